@@ -390,6 +390,7 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeperV2,
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -486,8 +487,15 @@ func NewSimApp(
 	ibcRouter.AddRoute(MockFeePort, feeWithMockModule)
 
 	// add transfer v2 module wrapped by callbacks v2 middleware
-	cbTransferModulev2 := ibccallbacksv2.NewIBCMiddleware(transferv2.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeperV2, app.MockContractKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
-	ibcRouterV2.AddRoute(ibctransfertypes.PortID, cbTransferModulev2)
+	// the stack builder sets the callbacks middleware as the WriteAcknowledgementWrapper of the transfer module so that
+	// asynchronous acknowledgements of forwarded packets are passed through the middleware
+	transferModuleV2 := transferv2.NewIBCModule(app.TransferKeeper, app.IBCKeeper.ChannelKeeperV2, app.IBCKeeper.ChannelKeeperV2)
+	cbTransferModulev2 := ibccallbacksv2.NewIBCMiddleware(transferModuleV2, app.IBCKeeper.ChannelKeeperV2, app.MockContractKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
+	transferStackV2 := ibcapi.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeperV2).
+		Base(transferModuleV2).
+		Next(&cbTransferModulev2).
+		Build()
+	ibcRouterV2.AddRoute(ibctransfertypes.PortID, transferStackV2)
 
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -17,7 +17,12 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/api"
 )
 
-var _ api.IBCModule = (*IBCMiddleware)(nil)
+var (
+	_ api.IBCModule  = (*IBCMiddleware)(nil)
+	_ api.Middleware = (*IBCMiddleware)(nil)
+
+	_ api.AsyncAcknowledgementExpiryExempt = (*IBCMiddleware)(nil)
+)
 
 // IBCMiddleware implements the IBC v2 middleware interface
 // with the underlying application.
@@ -71,6 +76,18 @@ func NewIBCMiddleware(
 	}
 }
 
+// SetUnderlyingApplication sets the underlying IBC module. This function may be used after
+// the middleware's creation to set the IBC module which is below this middleware in the IBC application stack.
+// The underlying application must implement the required callback interfaces.
+func (im *IBCMiddleware) SetUnderlyingApplication(app api.IBCModule) {
+	packetDataUnmarshalerApp, ok := app.(types.CallbacksCompatibleModuleV2)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*types.CallbacksCompatibleModuleV2)(nil)))
+	}
+
+	im.app = packetDataUnmarshalerApp
+}
+
 // WithWriteAckWrapper sets the WriteAcknowledgementWrapper for the middleware.
 func (im *IBCMiddleware) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
 	im.writeAckWrapper = writeAckWrapper
@@ -81,6 +98,13 @@ func (im *IBCMiddleware) GetWriteAckWrapper() api.WriteAcknowledgementWrapper {
 	return im.writeAckWrapper
 }
 
+// ExemptFromAsyncAcknowledgementExpiry returns true if the underlying app has opted out of the expiry
+// of packets awaiting an asynchronous acknowledgement.
+func (im IBCMiddleware) ExemptFromAsyncAcknowledgementExpiry() bool {
+	exempt, ok := im.app.(api.AsyncAcknowledgementExpiryExempt)
+	return ok && exempt.ExemptFromAsyncAcknowledgementExpiry()
+}
+
 // OnSendPacket implements source callbacks for sending packets.
 // It defers to the underlying application and then calls the contract callback.
 // If the contract callback returns an error, panics, or runs out of gas, then
@@ -246,9 +270,10 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		}
 		// NOTE: The callback is receiving the acknowledgement that the application received for its particular payload.
 		// In the case of a successful acknowledgement, this will be the acknowledgement sent by the counterparty application for the given payload
-		// In the case of an error acknowledgement, this will be the sentinel error acknowledgement bytes defined by IBC v2 protocol.
-		// Thus, the contract must be aware that the sentinel error acknowledgement signals a failed receive
-		// and the contract must handle this error case and the corresponding success case (ie ack != ErrorAcknowledgement) accordingly.
+		// In the case of an error acknowledgement, this will be the error acknowledgement bytes defined by IBC v2 protocol, that is
+		// the sentinel error acknowledgement, optionally followed by the encoded AcknowledgementError of a structured error acknowledgement.
+		// Thus, the contract must be aware that an acknowledgement prefixed by the sentinel error acknowledgement signals a failed receive
+		// and the contract must handle this error case and the corresponding success case (ie !IsErrorAppAcknowledgement(ack)) accordingly.
 		return im.contractKeeper.IBCOnAcknowledgementPacketCallback(
 			cachedCtx, packetv1, acknowledgement, relayer, cbData.CallbackAddress, cbData.SenderAddress, payload.Version,
 		)
@@ -387,11 +412,10 @@ func (im IBCMiddleware) WriteAcknowledgement(
 			TimeoutTimestamp:   0,
 		}
 		// wrap the individual acknowledgement into the channeltypesv2.Acknowledgement since it implements the exported.Acknowledgement interface
-		var ack channeltypesv2.Acknowledgement
-		if recvResult.Status == channeltypesv2.PacketStatus_Failure {
+		// failed receives without a structured error acknowledgement are passed the sentinel error acknowledgement
+		ack := channeltypesv2.NewAcknowledgement(recvResult.Acknowledgement)
+		if recvResult.Status == channeltypesv2.PacketStatus_Failure && !channeltypesv2.IsErrorAppAcknowledgement(recvResult.Acknowledgement) {
 			ack = channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
-		} else {
-			ack = channeltypesv2.NewAcknowledgement(recvResult.Acknowledgement)
 		}
 		return im.contractKeeper.IBCReceivePacketCallback(
 			cachedCtx, packetv1, ack, cbData.CallbackAddress, payload.Version,
@@ -96,6 +96,17 @@ func (s *CallbacksTestSuite) TestWithWriteAckWrapper() {
 	s.Require().IsType((*channelkeeperv2.Keeper)(nil), writeAckWrapper)
 }
 
+func (s *CallbacksTestSuite) TestSetUnderlyingApplication() {
+	cbsMiddleware := v2.IBCMiddleware{}
+	s.Require().PanicsWithError(fmt.Sprintf("underlying application does not implement %T", (*types.CallbacksCompatibleModuleV2)(nil)), func() {
+		cbsMiddleware.SetUnderlyingApplication(nil)
+	})
+
+	s.Require().NotPanics(func() {
+		cbsMiddleware.SetUnderlyingApplication(ibcmockv2.IBCModule{})
+	})
+}
+
 func (s *CallbacksTestSuite) TestSendPacket() {
 	var packetData transfertypes.FungibleTokenPacketDataV2
 
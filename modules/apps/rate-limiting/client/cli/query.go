@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+// GetCmdRateLimit returns the rate limit, including its current flow, of a denom on a channel or client
+func GetCmdRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [denom] [channel-or-client-id]",
+		Short:   "Query the rate limit of a denom on a channel or client.",
+		Long:    "Query the rate limit, including the current inflow and outflow, of a denom on a channel (IBC v1) or client (IBC v2).",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query rate-limiting rate-limit uatom channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitRequest{
+				Denom:             args[0],
+				ChannelOrClientId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRateLimits returns all the rate limits
+func GetCmdRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all the rate limits.",
+		Long:    "Query all the rate limits, including their current inflow and outflow.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query rate-limiting rate-limits", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllRateLimits(cmd.Context(), &types.QueryAllRateLimitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRateLimitsByChannelOrClient returns all the rate limits of a channel or client
+func GetCmdRateLimitsByChannelOrClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits-by-channel-or-client [channel-or-client-id]",
+		Short:   "Query all the rate limits of a channel or client.",
+		Long:    "Query all the rate limits, including their current inflow and outflow, of a channel (IBC v1) or client (IBC v2).",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query rate-limiting rate-limits-by-channel-or-client channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsByChannelOrClientRequest{
+				ChannelOrClientId: args[0],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimitsByChannelOrClient(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdWhitelistedAddresses returns all the whitelisted pairs of sender and receiver addresses
+func GetCmdWhitelistedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "whitelisted-addresses",
+		Short:   "Query all the whitelisted pairs of sender and receiver addresses.",
+		Long:    "Query all the pairs of sender and receiver addresses whose transfers are not rate limited.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query rate-limiting whitelisted-addresses", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllWhitelistedAddresses(cmd.Context(), &types.QueryAllWhitelistedAddressesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
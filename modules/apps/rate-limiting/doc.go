@@ -0,0 +1,7 @@
+/*
+Package ratelimiting implements a middleware which rate limits the fungible token transfers of the ICS-20
+application. Quotas are defined per denom and per channel (IBC v1) or client (IBC v2) as a percentage of the
+supply of the denom which can flow in or out of the chain over a rolling window of a number of hours. The
+middleware wraps the transfer application over both IBC v1 and IBC v2.
+*/
+package ratelimiting
@@ -0,0 +1,143 @@
+package ratelimiting_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// defaultQuota allows 10% of the channel value to be sent or received
+var defaultQuota = types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+type RateLimitingTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(RateLimitingTestSuite))
+}
+
+// setRateLimit stores a rate limit for the denom on the channel of the chain with a channel value of 1000
+func (*RateLimitingTestSuite) setRateLimit(chain *ibctesting.TestChain, denom, channelID string) {
+	rateLimit := types.NewRateLimit(types.NewPath(denom, channelID), defaultQuota, sdkmath.NewInt(1000))
+	chain.GetSimApp().RateLimitKeeper.SetRateLimit(chain.GetContext(), rateLimit)
+}
+
+// flow returns the flow of the rate limit of the denom on the channel of the chain
+func (suite *RateLimitingTestSuite) flow(chain *ibctesting.TestChain, denom, channelID string) types.Flow {
+	rateLimit, found := chain.GetSimApp().RateLimitKeeper.GetRateLimit(chain.GetContext(), denom, channelID)
+	suite.Require().True(found)
+
+	return rateLimit.Flow
+}
+
+// transfer sends the amount of the denom from chainA to chainB and returns the sent packet
+func (suite *RateLimitingTestSuite) transfer(amount int64, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID,
+		suite.path.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, "",
+		nil,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return ibctesting.ParsePacketFromEvents(res.Events)
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacket() {
+	suite.setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+	packet, err := suite.transfer(100, suite.chainB.GetTimeoutHeight())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.flow(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).Outflow)
+	suite.Require().True(suite.chainA.GetSimApp().RateLimitKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.Sequence))
+
+	// the outflow would exceed the quota
+	_, err = suite.transfer(1, suite.chainB.GetTimeoutHeight())
+	suite.Require().ErrorContains(err, types.ErrQuotaExceeded.Error())
+	suite.Require().Equal(sdkmath.NewInt(100), suite.flow(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).Outflow)
+
+	err = suite.path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// the outflow of acknowledged packets is kept
+	suite.Require().Equal(sdkmath.NewInt(100), suite.flow(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).Outflow)
+	suite.Require().False(suite.chainA.GetSimApp().RateLimitKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.Sequence))
+}
+
+func (suite *RateLimitingTestSuite) TestRecvPacketExceedsQuota() {
+	receivedDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID))
+
+	suite.setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+	// the inflow on chainB exceeds its quota, the outflow on chainA is within its quota
+	suite.setRateLimit(suite.chainB, receivedDenom.IBCDenom(), suite.path.EndpointB.ChannelID)
+	rateLimit, found := suite.chainB.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), receivedDenom.IBCDenom(), suite.path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	rateLimit.Flow.Inflow = sdkmath.NewInt(50)
+	suite.chainB.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainB.GetContext(), rateLimit)
+
+	packet, err := suite.transfer(100, suite.chainB.GetTimeoutHeight())
+	suite.Require().NoError(err)
+
+	_, ack, err := suite.path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	suite.Require().Equal(sdkmath.NewInt(50), suite.flow(suite.chainB, receivedDenom.IBCDenom(), suite.path.EndpointB.ChannelID).Inflow)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), receivedDenom.IBCDenom()).IsZero())
+
+	// the outflow on chainA is reverted once the error acknowledgement is received
+	suite.Require().True(suite.flow(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).Outflow.IsZero())
+	suite.Require().False(suite.chainA.GetSimApp().RateLimitKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.Sequence))
+}
+
+func (suite *RateLimitingTestSuite) TestTimeoutPacket() {
+	suite.setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+	packet, err := suite.transfer(100, clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.flow(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).Outflow)
+
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.flow(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID).Outflow.IsZero())
+	suite.Require().False(suite.chainA.GetSimApp().RateLimitKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.Sequence))
+}
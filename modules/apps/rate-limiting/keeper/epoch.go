@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+// GetHourEpoch returns the hour epoch stored in state.
+func (k Keeper) GetHourEpoch(ctx context.Context) types.HourEpoch {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyHourEpoch())
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.DefaultGenesisState().HourEpoch
+	}
+
+	var epoch types.HourEpoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+
+	return epoch
+}
+
+// SetHourEpoch stores the hour epoch in state.
+func (k Keeper) SetHourEpoch(ctx context.Context, epoch types.HourEpoch) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&epoch)
+	if err := store.Set(types.KeyHourEpoch(), bz); err != nil {
+		panic(err)
+	}
+}
+
+// BeginBlocker starts a new hour epoch once the duration of the current epoch has elapsed. The rate limits whose
+// window duration is a divisor of the new epoch number are reset, such that each window lasts the configured number of hours.
+func (k Keeper) BeginBlocker(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	epoch := k.GetHourEpoch(ctx)
+	if sdkCtx.BlockTime().Before(epoch.EpochStartTime.Add(epoch.Duration)) {
+		return
+	}
+
+	epoch.EpochNumber++
+	epoch.EpochStartTime = sdkCtx.BlockTime()
+	epoch.EpochStartHeight = sdkCtx.BlockHeight()
+	k.SetHourEpoch(ctx, epoch)
+
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if epoch.EpochNumber%rateLimit.Quota.DurationHours != 0 {
+			continue
+		}
+
+		if err := k.resetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId); err != nil {
+			k.Logger(ctx).Error("failed to reset rate limit", "denom", rateLimit.Path.Denom, "channel-or-client-id", rateLimit.Path.ChannelOrClientId, "error", err)
+		}
+	}
+}
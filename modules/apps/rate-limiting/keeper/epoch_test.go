@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	var (
+		epoch          types.HourEpoch
+		expEpoch       uint64
+		expDailyReset  bool
+		expHourlyReset bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"epoch has not ended",
+			func() {
+				epoch.EpochStartTime = suite.chainA.GetContext().BlockTime().Add(-time.Minute)
+				expEpoch = epoch.EpochNumber
+				expHourlyReset = false
+			},
+		},
+		{
+			"epoch has ended: hourly rate limit is reset",
+			func() {},
+		},
+		{
+			"epoch has ended: daily rate limit is reset",
+			func() {
+				epoch.EpochNumber = 23
+				expEpoch = 24
+				expDailyReset = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+
+			epoch = types.HourEpoch{
+				EpochNumber:    1,
+				Duration:       time.Hour,
+				EpochStartTime: ctx.BlockTime().Add(-time.Hour),
+			}
+			expEpoch = 2
+			expDailyReset = false
+			expHourlyReset = true
+
+			hourly := types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID), types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 1), sdkmath.NewInt(1000))
+			hourly.Flow.Outflow = sdkmath.NewInt(10)
+			daily := types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ClientID), types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24), sdkmath.NewInt(1000))
+			daily.Flow.Outflow = sdkmath.NewInt(10)
+
+			suite.keeper().SetRateLimit(ctx, hourly)
+			suite.keeper().SetRateLimit(ctx, daily)
+
+			tc.malleate()
+
+			suite.keeper().SetHourEpoch(ctx, epoch)
+			suite.keeper().BeginBlocker(ctx)
+
+			suite.Require().Equal(expEpoch, suite.keeper().GetHourEpoch(ctx).EpochNumber)
+
+			hourly, found := suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(expHourlyReset, hourly.Flow.Outflow.IsZero())
+
+			daily, found = suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ClientID)
+			suite.Require().True(found)
+			suite.Require().Equal(expDailyReset, daily.Flow.Outflow.IsZero())
+		})
+	}
+}
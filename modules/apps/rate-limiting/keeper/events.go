@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+// emitRateLimitResetEvent emits an event signalling that the flow of a rate limit has been reset.
+func emitRateLimitResetEvent(ctx context.Context, rateLimit types.RateLimit) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRateLimitReset,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelOrClientID, rateLimit.Path.ChannelOrClientId),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+// InitGenesis initializes the rate-limiting module state from a provided genesis state
+func (k Keeper) InitGenesis(ctx context.Context, state types.GenesisState) {
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, addressPair := range state.WhitelistedAddressPairs {
+		k.setWhitelistedAddressPair(ctx, addressPair)
+	}
+
+	for _, pendingPacket := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingPacket)
+	}
+
+	k.SetHourEpoch(ctx, state.HourEpoch)
+}
+
+// ExportGenesis returns the rate-limiting module exported genesis
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllRateLimits(ctx),
+		k.GetAllWhitelistedAddressPairs(ctx),
+		k.GetAllPendingSendPackets(ctx),
+		k.GetHourEpoch(ctx),
+	)
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	rateLimits := []types.RateLimit{
+		types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID), defaultQuota, sdkmath.NewInt(1000)),
+		types.NewRateLimit(types.NewPath(ibctesting.SecondaryDenom, ibctesting.FirstClientID), defaultQuota, sdkmath.NewInt(2000)),
+	}
+
+	genesisState := types.NewGenesisState(
+		rateLimits,
+		[]types.WhitelistedAddressPair{types.NewWhitelistedAddressPair(suite.chainA.SenderAccount.GetAddress().String(), "receiver")},
+		[]types.PendingSendPacket{
+			types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom, sdkmath.NewInt(10)),
+			types.NewPendingSendPacket(ibctesting.FirstClientID, 3, ibctesting.SecondaryDenom, sdkmath.NewInt(20)),
+		},
+		types.HourEpoch{
+			EpochNumber:      5,
+			Duration:         time.Hour,
+			EpochStartTime:   suite.chainA.GetContext().BlockTime().UTC(),
+			EpochStartHeight: suite.chainA.GetContext().BlockHeight(),
+		},
+	)
+
+	ctx := suite.chainA.GetContext()
+	suite.keeper().InitGenesis(ctx, *genesisState)
+
+	exported := suite.keeper().ExportGenesis(ctx)
+	suite.Require().ElementsMatch(genesisState.RateLimits, exported.RateLimits)
+	suite.Require().Equal(genesisState.WhitelistedAddressPairs, exported.WhitelistedAddressPairs)
+	suite.Require().ElementsMatch(genesisState.PendingSendPackets, exported.PendingSendPackets)
+	suite.Require().Equal(genesisState.HourEpoch, exported.HourEpoch)
+}
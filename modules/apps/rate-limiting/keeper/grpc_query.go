@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// AllRateLimits implements the Query/AllRateLimits gRPC method
+func (k Keeper) AllRateLimits(ctx context.Context, req *types.QueryAllRateLimitsRequest) (*types.QueryAllRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryAllRateLimitsResponse{
+		RateLimits: k.GetAllRateLimits(ctx),
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(ctx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewPath(req.Denom, req.ChannelOrClientId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelOrClientId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit not found for denom (%s) and channel or client (%s)", req.Denom, req.ChannelOrClientId)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: &rateLimit,
+	}, nil
+}
+
+// RateLimitsByChannelOrClient implements the Query/RateLimitsByChannelOrClient gRPC method
+func (k Keeper) RateLimitsByChannelOrClient(ctx context.Context, req *types.QueryRateLimitsByChannelOrClientRequest) (*types.QueryRateLimitsByChannelOrClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelOrClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryRateLimitsByChannelOrClientResponse{
+		RateLimits: k.GetRateLimitsByChannelOrClient(ctx, req.ChannelOrClientId),
+	}, nil
+}
+
+// AllWhitelistedAddresses implements the Query/AllWhitelistedAddresses gRPC method
+func (k Keeper) AllWhitelistedAddresses(ctx context.Context, req *types.QueryAllWhitelistedAddressesRequest) (*types.QueryAllWhitelistedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryAllWhitelistedAddressesResponse{
+		AddressPairs: k.GetAllWhitelistedAddressPairs(ctx),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var req *types.QueryRateLimitRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req.Denom = ibctesting.SecondaryDenom
+			},
+			false,
+		},
+		{
+			"invalid channel or client identifier",
+			func() {
+				req.ChannelOrClientId = ""
+			},
+			false,
+		},
+		{
+			"nil request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			expRateLimit := suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+
+			req = &types.QueryRateLimitRequest{
+				Denom:             sdk.DefaultBondDenom,
+				ChannelOrClientId: suite.path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.keeper().RateLimit(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	expRateLimits := []types.RateLimit{
+		suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		suite.setRateLimit(ibctesting.SecondaryDenom, sdkmath.NewInt(1000)),
+	}
+
+	otherRateLimit := types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ClientID), defaultQuota, sdkmath.NewInt(1000))
+	suite.keeper().SetRateLimit(suite.chainA.GetContext(), otherRateLimit)
+
+	res, err := suite.keeper().AllRateLimits(suite.chainA.GetContext(), &types.QueryAllRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(append(expRateLimits, otherRateLimit), res.RateLimits)
+
+	byChannelRes, err := suite.keeper().RateLimitsByChannelOrClient(suite.chainA.GetContext(), &types.QueryRateLimitsByChannelOrClientRequest{ChannelOrClientId: suite.path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expRateLimits, byChannelRes.RateLimits)
+
+	_, err = suite.keeper().RateLimitsByChannelOrClient(suite.chainA.GetContext(), &types.QueryRateLimitsByChannelOrClientRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryAllWhitelistedAddresses() {
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	_, err := suite.keeper().SetWhitelistedAddressPair(suite.chainA.GetContext(), types.NewMsgSetWhitelistedAddressPair(suite.keeper().GetAuthority(), sender, receiver))
+	suite.Require().NoError(err)
+
+	res, err := suite.keeper().AllWhitelistedAddresses(suite.chainA.GetContext(), &types.QueryAllWhitelistedAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.WhitelistedAddressPair{types.NewWhitelistedAddressPair(sender, receiver)}, res.AddressPairs)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// Keeper defines the rate-limiting keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
+	bankKeeper    types.BankKeeper
+
+	// the address capable of executing the rate-limiting governance messages. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate-limiting Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, storeService corestore.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper, authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetAuthority returns the rate-limiting module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var defaultQuota = types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// keeper returns the rate-limiting keeper of chainA
+func (suite *KeeperTestSuite) keeper() keeper.Keeper {
+	return suite.chainA.GetSimApp().RateLimitKeeper
+}
+
+// setRateLimit stores a rate limit for the denom on the channel of chainA with the provided channel value
+func (suite *KeeperTestSuite) setRateLimit(denom string, channelValue sdkmath.Int) types.RateLimit {
+	rateLimit := types.NewRateLimit(types.NewPath(denom, suite.path.EndpointA.ChannelID), defaultQuota, channelValue)
+	suite.keeper().SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+	return rateLimit
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeleteRateLimit() {
+	ctx := suite.chainA.GetContext()
+
+	_, found := suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().False(found)
+
+	expRateLimits := []types.RateLimit{
+		suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		suite.setRateLimit(ibctesting.SecondaryDenom, sdkmath.NewInt(1000)),
+	}
+
+	otherRateLimit := types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.SecondChannelID), defaultQuota, sdkmath.NewInt(1000))
+	suite.keeper().SetRateLimit(ctx, otherRateLimit)
+
+	rateLimit, found := suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(expRateLimits[0], rateLimit)
+
+	suite.Require().ElementsMatch(expRateLimits, suite.keeper().GetRateLimitsByChannelOrClient(ctx, suite.path.EndpointA.ChannelID))
+	suite.Require().ElementsMatch(append(expRateLimits, otherRateLimit), suite.keeper().GetAllRateLimits(ctx))
+
+	suite.keeper().DeleteRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+	_, found = suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().False(found)
+	suite.Require().Len(suite.keeper().GetAllRateLimits(ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestPendingSendPackets() {
+	ctx := suite.chainA.GetContext()
+
+	pendingPacketA := types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom, sdkmath.NewInt(10))
+	pendingPacketB := types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, ibctesting.SecondaryDenom, sdkmath.NewInt(20))
+	pendingPacketC := types.NewPendingSendPacket(ibctesting.FirstChannelID, 2, sdk.DefaultBondDenom, sdkmath.NewInt(30))
+	pendingPacketD := types.NewPendingSendPacket(ibctesting.SecondChannelID, 1, sdk.DefaultBondDenom, sdkmath.NewInt(40))
+
+	for _, pendingPacket := range []types.PendingSendPacket{pendingPacketA, pendingPacketB, pendingPacketC, pendingPacketD} {
+		suite.keeper().SetPendingSendPacket(ctx, pendingPacket)
+	}
+
+	suite.Require().True(suite.keeper().HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1))
+	suite.Require().False(suite.keeper().HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 3))
+
+	pendingPacket, found := suite.keeper().GetPendingSendPacket(ctx, ibctesting.FirstChannelID, 1, ibctesting.SecondaryDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(pendingPacketB, pendingPacket)
+
+	suite.Require().ElementsMatch([]types.PendingSendPacket{pendingPacketA, pendingPacketB}, suite.keeper().GetPendingSendPackets(ctx, ibctesting.FirstChannelID, 1))
+	suite.Require().ElementsMatch([]types.PendingSendPacket{pendingPacketA, pendingPacketB, pendingPacketC, pendingPacketD}, suite.keeper().GetAllPendingSendPackets(ctx))
+
+	// only the pending send packets of the denom on the channel are deleted
+	suite.keeper().DeletePendingSendPacketsByDenom(ctx, sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+	suite.Require().ElementsMatch([]types.PendingSendPacket{pendingPacketB, pendingPacketD}, suite.keeper().GetAllPendingSendPackets(ctx))
+
+	suite.keeper().DeletePendingSendPackets(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().False(suite.keeper().HasPendingSendPacket(ctx, ibctesting.FirstChannelID, 1))
+	suite.Require().Equal([]types.PendingSendPacket{pendingPacketD}, suite.keeper().GetAllPendingSendPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestWhitelistedAddressPairs() {
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	suite.Require().False(suite.keeper().IsAddressPairWhitelisted(ctx, sender, receiver))
+
+	msg := types.NewMsgSetWhitelistedAddressPair(suite.keeper().GetAuthority(), sender, receiver)
+	_, err := suite.keeper().SetWhitelistedAddressPair(ctx, msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.keeper().IsAddressPairWhitelisted(ctx, sender, receiver))
+	suite.Require().False(suite.keeper().IsAddressPairWhitelisted(ctx, receiver, sender))
+	suite.Require().Equal([]types.WhitelistedAddressPair{types.NewWhitelistedAddressPair(sender, receiver)}, suite.keeper().GetAllWhitelistedAddressPairs(ctx))
+
+	suite.keeper().DeleteWhitelistedAddressPair(ctx, sender, receiver)
+	suite.Require().False(suite.keeper().IsAddressPairWhitelisted(ctx, sender, receiver))
+	suite.Require().Empty(suite.keeper().GetAllWhitelistedAddressPairs(ctx))
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// AddRateLimit defines an rpc handler method for MsgAddRateLimit. Adds a new rate limit for a denom and channel or client.
+func (k Keeper) AddRateLimit(ctx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := k.validateAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.addRateLimit(ctx, msg.Denom, msg.ChannelOrClientId, quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit added", "denom", msg.Denom, "channel-or-client-id", msg.ChannelOrClientId)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit defines an rpc handler method for MsgUpdateRateLimit. Updates the quota of an existing rate limit
+// and resets its flow.
+func (k Keeper) UpdateRateLimit(ctx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := k.validateAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.updateRateLimit(ctx, msg.Denom, msg.ChannelOrClientId, quota); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit updated", "denom", msg.Denom, "channel-or-client-id", msg.ChannelOrClientId)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines an rpc handler method for MsgRemoveRateLimit. Removes an existing rate limit.
+func (k Keeper) RemoveRateLimit(ctx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.validateAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.removeRateLimit(ctx, msg.Denom, msg.ChannelOrClientId); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit removed", "denom", msg.Denom, "channel-or-client-id", msg.ChannelOrClientId)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit defines an rpc handler method for MsgResetRateLimit. Resets the flow of an existing rate limit.
+func (k Keeper) ResetRateLimit(ctx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.validateAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.resetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("rate limit reset", "denom", msg.Denom, "channel-or-client-id", msg.ChannelOrClientId)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// SetWhitelistedAddressPair defines an rpc handler method for MsgSetWhitelistedAddressPair. Whitelists the pair of
+// sender and receiver addresses such that their transfers are not rate limited.
+func (k Keeper) SetWhitelistedAddressPair(ctx context.Context, msg *types.MsgSetWhitelistedAddressPair) (*types.MsgSetWhitelistedAddressPairResponse, error) {
+	if err := k.validateAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.setWhitelistedAddressPair(ctx, types.NewWhitelistedAddressPair(msg.Sender, msg.Receiver))
+
+	k.Logger(ctx).Info("address pair whitelisted", "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgSetWhitelistedAddressPairResponse{}, nil
+}
+
+// RemoveWhitelistedAddressPair defines an rpc handler method for MsgRemoveWhitelistedAddressPair. Removes an existing
+// whitelisted pair of sender and receiver addresses.
+func (k Keeper) RemoveWhitelistedAddressPair(ctx context.Context, msg *types.MsgRemoveWhitelistedAddressPair) (*types.MsgRemoveWhitelistedAddressPairResponse, error) {
+	if err := k.validateAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !k.IsAddressPairWhitelisted(ctx, msg.Sender, msg.Receiver) {
+		return nil, errorsmod.Wrapf(types.ErrWhitelistedAddressNotFound, "sender (%s), receiver (%s)", msg.Sender, msg.Receiver)
+	}
+
+	k.DeleteWhitelistedAddressPair(ctx, msg.Sender, msg.Receiver)
+
+	k.Logger(ctx).Info("whitelisted address pair removed", "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgRemoveWhitelistedAddressPairResponse{}, nil
+}
+
+// validateAuthority returns an error if the signer is not the authority of the rate-limiting module.
+func (k Keeper) validateAuthority(signer string) error {
+	if k.GetAuthority() != signer {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), signer)
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgAddRateLimit() {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: client identifier",
+			func() {
+				msg.ChannelOrClientId = suite.path.EndpointA.ClientID
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel or client not found",
+			func() {
+				msg.ChannelOrClientId = ibctesting.InvalidID
+			},
+			types.ErrChannelOrClientNotFound,
+		},
+		{
+			"failure: rate limit already exists",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+			},
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"failure: zero channel value",
+			func() {
+				msg.Denom = "nonexistent"
+			},
+			types.ErrZeroChannelValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgAddRateLimit(suite.keeper().GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(10), sdkmath.NewInt(20), 24)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.keeper().AddRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.keeper().GetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours), rateLimit.Quota)
+				suite.Require().Equal(suite.keeper().GetChannelValue(ctx, msg.Denom), rateLimit.Flow.ChannelValue)
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateRateLimit() {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = ibctesting.SecondaryDenom
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimit := suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+			rateLimit.Flow.Outflow = sdkmath.NewInt(50)
+			suite.keeper().SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+			msg = types.NewMsgUpdateRateLimit(suite.keeper().GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(50), sdkmath.NewInt(50), 12)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.keeper().UpdateRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.keeper().GetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours), rateLimit.Quota)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.ChannelOrClientId = ibctesting.SecondChannelID
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+
+			msg = types.NewMsgRemoveRateLimit(suite.keeper().GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.keeper().RemoveRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := suite.keeper().GetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+				suite.Require().False(found)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgResetRateLimit() {
+	var msg *types.MsgResetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = ibctesting.SecondaryDenom
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimit := suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+			rateLimit.Flow.Inflow = sdkmath.NewInt(20)
+			rateLimit.Flow.Outflow = sdkmath.NewInt(50)
+			suite.keeper().SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			suite.keeper().SetPendingSendPacket(suite.chainA.GetContext(), types.NewPendingSendPacket(suite.path.EndpointA.ChannelID, 1, sdk.DefaultBondDenom, sdkmath.NewInt(50)))
+			// the pending send packets of other denoms on the channel are not affected by the reset
+			suite.keeper().SetPendingSendPacket(suite.chainA.GetContext(), types.NewPendingSendPacket(suite.path.EndpointA.ChannelID, 2, ibctesting.SecondaryDenom, sdkmath.NewInt(50)))
+
+			msg = types.NewMsgResetRateLimit(suite.keeper().GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.keeper().ResetRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.keeper().GetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewFlow(suite.keeper().GetChannelValue(ctx, msg.Denom)), rateLimit.Flow)
+				suite.Require().False(suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1))
+				suite.Require().True(suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 2))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveWhitelistedAddressPair() {
+	var msg *types.MsgRemoveWhitelistedAddressPair
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: whitelisted address pair not found",
+			func() {
+				msg.Receiver = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			types.ErrWhitelistedAddressNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			sender := suite.chainA.SenderAccount.GetAddress().String()
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+			_, err := suite.keeper().SetWhitelistedAddressPair(suite.chainA.GetContext(), types.NewMsgSetWhitelistedAddressPair(suite.keeper().GetAuthority(), sender, receiver))
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgRemoveWhitelistedAddressPair(suite.keeper().GetAuthority(), sender, receiver)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err = suite.keeper().RemoveWhitelistedAddressPair(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.keeper().IsAddressPairWhitelisted(ctx, sender, receiver))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// SendPacket wraps the ICS4Wrapper SendPacket function. The outflow of the rate limits of the tokens sent in
+// ICS-20 packets is updated once the packet has been sent, such that the sequence of the packet is known.
+// An error is returned if the outflow of any of the tokens would exceed its quota.
+func (k Keeper) SendPacket(
+	ctx context.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData, ok := k.unmarshalTransferPacketData(ctx, sourcePort, sourceChannel, data)
+	if !ok {
+		return sequence, nil
+	}
+
+	if err := k.SendRateLimitedPacket(ctx, sourceChannel, sequence, packetData); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement wraps the ICS4Wrapper WriteAcknowledgement function.
+func (k Keeper) WriteAcknowledgement(ctx context.Context, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, packet, acknowledgement)
+}
+
+// GetAppVersion wraps the ICS4Wrapper GetAppVersion function.
+func (k Keeper) GetAppVersion(ctx context.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// SendRateLimitedPacket adds the amount of each token sent in the packet to the outflow of its rate limit on the
+// channel or client. The denom and amount of each rate limited token are recorded as pending so that exactly this
+// outflow can be reverted if the packet fails. An error is returned if the outflow of any of the tokens exceeds its quota.
+func (k Keeper) SendRateLimitedPacket(ctx context.Context, channelOrClientID string, sequence uint64, data transfertypes.FungibleTokenPacketDataV2) error {
+	for _, token := range data.Tokens {
+		amount, err := parseAmount(token)
+		if err != nil {
+			return err
+		}
+
+		denom := token.Denom.IBCDenom()
+		updated, err := k.checkRateLimitAndUpdateFlow(ctx, types.PACKET_SEND, denom, channelOrClientID, data.Sender, data.Receiver, amount)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
+			continue
+		}
+
+		// the amounts of the same denom sent multiple times in the packet are accumulated.
+		if pendingPacket, found := k.GetPendingSendPacket(ctx, channelOrClientID, sequence, denom); found {
+			amount = amount.Add(pendingPacket.Amount)
+		}
+
+		k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelOrClientID, sequence, denom, amount))
+	}
+
+	return nil
+}
+
+// ReceiveRateLimitedPacket adds the amount of each token received in the packet to the inflow of its rate limit on
+// the destination channel or client. The denom of each token is resolved to the denom of the tokens minted or
+// unescrowed on this chain. An error is returned if the inflow of any of the tokens exceeds its quota.
+func (k Keeper) ReceiveRateLimitedPacket(
+	ctx context.Context,
+	sourcePort, sourceChannelOrClientID, destinationPort, destinationChannelOrClientID string,
+	data transfertypes.FungibleTokenPacketDataV2,
+) error {
+	for _, token := range data.Tokens {
+		amount, err := parseAmount(token)
+		if err != nil {
+			return err
+		}
+
+		denom := receivedDenom(token.Denom, sourcePort, sourceChannelOrClientID, destinationPort, destinationChannelOrClientID)
+		if _, err := k.checkRateLimitAndUpdateFlow(ctx, types.PACKET_RECV, denom, destinationChannelOrClientID, data.Sender, data.Receiver, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AcknowledgeRateLimitedPacket removes the packet from the pending send packets once it has been successfully received.
+func (k Keeper) AcknowledgeRateLimitedPacket(ctx context.Context, channelOrClientID string, sequence uint64) {
+	k.DeletePendingSendPackets(ctx, channelOrClientID, sequence)
+}
+
+// UndoSendRateLimitedPacket reverts the outflow added for each rate limited token of a packet which has failed, i.e.
+// which has been acknowledged with an error acknowledgement or has timed out, as the tokens are refunded to the sender.
+// Only the amounts recorded when the packet was sent are reverted, such that the outflow is not reverted for denoms
+// which were not rate limited at that time, nor for denoms whose rate limit has since been reset.
+func (k Keeper) UndoSendRateLimitedPacket(ctx context.Context, channelOrClientID string, sequence uint64) {
+	for _, pendingPacket := range k.GetPendingSendPackets(ctx, channelOrClientID, sequence) {
+		rateLimit, found := k.GetRateLimit(ctx, pendingPacket.Denom, channelOrClientID)
+		if found {
+			rateLimit.Flow.Outflow = sdkmath.MaxInt(rateLimit.Flow.Outflow.Sub(pendingPacket.Amount), sdkmath.ZeroInt())
+			k.SetRateLimit(ctx, rateLimit)
+		}
+	}
+
+	k.DeletePendingSendPackets(ctx, channelOrClientID, sequence)
+}
+
+// checkRateLimitAndUpdateFlow adds the amount to the flow of the rate limit of the denom on the channel or client
+// in the provided direction. It returns true if the flow has been updated, and false if the denom is not rate limited
+// on the channel or client, or if the sender and receiver addresses are whitelisted.
+func (k Keeper) checkRateLimitAndUpdateFlow(
+	ctx context.Context,
+	direction types.PacketDirection,
+	denom, channelOrClientID, sender, receiver string,
+	amount sdkmath.Int,
+) (bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelOrClientID)
+	if !found {
+		return false, nil
+	}
+
+	if k.IsAddressPairWhitelisted(ctx, sender, receiver) {
+		return false, nil
+	}
+
+	var err error
+	if direction == types.PACKET_SEND {
+		err = rateLimit.Flow.AddOutflow(amount, rateLimit.Quota)
+	} else {
+		err = rateLimit.Flow.AddInflow(amount, rateLimit.Quota)
+	}
+
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "denom (%s), channel or client (%s)", denom, channelOrClientID)
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return true, nil
+}
+
+// unmarshalTransferPacketData unmarshals the data of a packet sent over a channel of the transfer port. It returns
+// false if the packet is not an ICS-20 packet.
+func (k Keeper) unmarshalTransferPacketData(ctx context.Context, portID, channelID string, bz []byte) (transfertypes.FungibleTokenPacketDataV2, bool) {
+	if portID != transfertypes.PortID {
+		return transfertypes.FungibleTokenPacketDataV2{}, false
+	}
+
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, false
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(bz, version, "")
+	if err != nil {
+		return transfertypes.FungibleTokenPacketDataV2{}, false
+	}
+
+	return data, true
+}
+
+// receivedDenom returns the denom on this chain of a token received from the source port and channel or client.
+// Tokens returning to the chain they were sent from have the first hop of their trace removed, while the destination
+// hop is prepended to the trace of all other tokens.
+func receivedDenom(denom transfertypes.Denom, sourcePort, sourceChannelOrClientID, destinationPort, destinationChannelOrClientID string) string {
+	if denom.HasPrefix(sourcePort, sourceChannelOrClientID) {
+		return transfertypes.NewDenom(denom.Base, denom.Trace[1:]...).IBCDenom()
+	}
+
+	trace := append([]transfertypes.Hop{transfertypes.NewHop(destinationPort, destinationChannelOrClientID)}, denom.Trace...)
+	return transfertypes.NewDenom(denom.Base, trace...).IBCDenom()
+}
+
+// parseAmount parses the amount of the token into an sdkmath.Int.
+func parseAmount(token transfertypes.Token) (sdkmath.Int, error) {
+	amount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return sdkmath.Int{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+	}
+
+	return amount, nil
+}
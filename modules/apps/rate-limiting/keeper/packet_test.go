@@ -0,0 +1,304 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// packetData returns ICS-20 packet data sending the amount of the denom from the sender account of chainA to the sender account of chainB
+func (suite *KeeperTestSuite) packetData(denom transfertypes.Denom, amount int64) transfertypes.FungibleTokenPacketDataV2 {
+	token := transfertypes.Token{Denom: denom, Amount: sdkmath.NewInt(amount).String()}
+	return transfertypes.NewFungibleTokenPacketDataV2(
+		[]transfertypes.Token{token},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+		transfertypes.ForwardingPacketData{},
+	)
+}
+
+func (suite *KeeperTestSuite) TestSendRateLimitedPacket() {
+	var (
+		data         transfertypes.FungibleTokenPacketDataV2
+		expOutflow   sdkmath.Int
+		expIsPending bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: outflow equal to quota",
+			func() {
+				data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 100)
+				expOutflow = sdkmath.NewInt(100)
+			},
+			nil,
+		},
+		{
+			"success: denom is not rate limited",
+			func() {
+				data = suite.packetData(transfertypes.NewDenom(ibctesting.SecondaryDenom), 1000)
+				expOutflow = sdkmath.ZeroInt()
+				expIsPending = false
+			},
+			nil,
+		},
+		{
+			"success: address pair is whitelisted",
+			func() {
+				data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 1000)
+				_, err := suite.keeper().SetWhitelistedAddressPair(suite.chainA.GetContext(), types.NewMsgSetWhitelistedAddressPair(suite.keeper().GetAuthority(), data.Sender, data.Receiver))
+				suite.Require().NoError(err)
+
+				expOutflow = sdkmath.ZeroInt()
+				expIsPending = false
+			},
+			nil,
+		},
+		{
+			"failure: outflow exceeds quota",
+			func() {
+				data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 101)
+			},
+			types.ErrQuotaExceeded,
+		},
+		{
+			"failure: net outflow exceeds quota",
+			func() {
+				rateLimit, _ := suite.keeper().GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+				rateLimit.Flow.Outflow = sdkmath.NewInt(60)
+				suite.keeper().SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			types.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+
+			data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 50)
+			expOutflow = sdkmath.NewInt(50)
+			expIsPending = true
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.keeper().SendRateLimitedPacket(ctx, suite.path.EndpointA.ChannelID, 1, data)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(expOutflow, rateLimit.Flow.Outflow)
+				suite.Require().Equal(expIsPending, suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+				suite.Require().False(suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReceiveRateLimitedPacket() {
+	var (
+		data      transfertypes.FungibleTokenPacketDataV2
+		denom     string
+		expInflow sdkmath.Int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: tokens native to the counterparty chain",
+			func() {},
+			nil,
+		},
+		{
+			"success: tokens returning to this chain",
+			func() {
+				denom = sdk.DefaultBondDenom
+				trace := transfertypes.NewHop(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+				data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom, trace), 50)
+			},
+			nil,
+		},
+		{
+			"success: denom is not rate limited",
+			func() {
+				data = suite.packetData(transfertypes.NewDenom(ibctesting.SecondaryDenom), 1000)
+				expInflow = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"failure: inflow exceeds quota",
+			func() {
+				data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 101)
+			},
+			types.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// tokens native to chainB are received on chainA with the hop of the channel on chainA prepended to their trace
+			denom = transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)).IBCDenom()
+			data = suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 50)
+			expInflow = sdkmath.NewInt(50)
+
+			tc.malleate()
+
+			suite.setRateLimit(denom, sdkmath.NewInt(1000))
+
+			ctx := suite.chainA.GetContext()
+			err := suite.keeper().ReceiveRateLimitedPacket(
+				ctx,
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				data,
+			)
+
+			rateLimit, found := suite.keeper().GetRateLimit(ctx, denom, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expInflow, rateLimit.Flow.Inflow)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndoSendRateLimitedPacket() {
+	var (
+		sequence     uint64
+		expOutflow   sdkmath.Int
+		expIsPending bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"success: packet is not pending",
+			func() {
+				sequence = 2
+				expOutflow = sdkmath.NewInt(50)
+				expIsPending = true
+			},
+		},
+		{
+			"success: rate limit has been reset after the packet was sent",
+			func() {
+				_, err := suite.keeper().ResetRateLimit(suite.chainA.GetContext(), types.NewMsgResetRateLimit(suite.keeper().GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID))
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+
+			data := suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 50)
+			err := suite.keeper().SendRateLimitedPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 1, data)
+			suite.Require().NoError(err)
+
+			sequence = 1
+			expOutflow = sdkmath.ZeroInt()
+			expIsPending = false
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			suite.keeper().UndoSendRateLimitedPacket(ctx, suite.path.EndpointA.ChannelID, sequence)
+
+			rateLimit, found := suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(expOutflow, rateLimit.Flow.Outflow)
+			suite.Require().Equal(expIsPending, suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndoSendRateLimitedPacketMultiDenom() {
+	suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+
+	data := suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 50)
+	data.Tokens = append(data.Tokens, transfertypes.Token{Denom: transfertypes.NewDenom(ibctesting.SecondaryDenom), Amount: "40"})
+	err := suite.keeper().SendRateLimitedPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 1, data)
+	suite.Require().NoError(err)
+
+	// the secondary denom is only rate limited after the packet was sent
+	suite.setRateLimit(ibctesting.SecondaryDenom, sdkmath.NewInt(1000))
+	err = suite.keeper().SendRateLimitedPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 2, suite.packetData(transfertypes.NewDenom(ibctesting.SecondaryDenom), 30))
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	suite.keeper().UndoSendRateLimitedPacket(ctx, suite.path.EndpointA.ChannelID, 1)
+	suite.Require().False(suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 1))
+
+	rateLimit, found := suite.keeper().GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	// the outflow of the secondary denom is not reverted as it was not rate limited when the packet was sent
+	rateLimit, found = suite.keeper().GetRateLimit(ctx, ibctesting.SecondaryDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(30), rateLimit.Flow.Outflow)
+	suite.Require().True(suite.keeper().HasPendingSendPacket(ctx, suite.path.EndpointA.ChannelID, 2))
+}
+
+func (suite *KeeperTestSuite) TestAcknowledgeRateLimitedPacket() {
+	suite.setRateLimit(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+
+	data := suite.packetData(transfertypes.NewDenom(sdk.DefaultBondDenom), 50)
+	err := suite.keeper().SendRateLimitedPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 1, data)
+	suite.Require().NoError(err)
+
+	suite.keeper().AcknowledgeRateLimitedPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 1)
+	suite.Require().False(suite.keeper().HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, 1))
+
+	// the outflow of acknowledged packets is not reverted
+	rateLimit, found := suite.keeper().GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(50), rateLimit.Flow.Outflow)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+// SetPendingSendPacket records the amount of the rate limited denom sent in the packet over the channel or client
+// with the provided sequence, which counts towards the outflow of the current window.
+func (k Keeper) SetPendingSendPacket(ctx context.Context, pendingPacket types.PendingSendPacket) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&pendingPacket)
+	if err := store.Set(types.KeyPendingSendPacket(pendingPacket.ChannelOrClientId, pendingPacket.Sequence, pendingPacket.Denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetPendingSendPacket returns the rate limited amount of the denom sent in the packet over the provided channel or
+// client with the provided sequence.
+func (k Keeper) GetPendingSendPacket(ctx context.Context, channelOrClientID string, sequence uint64, denom string) (types.PendingSendPacket, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyPendingSendPacket(channelOrClientID, sequence, denom))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingPacket)
+	return pendingPacket, true
+}
+
+// GetPendingSendPackets returns the rate limited amounts of all the denoms sent in the packet over the provided
+// channel or client with the provided sequence.
+func (k Keeper) GetPendingSendPackets(ctx context.Context, channelOrClientID string, sequence uint64) []types.PendingSendPacket {
+	return k.getPendingSendPacketsByPrefix(ctx, types.KeyPendingSendPacketsBySequence(channelOrClientID, sequence))
+}
+
+// HasPendingSendPacket returns true if the packet sent over the provided channel or client with the provided sequence
+// counts towards the outflow of the current window of any of the rate limits.
+func (k Keeper) HasPendingSendPacket(ctx context.Context, channelOrClientID string, sequence uint64) bool {
+	return len(k.GetPendingSendPackets(ctx, channelOrClientID, sequence)) != 0
+}
+
+// DeletePendingSendPackets deletes the rate limited amounts of all the denoms sent in the packet over the provided
+// channel or client with the provided sequence.
+func (k Keeper) DeletePendingSendPackets(ctx context.Context, channelOrClientID string, sequence uint64) {
+	for _, pendingPacket := range k.GetPendingSendPackets(ctx, channelOrClientID, sequence) {
+		k.deletePendingSendPacket(ctx, pendingPacket)
+	}
+}
+
+// DeletePendingSendPacketsByDenom deletes the rate limited amounts of the provided denom of all the packets sent over
+// the provided channel or client. The amounts of other denoms sent over the channel or client are left untouched.
+func (k Keeper) DeletePendingSendPacketsByDenom(ctx context.Context, denom, channelOrClientID string) {
+	for _, pendingPacket := range k.getPendingSendPacketsByPrefix(ctx, types.KeyPendingSendPacketsByChannelOrClient(channelOrClientID)) {
+		if pendingPacket.Denom == denom {
+			k.deletePendingSendPacket(ctx, pendingPacket)
+		}
+	}
+}
+
+// GetAllPendingSendPackets returns the rate limited amounts of all the pending send packets stored in state.
+func (k Keeper) GetAllPendingSendPackets(ctx context.Context) []types.PendingSendPacket {
+	return k.getPendingSendPacketsByPrefix(ctx, []byte(types.PendingSendPacketKeyPrefix+"/"))
+}
+
+// deletePendingSendPacket deletes the rate limited amount of the denom of the pending send packet.
+func (k Keeper) deletePendingSendPacket(ctx context.Context, pendingPacket types.PendingSendPacket) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyPendingSendPacket(pendingPacket.ChannelOrClientId, pendingPacket.Sequence, pendingPacket.Denom)); err != nil {
+		panic(err)
+	}
+}
+
+// getPendingSendPacketsByPrefix returns all the pending send packets stored in state under the provided key prefix
+func (k Keeper) getPendingSendPacketsByPrefix(ctx context.Context, keyPrefix []byte) []types.PendingSendPacket {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	pendingPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// GetRateLimit retrieves the rate limit of the provided denom and channel or client from state
+func (k Keeper) GetRateLimit(ctx context.Context, denom, channelOrClientID string) (types.RateLimit, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyRateLimit(denom, channelOrClientID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit in state keyed by its denom and channel or client
+func (k Keeper) SetRateLimit(ctx context.Context, rateLimit types.RateLimit) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	if err := store.Set(types.KeyRateLimit(rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteRateLimit deletes the rate limit of the provided denom and channel or client from state
+func (k Keeper) DeleteRateLimit(ctx context.Context, denom, channelOrClientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyRateLimit(denom, channelOrClientID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRateLimits returns all the rate limits stored in state
+func (k Keeper) GetAllRateLimits(ctx context.Context) []types.RateLimit {
+	return k.getRateLimitsByPrefix(ctx, []byte(types.RateLimitKeyPrefix))
+}
+
+// GetRateLimitsByChannelOrClient returns all the rate limits of the provided channel or client stored in state
+func (k Keeper) GetRateLimitsByChannelOrClient(ctx context.Context, channelOrClientID string) []types.RateLimit {
+	return k.getRateLimitsByPrefix(ctx, types.KeyRateLimitsByChannelOrClient(channelOrClientID))
+}
+
+// getRateLimitsByPrefix returns all the rate limits stored in state under the provided key prefix
+func (k Keeper) getRateLimitsByPrefix(ctx context.Context, keyPrefix []byte) []types.RateLimit {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// addRateLimit adds a new rate limit for the provided denom and channel or client. The channel must be a
+// channel of the transfer port (IBC v1) or the client must exist (IBC v2), and the supply of the denom must not be zero.
+func (k Keeper) addRateLimit(ctx context.Context, denom, channelOrClientID string, quota types.Quota) error {
+	if !k.hasChannelOrClient(ctx, channelOrClientID) {
+		return errorsmod.Wrapf(types.ErrChannelOrClientNotFound, "channel or client (%s) not found", channelOrClientID)
+	}
+
+	if _, found := k.GetRateLimit(ctx, denom, channelOrClientID); found {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom (%s), channel or client (%s)", denom, channelOrClientID)
+	}
+
+	channelValue := k.GetChannelValue(ctx, denom)
+	if channelValue.IsZero() {
+		return errorsmod.Wrapf(types.ErrZeroChannelValue, "denom (%s)", denom)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(types.NewPath(denom, channelOrClientID), quota, channelValue))
+	return nil
+}
+
+// updateRateLimit updates the quota of an existing rate limit. The flow of the rate limit and its pending send packets are reset.
+func (k Keeper) updateRateLimit(ctx context.Context, denom, channelOrClientID string, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, denom, channelOrClientID); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom (%s), channel or client (%s)", denom, channelOrClientID)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(types.NewPath(denom, channelOrClientID), quota, k.GetChannelValue(ctx, denom)))
+	k.DeletePendingSendPacketsByDenom(ctx, denom, channelOrClientID)
+	return nil
+}
+
+// removeRateLimit removes an existing rate limit along with its pending send packets.
+func (k Keeper) removeRateLimit(ctx context.Context, denom, channelOrClientID string) error {
+	if _, found := k.GetRateLimit(ctx, denom, channelOrClientID); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom (%s), channel or client (%s)", denom, channelOrClientID)
+	}
+
+	k.DeleteRateLimit(ctx, denom, channelOrClientID)
+	k.DeletePendingSendPacketsByDenom(ctx, denom, channelOrClientID)
+	return nil
+}
+
+// resetRateLimit resets the flow of an existing rate limit and sets its channel value to the current supply of the denom.
+// The pending send packets of the denom on the channel or client are removed as they no longer count towards the outflow.
+func (k Keeper) resetRateLimit(ctx context.Context, denom, channelOrClientID string) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelOrClientID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom (%s), channel or client (%s)", denom, channelOrClientID)
+	}
+
+	rateLimit.Flow = types.NewFlow(k.GetChannelValue(ctx, denom))
+	k.SetRateLimit(ctx, rateLimit)
+	k.DeletePendingSendPacketsByDenom(ctx, denom, channelOrClientID)
+
+	emitRateLimitResetEvent(ctx, rateLimit)
+	return nil
+}
+
+// GetChannelValue returns the value against which the quotas of the denom are computed, i.e. the total supply of the denom.
+func (k Keeper) GetChannelValue(ctx context.Context, denom string) sdkmath.Int {
+	return k.bankKeeper.GetSupply(ctx, denom).Amount
+}
+
+// hasChannelOrClient returns true if a channel of the transfer port or a client with the provided identifier exists.
+func (k Keeper) hasChannelOrClient(ctx context.Context, channelOrClientID string) bool {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelOrClientID); found {
+		return true
+	}
+
+	_, found := k.clientKeeper.GetClientState(ctx, channelOrClientID)
+	return found
+}
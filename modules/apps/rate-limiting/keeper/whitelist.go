@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+)
+
+// setWhitelistedAddressPair stores the pair of sender and receiver addresses whose transfers are not rate limited.
+func (k Keeper) setWhitelistedAddressPair(ctx context.Context, addressPair types.WhitelistedAddressPair) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&addressPair)
+	if err := store.Set(types.KeyWhitelistedAddressPair(addressPair.Sender, addressPair.Receiver), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteWhitelistedAddressPair deletes the whitelisted pair of sender and receiver addresses.
+func (k Keeper) DeleteWhitelistedAddressPair(ctx context.Context, sender, receiver string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyWhitelistedAddressPair(sender, receiver)); err != nil {
+		panic(err)
+	}
+}
+
+// IsAddressPairWhitelisted returns true if transfers from the sender to the receiver are not rate limited.
+func (k Keeper) IsAddressPairWhitelisted(ctx context.Context, sender, receiver string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.KeyWhitelistedAddressPair(sender, receiver))
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
+
+// GetAllWhitelistedAddressPairs returns all the whitelisted pairs of sender and receiver addresses stored in state.
+func (k Keeper) GetAllWhitelistedAddressPairs(ctx context.Context) []types.WhitelistedAddressPair {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.WhitelistedAddressPairKeyPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	addressPairs := []types.WhitelistedAddressPair{}
+	for ; iterator.Valid(); iterator.Next() {
+		var addressPair types.WhitelistedAddressPair
+		k.cdc.MustUnmarshal(iterator.Value(), &addressPair)
+
+		addressPairs = append(addressPairs, addressPair)
+	}
+
+	return addressPairs
+}
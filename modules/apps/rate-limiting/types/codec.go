@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary rate-limiting interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgAddRateLimit{}, "cosmos-sdk/MsgAddRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateRateLimit{}, "cosmos-sdk/MsgUpdateRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveRateLimit{}, "cosmos-sdk/MsgRemoveRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgResetRateLimit{}, "cosmos-sdk/MsgResetRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetWhitelistedAddressPair{}, "cosmos-sdk/MsgSetWhitelistedAddressPair")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveWhitelistedAddressPair{}, "cosmos-sdk/MsgRemoveWhitelistedAddrPair")
+}
+
+// RegisterInterfaces register the rate-limiting module interfaces to protobuf
+// Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgAddRateLimit{},
+		&MsgUpdateRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimit{},
+		&MsgSetWhitelistedAddressPair{},
+		&MsgRemoveWhitelistedAddressPair{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// ModuleCdc references the global rate-limiting module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+//
+// The actual codec used for serialization should be provided to the rate-limiting
+// module and defined at the application level.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
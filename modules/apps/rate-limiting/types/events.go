@@ -0,0 +1,10 @@
+package types
+
+// rate-limiting events
+const (
+	EventTypeRateLimitReset = "rate_limit_reset"
+
+	AttributeKeyDenom             = "denom"
+	AttributeKeyChannelOrClientID = "channel_or_client_id"
+	AttributeKeyChannelValue      = "channel_value"
+)
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx context.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx context.Context, clientID string) (ibcexported.ClientState, bool)
+}
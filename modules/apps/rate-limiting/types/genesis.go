@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a rate-limiting GenesisState instance.
+func NewGenesisState(
+	rateLimits []RateLimit,
+	whitelistedAddressPairs []WhitelistedAddressPair,
+	pendingSendPackets []PendingSendPacket,
+	hourEpoch HourEpoch,
+) *GenesisState {
+	return &GenesisState{
+		RateLimits:              rateLimits,
+		WhitelistedAddressPairs: whitelistedAddressPairs,
+		PendingSendPackets:      pendingSendPackets,
+		HourEpoch:               hourEpoch,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate-limiting GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:              []RateLimit{},
+		WhitelistedAddressPairs: []WhitelistedAddressPair{},
+		PendingSendPackets:      []PendingSendPacket{},
+		HourEpoch: HourEpoch{
+			EpochNumber: 0,
+			Duration:    time.Hour,
+		},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	rateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId))
+		if rateLimits[key] {
+			return errorsmod.Wrapf(ErrDuplicateRateLimit, "denom (%s), channel or client (%s)", rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId)
+		}
+
+		rateLimits[key] = true
+	}
+
+	addressPairs := make(map[string]bool)
+	for _, addressPair := range gs.WhitelistedAddressPairs {
+		if err := addressPair.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyWhitelistedAddressPair(addressPair.Sender, addressPair.Receiver))
+		if addressPairs[key] {
+			return errorsmod.Wrapf(ErrDuplicateWhitelistedAddress, "sender (%s), receiver (%s)", addressPair.Sender, addressPair.Receiver)
+		}
+
+		addressPairs[key] = true
+	}
+
+	pendingSendPackets := make(map[string]bool)
+	for _, pendingPacket := range gs.PendingSendPackets {
+		if err := pendingPacket.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyPendingSendPacket(pendingPacket.ChannelOrClientId, pendingPacket.Sequence, pendingPacket.Denom))
+		if pendingSendPackets[key] {
+			return errorsmod.Wrapf(ErrInvalidPendingSendPacket, "duplicate pending send packet: denom (%s), channel or client (%s), sequence (%d)", pendingPacket.Denom, pendingPacket.ChannelOrClientId, pendingPacket.Sequence)
+		}
+
+		pendingSendPackets[key] = true
+	}
+
+	if gs.HourEpoch.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidHourEpoch, "epoch duration must be positive, got %s", gs.HourEpoch.Duration)
+	}
+
+	return nil
+}
@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/rate_limiting/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the rate limiting middleware genesis state
+type GenesisState struct {
+	// the rate limits and their current flow
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// the sender and receiver pairs which are not rate limited
+	WhitelistedAddressPairs []WhitelistedAddressPair `protobuf:"bytes,2,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs"`
+	// the rate limited amounts of the packets sent in the current window
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,3,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets"`
+	// the current hourly epoch
+	HourEpoch HourEpoch `protobuf:"bytes,4,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f0dbc611075e553, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWhitelistedAddressPairs() []WhitelistedAddressPair {
+	if m != nil {
+		return m.WhitelistedAddressPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHourEpoch() HourEpoch {
+	if m != nil {
+		return m.HourEpoch
+	}
+	return HourEpoch{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.rate_limiting.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/rate_limiting/v1/genesis.proto", fileDescriptor_0f0dbc611075e553)
+}
+
+var fileDescriptor_0f0dbc611075e553 = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x93, 0xdb, 0x72, 0xe1, 0x4e, 0xef, 0x2a, 0x14, 0x6e, 0x6e, 0x17, 0xb1, 0xba, 0x72,
+	0x61, 0x33, 0xd4, 0x3f, 0x8b, 0x82, 0x1b, 0x05, 0xd1, 0x85, 0x8b, 0xda, 0x82, 0x82, 0x9b, 0x30,
+	0x49, 0x0e, 0xc9, 0x60, 0x92, 0x19, 0xe6, 0x4c, 0x5a, 0xc4, 0x97, 0xf0, 0x15, 0x7c, 0x9b, 0x2e,
+	0xbb, 0x74, 0x25, 0xd2, 0xbe, 0x88, 0x24, 0xa9, 0xad, 0x15, 0xa1, 0xdd, 0x25, 0x33, 0xe7, 0xf7,
+	0xfd, 0x0e, 0xc3, 0x47, 0x28, 0xf7, 0x03, 0xca, 0xa4, 0x4c, 0x78, 0xc0, 0x34, 0x17, 0x19, 0x52,
+	0xc5, 0x34, 0x78, 0x09, 0x4f, 0xb9, 0xe6, 0x59, 0x44, 0x47, 0x5d, 0x1a, 0x41, 0x06, 0xc8, 0xd1,
+	0x95, 0x4a, 0x68, 0x61, 0xed, 0x72, 0x3f, 0x70, 0xbf, 0x02, 0xee, 0x1a, 0xe0, 0x8e, 0xba, 0xad,
+	0x66, 0x24, 0x22, 0x51, 0x4e, 0xd3, 0xe2, 0xab, 0x02, 0x5b, 0x27, 0x9b, 0x4d, 0xeb, 0x49, 0x25,
+	0xb6, 0xf7, 0x52, 0x23, 0x7f, 0x2f, 0xab, 0x0d, 0x86, 0x9a, 0x69, 0xb0, 0x86, 0xa4, 0xb1, 0x9a,
+	0x43, 0xdb, 0x6c, 0xd7, 0xf6, 0x1b, 0x87, 0x07, 0xee, 0xc6, 0xb5, 0xdc, 0x01, 0xd3, 0x70, 0x5d,
+	0xfc, 0x9f, 0xd7, 0x27, 0x6f, 0x3b, 0xc6, 0x80, 0xa8, 0xcf, 0x03, 0xb4, 0x9e, 0xc8, 0xff, 0x71,
+	0xcc, 0x35, 0x24, 0x1c, 0x35, 0x84, 0x1e, 0x0b, 0x43, 0x05, 0x88, 0x9e, 0x64, 0x5c, 0xa1, 0xfd,
+	0xab, 0x54, 0xf4, 0xb6, 0x50, 0xdc, 0xad, 0x32, 0xce, 0xaa, 0x88, 0x3e, 0xe3, 0x6a, 0xe1, 0xfb,
+	0x37, 0xfe, 0xf1, 0x16, 0xad, 0x84, 0x34, 0x25, 0x64, 0x21, 0xcf, 0x22, 0x0f, 0x21, 0x0b, 0x3d,
+	0xc9, 0x82, 0x07, 0xd0, 0x68, 0xd7, 0x4a, 0xef, 0xf1, 0x16, 0xde, 0x7e, 0x85, 0x0f, 0x21, 0x0b,
+	0xfb, 0x25, 0xbc, 0x50, 0x5a, 0xf2, 0xfb, 0x05, 0x5a, 0x37, 0x84, 0xc4, 0x22, 0x57, 0x1e, 0x48,
+	0x11, 0xc4, 0x76, 0xbd, 0x6d, 0x6e, 0xf9, 0x7c, 0x57, 0x22, 0x57, 0x17, 0x05, 0xb3, 0xc8, 0xfe,
+	0x13, 0x2f, 0x0f, 0x6e, 0x27, 0x33, 0xc7, 0x9c, 0xce, 0x1c, 0xf3, 0x7d, 0xe6, 0x98, 0xcf, 0x73,
+	0xc7, 0x98, 0xce, 0x1d, 0xe3, 0x75, 0xee, 0x18, 0xf7, 0xa7, 0x11, 0xd7, 0x71, 0xee, 0xbb, 0x81,
+	0x48, 0x69, 0x20, 0x30, 0x15, 0x58, 0x14, 0xae, 0x13, 0x09, 0x3a, 0xea, 0xd1, 0x54, 0x84, 0x79,
+	0x02, 0x58, 0x94, 0xa2, 0x2a, 0x43, 0x67, 0x59, 0x06, 0xfd, 0x28, 0x01, 0xfd, 0xdf, 0x65, 0x05,
+	0x8e, 0x3e, 0x06, 0x00, 0x72, 0x2e, 0x2f, 0x00, 0xa5, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HourEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WhitelistedAddressPairs) > 0 {
+		for iNdEx := len(m.WhitelistedAddressPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedAddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhitelistedAddressPairs) > 0 {
+		for _, e := range m.WhitelistedAddressPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.HourEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedAddressPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedAddressPairs = append(m.WhitelistedAddressPairs, WhitelistedAddressPair{})
+			if err := m.WhitelistedAddressPairs[len(m.WhitelistedAddressPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
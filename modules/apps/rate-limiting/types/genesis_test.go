@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestValidateDefaultGenesis(t *testing.T) {
+	err := types.DefaultGenesisState().Validate()
+	require.NoError(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid rate limit",
+			func() {
+				genState.RateLimits[0].Quota.DurationHours = 0
+			},
+			types.ErrInvalidQuota,
+		},
+		{
+			"failure: duplicate rate limit",
+			func() {
+				genState.RateLimits = append(genState.RateLimits, genState.RateLimits[0])
+			},
+			types.ErrDuplicateRateLimit,
+		},
+		{
+			"failure: duplicate whitelisted address pair",
+			func() {
+				genState.WhitelistedAddressPairs = append(genState.WhitelistedAddressPairs, genState.WhitelistedAddressPairs[0])
+			},
+			types.ErrDuplicateWhitelistedAddress,
+		},
+		{
+			"failure: duplicate pending send packet",
+			func() {
+				genState.PendingSendPackets = append(genState.PendingSendPackets, genState.PendingSendPackets[0])
+			},
+			types.ErrInvalidPendingSendPacket,
+		},
+		{
+			"failure: invalid pending send packet channel",
+			func() {
+				genState.PendingSendPackets[0].ChannelOrClientId = ""
+			},
+			types.ErrInvalidPendingSendPacket,
+		},
+		{
+			"failure: zero pending send packet sequence",
+			func() {
+				genState.PendingSendPackets[0].Sequence = 0
+			},
+			types.ErrInvalidPendingSendPacket,
+		},
+		{
+			"failure: zero pending send packet amount",
+			func() {
+				genState.PendingSendPackets[0].Amount = sdkmath.ZeroInt()
+			},
+			types.ErrInvalidPendingSendPacket,
+		},
+		{
+			"failure: zero epoch duration",
+			func() {
+				genState.HourEpoch.Duration = 0
+			},
+			types.ErrInvalidHourEpoch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+			rateLimit := types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID), quota, sdkmath.NewInt(1000))
+
+			genState = types.NewGenesisState(
+				[]types.RateLimit{rateLimit},
+				[]types.WhitelistedAddressPair{types.NewWhitelistedAddressPair(defaultAccAddress, "receiver")},
+				[]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, sdk.DefaultBondDenom, sdkmath.NewInt(10))},
+				types.DefaultGenesisState().HourEpoch,
+			)
+
+			tc.malleate()
+
+			err := genState.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
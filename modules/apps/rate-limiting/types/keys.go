@@ -0,0 +1,68 @@
+package types
+
+import "fmt"
+
+const (
+	// ModuleName defines the rate-limiting module name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate-limiting module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate-limiting module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate-limiting module
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for the rate limited amounts of sent packets which count
+	// towards the outflow of the current window
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+
+	// WhitelistedAddressPairKeyPrefix is the key prefix for whitelisted sender and receiver address pairs
+	WhitelistedAddressPairKeyPrefix = "whitelistedAddressPair"
+
+	// HourEpochKey is the key for the hour epoch stored in state
+	HourEpochKey = "hourEpoch"
+)
+
+// KeyRateLimitsByChannelOrClient returns the key prefix under which the rate limits of the provided channel or client are stored
+func KeyRateLimitsByChannelOrClient(channelOrClientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RateLimitKeyPrefix, channelOrClientID))
+}
+
+// KeyRateLimit returns the key under which the rate limit of the provided denom and channel or client is stored
+func KeyRateLimit(denom, channelOrClientID string) []byte {
+	return append(KeyRateLimitsByChannelOrClient(channelOrClientID), []byte(denom)...)
+}
+
+// KeyPendingSendPacketsByChannelOrClient returns the key prefix under which the pending send packets
+// of the provided channel or client are stored
+func KeyPendingSendPacketsByChannelOrClient(channelOrClientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", PendingSendPacketKeyPrefix, channelOrClientID))
+}
+
+// KeyPendingSendPacketsBySequence returns the key prefix under which the rate limited amounts of the pending send
+// packet with the provided channel or client and sequence are stored
+func KeyPendingSendPacketsBySequence(channelOrClientID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/", PendingSendPacketKeyPrefix, channelOrClientID, sequence))
+}
+
+// KeyPendingSendPacket returns the key under which the rate limited amount of the provided denom of the pending send
+// packet with the provided channel or client and sequence is stored
+func KeyPendingSendPacket(channelOrClientID string, sequence uint64, denom string) []byte {
+	return append(KeyPendingSendPacketsBySequence(channelOrClientID, sequence), []byte(denom)...)
+}
+
+// KeyWhitelistedAddressPair returns the key under which the whitelisted pair of sender and receiver addresses is stored
+func KeyWhitelistedAddressPair(sender, receiver string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", WhitelistedAddressPairKeyPrefix, sender, receiver))
+}
+
+// KeyHourEpoch returns the key under which the hour epoch is stored
+func KeyHourEpoch() []byte {
+	return []byte(HourEpochKey)
+}
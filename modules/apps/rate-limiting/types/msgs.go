@@ -0,0 +1,157 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgAddRateLimit)(nil)
+	_ sdk.Msg = (*MsgUpdateRateLimit)(nil)
+	_ sdk.Msg = (*MsgRemoveRateLimit)(nil)
+	_ sdk.Msg = (*MsgResetRateLimit)(nil)
+	_ sdk.Msg = (*MsgSetWhitelistedAddressPair)(nil)
+	_ sdk.Msg = (*MsgRemoveWhitelistedAddressPair)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgAddRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgResetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetWhitelistedAddressPair)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveWhitelistedAddressPair)(nil)
+)
+
+// NewMsgAddRateLimit creates a new instance of MsgAddRateLimit
+func NewMsgAddRateLimit(signer, denom, channelOrClientID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+		MaxPercentSend:    maxPercentSend,
+		MaxPercentRecv:    maxPercentRecv,
+		DurationHours:     durationHours,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgAddRateLimit.
+func (msg *MsgAddRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelOrClientId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// NewMsgUpdateRateLimit creates a new instance of MsgUpdateRateLimit
+func NewMsgUpdateRateLimit(signer, denom, channelOrClientID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+		MaxPercentSend:    maxPercentSend,
+		MaxPercentRecv:    maxPercentRecv,
+		DurationHours:     durationHours,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateRateLimit.
+func (msg *MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelOrClientId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// NewMsgRemoveRateLimit creates a new instance of MsgRemoveRateLimit
+func NewMsgRemoveRateLimit(signer, denom, channelOrClientID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRemoveRateLimit.
+func (msg *MsgRemoveRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelOrClientId).Validate()
+}
+
+// NewMsgResetRateLimit creates a new instance of MsgResetRateLimit
+func NewMsgResetRateLimit(signer, denom, channelOrClientID string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgResetRateLimit.
+func (msg *MsgResetRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelOrClientId).Validate()
+}
+
+// NewMsgSetWhitelistedAddressPair creates a new instance of MsgSetWhitelistedAddressPair
+func NewMsgSetWhitelistedAddressPair(signer, sender, receiver string) *MsgSetWhitelistedAddressPair {
+	return &MsgSetWhitelistedAddressPair{
+		Signer:   signer,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgSetWhitelistedAddressPair.
+func (msg *MsgSetWhitelistedAddressPair) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewWhitelistedAddressPair(msg.Sender, msg.Receiver).Validate()
+}
+
+// NewMsgRemoveWhitelistedAddressPair creates a new instance of MsgRemoveWhitelistedAddressPair
+func NewMsgRemoveWhitelistedAddressPair(signer, sender, receiver string) *MsgRemoveWhitelistedAddressPair {
+	return &MsgRemoveWhitelistedAddressPair{
+		Signer:   signer,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRemoveWhitelistedAddressPair.
+func (msg *MsgRemoveWhitelistedAddressPair) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewWhitelistedAddressPair(msg.Sender, msg.Receiver).Validate()
+}
+
+// validateSigner returns an error if the signer is not a valid bech32 address.
+func validateSigner(signer string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	ratelimiting "github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting"
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestMsgAddRateLimitValidation(t *testing.T) {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid channel or client identifier",
+			func() {
+				msg.ChannelOrClientId = "(invalid)"
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid quota",
+			func() {
+				msg.DurationHours = 0
+			},
+			types.ErrInvalidQuota,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgAddRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateRateLimitValidation(t *testing.T) {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: empty denom",
+			func() {
+				msg.Denom = ""
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"failure: invalid quota",
+			func() {
+				msg.MaxPercentSend = sdkmath.NewInt(101)
+			},
+			types.ErrInvalidQuota,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgUpdateRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveAndResetRateLimitValidation(t *testing.T) {
+	require.NoError(t, types.NewMsgRemoveRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgRemoveRateLimit(invalidAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID).ValidateBasic(), ibcerrors.ErrInvalidAddress)
+	require.ErrorIs(t, types.NewMsgRemoveRateLimit(defaultAccAddress, "", ibctesting.FirstChannelID).ValidateBasic(), ibcerrors.ErrInvalidCoins)
+
+	require.NoError(t, types.NewMsgResetRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstClientID).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgResetRateLimit(invalidAddress, sdk.DefaultBondDenom, ibctesting.FirstClientID).ValidateBasic(), ibcerrors.ErrInvalidAddress)
+	require.ErrorIs(t, types.NewMsgResetRateLimit(defaultAccAddress, sdk.DefaultBondDenom, "").ValidateBasic(), host.ErrInvalidID)
+}
+
+func TestMsgWhitelistedAddressPairValidation(t *testing.T) {
+	require.NoError(t, types.NewMsgSetWhitelistedAddressPair(defaultAccAddress, defaultAccAddress, "receiver").ValidateBasic())
+	require.ErrorIs(t, types.NewMsgSetWhitelistedAddressPair(invalidAddress, defaultAccAddress, "receiver").ValidateBasic(), ibcerrors.ErrInvalidAddress)
+	require.ErrorIs(t, types.NewMsgSetWhitelistedAddressPair(defaultAccAddress, defaultAccAddress, "").ValidateBasic(), ibcerrors.ErrInvalidAddress)
+
+	require.NoError(t, types.NewMsgRemoveWhitelistedAddressPair(defaultAccAddress, defaultAccAddress, "receiver").ValidateBasic())
+	require.ErrorIs(t, types.NewMsgRemoveWhitelistedAddressPair(defaultAccAddress, "", "receiver").ValidateBasic(), ibcerrors.ErrInvalidAddress)
+}
+
+func TestMsgAddRateLimitGetSigners(t *testing.T) {
+	msg := types.NewMsgAddRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID, sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(ratelimiting.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+
+	expSigner, err := sdk.AccAddressFromBech32(defaultAccAddress)
+	require.NoError(t, err)
+	require.Equal(t, expSigner.Bytes(), signers[0])
+}
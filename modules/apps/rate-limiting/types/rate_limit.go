@@ -0,0 +1,192 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// maxPercent is the maximum percentage of the channel value that a quota may allow to be transferred.
+var maxPercent = sdkmath.NewInt(100)
+
+// NewPath creates a new Path instance.
+func NewPath(denom, channelOrClientID string) Path {
+	return Path{
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// Validate performs a basic validation of the Path fields.
+func (p Path) Validate() error {
+	if strings.TrimSpace(p.Denom) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "denom cannot be empty")
+	}
+
+	// the identifier validation of channels is used as it also accepts all valid client identifiers.
+	if err := host.ChannelIdentifierValidator(p.ChannelOrClientId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel or client identifier %s", p.ChannelOrClientId)
+	}
+
+	return nil
+}
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs a basic validation of the Quota fields. The percentages must be between 0 and 100 and
+// cannot both be zero, and the window must last at least one hour.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(maxPercent) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max percent send must be between 0 and 100, got %s", q.MaxPercentSend)
+	}
+
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(maxPercent) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max percent recv must be between 0 and 100, got %s", q.MaxPercentRecv)
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max percent send and max percent recv cannot both be zero")
+	}
+
+	if q.DurationHours == 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "duration hours cannot be zero")
+	}
+
+	return nil
+}
+
+// CheckExceedsQuota returns true if the amount is greater than the percentage of the channel value
+// allowed by the quota in the provided direction. A zero channel value never exceeds the quota.
+func (q Quota) CheckExceedsQuota(direction PacketDirection, amount, channelValue sdkmath.Int) bool {
+	if channelValue.IsZero() {
+		return false
+	}
+
+	maxPercentage := q.MaxPercentSend
+	if direction == PACKET_RECV {
+		maxPercentage = q.MaxPercentRecv
+	}
+
+	threshold := channelValue.Mul(maxPercentage).Quo(maxPercent)
+	return amount.GT(threshold)
+}
+
+// NewFlow creates a new Flow instance with zero inflow and outflow.
+func NewFlow(channelValue sdkmath.Int) Flow {
+	return Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// AddInflow adds the amount to the inflow if the resulting net inflow does not exceed the quota.
+func (f *Flow) AddInflow(amount sdkmath.Int, quota Quota) error {
+	netInflow := f.Inflow.Sub(f.Outflow).Add(amount)
+	if quota.CheckExceedsQuota(PACKET_RECV, netInflow, f.ChannelValue) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "inflow exceeds quota: net inflow (%s), channel value (%s), max percent recv (%s)", netInflow, f.ChannelValue, quota.MaxPercentRecv)
+	}
+
+	f.Inflow = f.Inflow.Add(amount)
+	return nil
+}
+
+// AddOutflow adds the amount to the outflow if the resulting net outflow does not exceed the quota.
+func (f *Flow) AddOutflow(amount sdkmath.Int, quota Quota) error {
+	netOutflow := f.Outflow.Sub(f.Inflow).Add(amount)
+	if quota.CheckExceedsQuota(PACKET_SEND, netOutflow, f.ChannelValue) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "outflow exceeds quota: net outflow (%s), channel value (%s), max percent send (%s)", netOutflow, f.ChannelValue, quota.MaxPercentSend)
+	}
+
+	f.Outflow = f.Outflow.Add(amount)
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance with an empty flow.
+func NewRateLimit(path Path, quota Quota, channelValue sdkmath.Int) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  NewFlow(channelValue),
+	}
+}
+
+// Validate performs a basic validation of the RateLimit fields.
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+
+	if r.Flow.Inflow.IsNil() || r.Flow.Inflow.IsNegative() || r.Flow.Outflow.IsNil() || r.Flow.Outflow.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidQuota, "inflow and outflow cannot be negative")
+	}
+
+	if r.Flow.ChannelValue.IsNil() || r.Flow.ChannelValue.IsNegative() {
+		return errorsmod.Wrap(ErrZeroChannelValue, "channel value cannot be negative")
+	}
+
+	return nil
+}
+
+// NewWhitelistedAddressPair creates a new WhitelistedAddressPair instance.
+func NewWhitelistedAddressPair(sender, receiver string) WhitelistedAddressPair {
+	return WhitelistedAddressPair{
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// Validate performs a basic validation of the WhitelistedAddressPair fields.
+func (p WhitelistedAddressPair) Validate() error {
+	if strings.TrimSpace(p.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+
+	if strings.TrimSpace(p.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be empty")
+	}
+
+	return nil
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance.
+func NewPendingSendPacket(channelOrClientID string, sequence uint64, denom string, amount sdkmath.Int) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelOrClientId: channelOrClientID,
+		Sequence:          sequence,
+		Denom:             denom,
+		Amount:            amount,
+	}
+}
+
+// Validate performs a basic validation of the PendingSendPacket fields.
+func (p PendingSendPacket) Validate() error {
+	if err := NewPath(p.Denom, p.ChannelOrClientId).Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPendingSendPacket, "%s", err)
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, "sequence cannot be zero")
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidPendingSendPacket, "amount must be positive, got %s", p.Amount)
+	}
+
+	return nil
+}
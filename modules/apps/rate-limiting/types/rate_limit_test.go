@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var defaultAccAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+const invalidAddress = "invalid-address"
+
+func TestValidateRateLimit(t *testing.T) {
+	var rateLimit types.RateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: client identifier",
+			func() {
+				rateLimit.Path.ChannelOrClientId = ibctesting.FirstClientID
+			},
+			nil,
+		},
+		{
+			"success: zero max percent recv",
+			func() {
+				rateLimit.Quota.MaxPercentRecv = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"failure: empty denom",
+			func() {
+				rateLimit.Path.Denom = ""
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"failure: invalid channel or client identifier",
+			func() {
+				rateLimit.Path.ChannelOrClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: max percent send greater than 100",
+			func() {
+				rateLimit.Quota.MaxPercentSend = sdkmath.NewInt(101)
+			},
+			types.ErrInvalidQuota,
+		},
+		{
+			"failure: negative max percent recv",
+			func() {
+				rateLimit.Quota.MaxPercentRecv = sdkmath.NewInt(-1)
+			},
+			types.ErrInvalidQuota,
+		},
+		{
+			"failure: max percent send and max percent recv are zero",
+			func() {
+				rateLimit.Quota.MaxPercentSend = sdkmath.ZeroInt()
+				rateLimit.Quota.MaxPercentRecv = sdkmath.ZeroInt()
+			},
+			types.ErrInvalidQuota,
+		},
+		{
+			"failure: zero duration hours",
+			func() {
+				rateLimit.Quota.DurationHours = 0
+			},
+			types.ErrInvalidQuota,
+		},
+		{
+			"failure: negative channel value",
+			func() {
+				rateLimit.Flow.ChannelValue = sdkmath.NewInt(-1)
+			},
+			types.ErrZeroChannelValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 24)
+			rateLimit = types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID), quota, sdkmath.NewInt(1000))
+
+			tc.malleate()
+
+			err := rateLimit.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestCheckExceedsQuota(t *testing.T) {
+	quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 1)
+
+	testCases := []struct {
+		name         string
+		direction    types.PacketDirection
+		amount       sdkmath.Int
+		channelValue sdkmath.Int
+		expExceeds   bool
+	}{
+		{"send: amount below threshold", types.PACKET_SEND, sdkmath.NewInt(99), sdkmath.NewInt(1000), false},
+		{"send: amount equal to threshold", types.PACKET_SEND, sdkmath.NewInt(100), sdkmath.NewInt(1000), false},
+		{"send: amount above threshold", types.PACKET_SEND, sdkmath.NewInt(101), sdkmath.NewInt(1000), true},
+		{"recv: amount equal to threshold", types.PACKET_RECV, sdkmath.NewInt(200), sdkmath.NewInt(1000), false},
+		{"recv: amount above threshold", types.PACKET_RECV, sdkmath.NewInt(201), sdkmath.NewInt(1000), true},
+		{"zero channel value", types.PACKET_SEND, sdkmath.NewInt(1000), sdkmath.ZeroInt(), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expExceeds, quota.CheckExceedsQuota(tc.direction, tc.amount, tc.channelValue))
+		})
+	}
+}
+
+func TestFlow(t *testing.T) {
+	quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 1)
+	flow := types.NewFlow(sdkmath.NewInt(1000))
+
+	// outflow up to the quota is allowed
+	require.NoError(t, flow.AddOutflow(sdkmath.NewInt(100), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdkmath.NewInt(1), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(100), flow.Outflow)
+
+	// the inflow is computed net of the outflow
+	require.NoError(t, flow.AddInflow(sdkmath.NewInt(200), quota))
+	require.ErrorIs(t, flow.AddInflow(sdkmath.NewInt(1), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(200), flow.Inflow)
+
+	// the net outflow is now negative, such that the outflow can increase again
+	require.NoError(t, flow.AddOutflow(sdkmath.NewInt(200), quota))
+	require.Equal(t, sdkmath.NewInt(300), flow.Outflow)
+}
+
+func TestValidateWhitelistedAddressPair(t *testing.T) {
+	require.NoError(t, types.NewWhitelistedAddressPair(defaultAccAddress, "receiver").Validate())
+	require.ErrorIs(t, types.NewWhitelistedAddressPair("", "receiver").Validate(), ibcerrors.ErrInvalidAddress)
+	require.ErrorIs(t, types.NewWhitelistedAddressPair(defaultAccAddress, " ").Validate(), ibcerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,191 @@
+package v2
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/rate-limiting/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var (
+	_ api.IBCModule  = (*IBCMiddleware)(nil)
+	_ api.Middleware = (*IBCMiddleware)(nil)
+
+	_ api.AsyncAcknowledgementExpiryExempt = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the IBC v2 middleware interface for the rate-limiting middleware
+// given the rate-limiting keeper and the underlying application.
+type IBCMiddleware struct {
+	app             api.IBCModule
+	writeAckWrapper api.WriteAcknowledgementWrapper
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper. The underlying application and the
+// WriteAcknowledgementWrapper are set when the middleware is added to an IBC application stack.
+func NewIBCMiddleware(k keeper.Keeper) *IBCMiddleware {
+	return &IBCMiddleware{
+		keeper: k,
+	}
+}
+
+// SetUnderlyingApplication sets the underlying IBC module. This function may be used after
+// the middleware's creation to set the IBC module which is below this middleware in the IBC application stack.
+func (im *IBCMiddleware) SetUnderlyingApplication(app api.IBCModule) {
+	im.app = app
+}
+
+// WithWriteAckWrapper sets the WriteAcknowledgementWrapper for the middleware.
+func (im *IBCMiddleware) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	im.writeAckWrapper = writeAckWrapper
+}
+
+// OnSendPacket defers to the underlying application and then updates the outflow of the rate limits of the
+// sent tokens. The packet send is rejected if the outflow of any of the tokens exceeds its quota.
+func (im *IBCMiddleware) OnSendPacket(
+	ctx context.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+
+	if payload.SourcePort != transfertypes.PortID {
+		return nil
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.SendRateLimitedPacket(ctx, sourceClient, sequence, data)
+}
+
+// OnRecvPacket updates the inflow of the rate limits of the received tokens before the packet is passed to the
+// underlying application. An error acknowledgement is returned if the inflow of any of the tokens exceeds its quota.
+func (im *IBCMiddleware) OnRecvPacket(
+	ctx context.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	if payload.DestinationPort != transfertypes.PortID {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		// the underlying application rejects packets which cannot be unmarshaled.
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	if err := im.keeper.ReceiveRateLimitedPacket(ctx, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient, data); err != nil {
+		im.keeper.Logger(ctx).Error("rate limit exceeded for received packet", "sequence", sequence, "error", err)
+		return channeltypesv2.RecvPacketResult{
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: channeltypesv2.NewErrorAppAcknowledgement(err),
+		}
+	}
+
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket reverts the outflow of the rate limits of the sent tokens if the packet was acknowledged
+// with an error acknowledgement, as the tokens are refunded to the sender, and then defers to the underlying application.
+// The acknowledgement is either the IBC v2 error acknowledgement or an ICS20 acknowledgement encoded according to the
+// encoding of the payload.
+func (im *IBCMiddleware) OnAcknowledgementPacket(
+	ctx context.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if payload.SourcePort != transfertypes.PortID {
+		return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	}
+
+	isErrorAck := channeltypesv2.IsErrorAppAcknowledgement(acknowledgement)
+	if !isErrorAck {
+		ack, err := transfertypes.UnmarshalAcknowledgement(acknowledgement, payload.Version, payload.Encoding)
+		if err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+		}
+
+		isErrorAck = !ack.Success()
+	}
+
+	if isErrorAck {
+		im.keeper.UndoSendRateLimitedPacket(ctx, sourceClient, sequence)
+	} else {
+		im.keeper.AcknowledgeRateLimitedPacket(ctx, sourceClient, sequence)
+	}
+
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// OnTimeoutPacket reverts the outflow of the rate limits of the sent tokens, as the tokens are refunded to the
+// sender, and then defers to the underlying application.
+func (im *IBCMiddleware) OnTimeoutPacket(
+	ctx context.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if payload.SourcePort != transfertypes.PortID {
+		return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	im.keeper.UndoSendRateLimitedPacket(ctx, sourceClient, sequence)
+
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// WriteAcknowledgement passes the asynchronous acknowledgement written by the underlying application on to the
+// next middleware or core IBC.
+func (im *IBCMiddleware) WriteAcknowledgement(
+	ctx context.Context,
+	clientID string,
+	sequence uint64,
+	ack channeltypesv2.Acknowledgement,
+) error {
+	return im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+// UnmarshalPacketData attempts to use the underlying app to unmarshal the packet data.
+// If the underlying app does not support the PacketDataUnmarshaler interface, an error is returned.
+func (im *IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (interface{}, error) {
+	unmarshaler, ok := im.app.(api.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "underlying app does not implement %T", (*api.PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(payload)
+}
+
+// ExemptFromAsyncAcknowledgementExpiry returns true if the underlying app has opted out of the expiry
+// of packets awaiting an asynchronous acknowledgement.
+func (im *IBCMiddleware) ExemptFromAsyncAcknowledgementExpiry() bool {
+	exempt, ok := im.app.(api.AsyncAcknowledgementExpiryExempt)
+	return ok && exempt.ExemptFromAsyncAcknowledgementExpiry()
+}
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryTransferEnabled(),
+		GetCmdQueryAllTransferEnabled(),
 	)
 
 	return queryCmd
@@ -39,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewTransferV2TxCmd(),
 	)
 
 	return txCmd
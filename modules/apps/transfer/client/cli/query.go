@@ -200,3 +200,78 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTransferEnabled defines the command to query whether tokens of a denomination can be sent and received.
+func GetCmdQueryTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-enabled [denom] [channel-or-client-id]",
+		Short:   "Query whether tokens of a denom can be sent and received",
+		Long:    "Query whether tokens of a denom can be sent and received over a channel or client. Only the transfers enabled over all channels and clients are considered if the channel or client identifier is omitted.",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-enabled uosmo channel-0", version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransferEnabledRequest{
+				Denom: args[0],
+			}
+
+			if len(args) > 1 {
+				req.ChannelOrClientId = args[1]
+			}
+
+			res, err := queryClient.TransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAllTransferEnabled defines the command to query all the entries enabling or disabling the transfers of a denomination.
+func GetCmdQueryAllTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "all-transfer-enabled",
+		Short:   "Query for all the entries enabling or disabling the transfers of a denom",
+		Long:    "Query for all the entries enabling or disabling the transfers of a denom",
+		Example: fmt.Sprintf("%s query ibc-transfer all-transfer-enabled", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllTransferEnabledRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllTransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer enabled entries")
+
+	return cmd
+}
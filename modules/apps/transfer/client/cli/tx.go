@@ -25,6 +25,7 @@ const (
 	flagMemo                   = "memo"
 	flagForwarding             = "forwarding"
 	flagUnwind                 = "unwind"
+	flagEncoding               = "encoding"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -33,6 +34,10 @@ const (
 // timeout.
 var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 
+// defaultRelativePacketTimeoutTimestampV2 is the default packet timeout timestamp (in seconds) of
+// IBC v2 transfers relative to the local clock time. The default is currently set to a 10 minute timeout.
+var defaultRelativePacketTimeoutTimestampV2 = uint64((time.Duration(10) * time.Minute).Seconds())
+
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -147,16 +152,125 @@ each intermediary chain. {unwind} and {forwarding} flags can be used together to
 	return cmd
 }
 
+// NewTransferV2TxCmd returns the command to create a NewMsgTransferV2 transaction
+func NewTransferV2TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-v2 [src-client] [receiver] [coins]",
+		Short: "Transfer one or more fungible tokens over an IBC v2 client",
+		Long: strings.TrimSpace(`Transfer one or more fungible tokens over an IBC v2 client. Multiple tokens can be transferred in a single
+packet if the coins list is a comma-separated string (e.g. 100uatom,100uosmo). The timeout timestamp is given in seconds and is added to the
+value of the user's local system clock time unless the {absolute-timeouts} flag is used. If no timeout value is set then a default relative
+timeout value of 10 minutes is used. The packet data is encoded using the {encoding} flag, which defaults to protobuf. Tokens can also be
+automatically forwarded through multiple chains using the {forwarding} flag and specifying a comma-separated list of portID/clientID pairs for
+each intermediary chain.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer transfer-v2 [src-client] [receiver] [coins] --encoding %s", version.AppName, types.EncodingJSON),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress().String()
+
+			srcClient := args[0]
+			receiver := args[1]
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denom := types.ExtractDenomFromPath(coin.Denom)
+					coins[i].Denom = denom.IBCDenom()
+				}
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			hops, err := parseHops(cmd)
+			if err != nil {
+				return err
+			}
+
+			var forwarding *types.Forwarding
+			if len(hops) > 0 {
+				forwarding = types.NewForwarding(false, hops...)
+			}
+
+			// if the timeout is not absolute, CLI users rely solely on local clock time in order to calculate the timeout timestamp.
+			if !absoluteTimeouts {
+				if timeoutTimestamp == 0 {
+					return errors.New("relative timeouts must provide a non zero value timestamp")
+				}
+
+				// use local clock time as reference time for calculating timeout timestamp.
+				now := time.Now().Unix()
+				if now <= 0 {
+					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+				}
+
+				timeoutTimestamp = uint64(now) + timeoutTimestamp
+			}
+
+			msg := types.NewMsgTransferV2(
+				srcClient, coins, sender, receiver, timeoutTimestamp, memo, forwarding, encoding,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestampV2, "Packet timeout timestamp in seconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flag is used as an absolute unix timestamp in seconds.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/clientID pairs.")
+	cmd.Flags().String(flagEncoding, "", fmt.Sprintf("Encoding of the packet data, one of [%s, %s, %s]. Defaults to %s.", types.EncodingProtobuf, types.EncodingJSON, types.EncodingABI, types.EncodingProtobuf))
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // parseForwarding parses the forwarding flag into a Forwarding object or nil if the flag is not specified. If the flag cannot
 // be parsed or the hops aren't in the portID/channelID format an error is returned.
 func parseForwarding(cmd *cobra.Command) (*types.Forwarding, error) {
-	var hops []types.Hop
-
 	unwind, err := cmd.Flags().GetBool(flagUnwind)
 	if err != nil {
 		return nil, err
 	}
-	forwarding := types.NewForwarding(unwind)
+
+	hops, err := parseHops(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewForwarding(unwind, hops...), nil
+}
+
+// parseHops parses the forwarding flag into a list of hops. If the flag cannot be parsed or the hops
+// aren't in the portID/channelID format an error is returned.
+func parseHops(cmd *cobra.Command) ([]types.Hop, error) {
+	var hops []types.Hop
 
 	forwardingString, err := cmd.Flags().GetString(flagForwarding)
 	if err != nil {
@@ -164,8 +278,7 @@ func parseForwarding(cmd *cobra.Command) (*types.Forwarding, error) {
 	}
 
 	if strings.TrimSpace(forwardingString) == "" {
-		// If forwarding not specified, we might have unwind set
-		return forwarding, nil
+		return nil, nil
 	}
 
 	pairs := strings.Split(forwardingString, ",")
@@ -179,8 +292,7 @@ func parseForwarding(cmd *cobra.Command) (*types.Forwarding, error) {
 		hops = append(hops, hop)
 	}
 
-	forwarding.Hops = hops
-	return forwarding, nil
+	return hops, nil
 }
 
 // normalizeArgs takes the positional arguments specified and if the unwind flag
@@ -44,6 +44,11 @@ func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket
 	return k.getAllForwardedPackets(ctx)
 }
 
+// GetAllForwardedPacketsV2 is a wrapper around getAllForwardedPacketsV2 for testing purposes.
+func (k Keeper) GetAllForwardedPacketsV2(ctx sdk.Context) []types.ForwardedPacketV2 {
+	return k.getAllForwardedPacketsV2(ctx)
+}
+
 // CreatePacketDataBytesFromVersion is a wrapper around createPacketDataBytesFromVersion for testing purposes
 func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop) ([]byte, error) {
 	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens, hops)
@@ -58,7 +58,7 @@ func (k Keeper) acknowledgeForwardedPacket(ctx context.Context, forwardedPacket,
 // revertForwardedPacket reverts the logic of receive packet that occurs in the middle chains during a packet forwarding.
 // If the packet fails to be forwarded all the way to the final destination, the state changes on this chain must be reverted
 // before sending back the error acknowledgement to ensure atomic packet forwarding.
-func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket channeltypes.Packet, failedPacketData types.FungibleTokenPacketDataV2) error {
+func (k Keeper) revertForwardedPacket(ctx context.Context, destinationPort, destinationChannel string, failedPacketData types.FungibleTokenPacketDataV2) error {
 	/*
 		Recall that RecvPacket handles an incoming packet depending on the denom of the received funds:
 			1. If the funds are native, then the amount is sent to the receiver from the escrow.
@@ -69,7 +69,7 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 	*/
 
 	forwardingAddr := k.AuthKeeper.GetModuleAddress(types.ModuleName)
-	escrow := types.GetEscrowAddress(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
+	escrow := types.GetEscrowAddress(destinationPort, destinationChannel)
 
 	// we can iterate over the received tokens of forwardedPacket by iterating over the sent tokens of failedPacketData
 	for _, token := range failedPacketData.Tokens {
@@ -80,9 +80,9 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 		}
 
 		// check if the token we received originated on the sender
-		// given that the packet is being reversed, we check the DestinationChannel and DestinationPort
-		// of the forwardedPacket to see if a hop was added to the trace during the receive step
-		if token.Denom.HasPrefix(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel) {
+		// given that the packet is being reversed, we check the destination port and channel
+		// of the forwarded packet to see if a hop was added to the trace during the receive step
+		if token.Denom.HasPrefix(destinationPort, destinationChannel) {
 			if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 				return err
 			}
@@ -96,6 +96,12 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 	return nil
 }
 
+// RevertForwardedPacketV2 reverts the logic of receive packet for an IBC v2 packet received on the provided
+// destination port and client which could not be forwarded all the way to its final destination.
+func (k Keeper) RevertForwardedPacketV2(ctx context.Context, destinationPort, destinationClient string, failedPacketData types.FungibleTokenPacketDataV2) error {
+	return k.revertForwardedPacket(ctx, destinationPort, destinationClient, failedPacketData)
+}
+
 // getReceiverFromPacketData returns either the sender specified in the packet data or the forwarding address
 // if there are still hops left to perform.
 func (k Keeper) getReceiverFromPacketData(data types.FungibleTokenPacketDataV2) (sdk.AccAddress, error) {
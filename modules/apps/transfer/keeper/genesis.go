@@ -28,15 +28,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		forwardKey := forwardPacketState.ForwardKey
 		k.setForwardedPacket(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.Packet)
 	}
+
+	for _, forwardPacketState := range state.ForwardedPacketsV2 {
+		forwardKey := forwardPacketState.ForwardKey
+		k.SetForwardedPacketV2(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.PacketId)
+	}
+
+	for _, transferEnabled := range state.TransferEnabled {
+		k.setTransferEnabled(ctx, transferEnabled)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:           k.GetPort(ctx),
-		Denoms:           k.GetAllDenoms(ctx),
-		Params:           k.GetParams(ctx),
-		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
-		ForwardedPackets: k.getAllForwardedPackets(ctx),
+		PortId:             k.GetPort(ctx),
+		Denoms:             k.GetAllDenoms(ctx),
+		Params:             k.GetParams(ctx),
+		TotalEscrowed:      k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets:   k.getAllForwardedPackets(ctx),
+		TransferEnabled:    k.GetAllTransferEnabled(ctx),
+		ForwardedPacketsV2: k.getAllForwardedPacketsV2(ctx),
 	}
 }
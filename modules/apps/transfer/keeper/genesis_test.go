@@ -31,7 +31,8 @@ func (suite *KeeperTestSuite) TestGenesis() {
 			{[]types.Hop{getHop(3), getHop(2), getHop(1), getHop(0)}, "1000000000000000"},
 			{[]types.Hop{getHop(4), getHop(3), getHop(2), getHop(1), getHop(0)}, "100000000000000000000"},
 		}
-		forwardPackets []types.ForwardedPacket
+		forwardPackets   []types.ForwardedPacket
+		forwardPacketsV2 []types.ForwardedPacketV2
 	)
 
 	for _, traceAndEscrowAmount := range traceAndEscrowAmounts {
@@ -57,11 +58,28 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		}
 	}
 
+	// Store forwarded IBC v2 packets, sequence 47 is encoded with the key path separator
+	for _, sequence := range []uint64{1, 47, 1000} {
+		forwardKey := channeltypes.NewPacketID(ibctesting.TransferPort, ibctesting.FirstClientID, sequence)
+		packetID := channeltypes.NewPacketID(ibctesting.TransferPort, ibctesting.SecondClientID, sequence+1)
+		forwardPacketsV2 = append(forwardPacketsV2, types.ForwardedPacketV2{ForwardKey: forwardKey, PacketId: packetID})
+
+		suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacketV2(suite.chainA.GetContext(), forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, packetID)
+	}
+
+	transferEnabled := []types.TransferEnabled{
+		types.NewTransferEnabled(denoms[0].IBCDenom(), "", false, true),
+		types.NewTransferEnabled(denoms[1].IBCDenom(), "channel-1", true, false),
+	}
+	_, err := suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(suite.chainA.GetContext(), types.NewMsgSetTransferEnabled(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), transferEnabled...))
+	suite.Require().NoError(err)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denoms.Sort(), genesis.Denoms)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().ElementsMatch(transferEnabled, genesis.TransferEnabled)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -74,4 +92,8 @@ func (suite *KeeperTestSuite) TestGenesis() {
 
 	storedForwardedPackets := suite.chainA.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainA.GetContext())
 	suite.Require().Equal(storedForwardedPackets, forwardPackets)
+	suite.Require().ElementsMatch(forwardPacketsV2, genesis.ForwardedPacketsV2)
+	suite.Require().ElementsMatch(forwardPacketsV2, suite.chainA.GetSimApp().TransferKeeper.GetAllForwardedPacketsV2(suite.chainA.GetContext()))
+
+	suite.Require().ElementsMatch(transferEnabled, suite.chainA.GetSimApp().TransferKeeper.GetAllTransferEnabled(suite.chainA.GetContext()))
 }
@@ -152,3 +152,47 @@ func (k Keeper) TotalEscrowForDenom(ctx context.Context, req *types.QueryTotalEs
 		Amount: amount,
 	}, nil
 }
+
+// TransferEnabled implements the TransferEnabled gRPC method.
+func (k Keeper) TransferEnabled(ctx context.Context, req *types.QueryTransferEnabledRequest) (*types.QueryTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewTransferEnabled(req.Denom, req.ChannelOrClientId, true, true).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTransferEnabledResponse{
+		SendEnabled:    k.IsSendEnabledDenom(ctx, req.Denom, req.ChannelOrClientId),
+		ReceiveEnabled: k.IsReceiveEnabledDenom(ctx, req.Denom, req.ChannelOrClientId),
+	}, nil
+}
+
+// AllTransferEnabled implements the AllTransferEnabled gRPC method.
+func (k Keeper) AllTransferEnabled(ctx context.Context, req *types.QueryAllTransferEnabledRequest) (*types.QueryAllTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var transferEnabled []types.TransferEnabled
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.TransferEnabledKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var te types.TransferEnabled
+		if err := k.cdc.Unmarshal(value, &te); err != nil {
+			return err
+		}
+
+		transferEnabled = append(transferEnabled, te)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllTransferEnabledResponse{
+		TransferEnabled: transferEnabled,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -400,3 +400,114 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryTransferEnabled() {
+	var req *types.QueryTransferEnabledRequest
+
+	testCases := []struct {
+		msg               string
+		malleate          func()
+		expSendEnabled    bool
+		expReceiveEnabled bool
+		expErr            error
+	}{
+		{
+			"success: over channel",
+			func() {},
+			false,
+			false,
+			nil,
+		},
+		{
+			"success: over all channels and clients",
+			func() {
+				req.ChannelOrClientId = ""
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"success: no entries for denom",
+			func() {
+				req.Denom = ibctesting.SecondaryDenom
+			},
+			true,
+			true,
+			nil,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.Denom = "??𓃠🐾??"
+			},
+			false,
+			false,
+			errors.New("invalid denom"),
+		},
+		{
+			"failure: invalid channel or client identifier",
+			func() {
+				req.ChannelOrClientId = "(invalid)"
+			},
+			false,
+			false,
+			errors.New("invalid channel or client identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			_, err := transferKeeper.SetTransferEnabled(suite.chainA.GetContext(), types.NewMsgSetTransferEnabled(
+				transferKeeper.GetAuthority(),
+				types.NewTransferEnabled(sdk.DefaultBondDenom, "", false, true),
+				types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstChannelID, true, false),
+			))
+			suite.Require().NoError(err)
+
+			req = &types.QueryTransferEnabledRequest{
+				Denom:             sdk.DefaultBondDenom,
+				ChannelOrClientId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := transferKeeper.TransferEnabled(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSendEnabled, res.SendEnabled)
+				suite.Require().Equal(tc.expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAllTransferEnabled() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	expTransferEnabled := []types.TransferEnabled{
+		types.NewTransferEnabled(sdk.DefaultBondDenom, "", false, true),
+		types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstChannelID, true, false),
+		types.NewTransferEnabled(ibctesting.SecondaryDenom, ibctesting.FirstClientID, false, false),
+	}
+
+	_, err := transferKeeper.SetTransferEnabled(suite.chainA.GetContext(), types.NewMsgSetTransferEnabled(transferKeeper.GetAuthority(), expTransferEnabled...))
+	suite.Require().NoError(err)
+
+	res, err := transferKeeper.AllTransferEnabled(suite.chainA.GetContext(), &types.QueryAllTransferEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expTransferEnabled, res.TransferEnabled)
+
+	res, err = transferKeeper.AllTransferEnabled(suite.chainA.GetContext(), &types.QueryAllTransferEnabledRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TransferEnabled, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
@@ -32,10 +32,11 @@ type Keeper struct {
 	cdc            codec.BinaryCodec
 	legacySubspace types.ParamSubspace
 
-	ics4Wrapper   porttypes.ICS4Wrapper
-	channelKeeper types.ChannelKeeper
-	AuthKeeper    types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	ics4Wrapper     porttypes.ICS4Wrapper
+	channelKeeper   types.ChannelKeeper
+	channelKeeperV2 types.ChannelKeeperV2
+	AuthKeeper      types.AccountKeeper
+	BankKeeper      types.BankKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -49,6 +50,7 @@ func NewKeeper(
 	legacySubspace types.ParamSubspace,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
+	channelKeeperV2 types.ChannelKeeperV2,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	authority string,
@@ -63,14 +65,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:            cdc,
-		storeService:   storeService,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		AuthKeeper:     authKeeper,
-		BankKeeper:     bankKeeper,
-		authority:      authority,
+		cdc:             cdc,
+		storeService:    storeService,
+		legacySubspace:  legacySubspace,
+		ics4Wrapper:     ics4Wrapper,
+		channelKeeper:   channelKeeper,
+		channelKeeperV2: channelKeeperV2,
+		AuthKeeper:      authKeeper,
+		BankKeeper:      bankKeeper,
+		authority:       authority,
 	}
 }
 
@@ -335,6 +338,87 @@ func (k Keeper) deleteForwardedPacket(ctx context.Context, portID, channelID str
 	}
 }
 
+// SetForwardedPacketV2 sets the identifier of the received IBC v2 packet which was forwarded
+// in the store, keyed by the portID, clientID and sequence of the packet sent on the next hop.
+func (k Keeper) SetForwardedPacketV2(ctx context.Context, portID, clientID string, sequence uint64, packetID channeltypes.PacketId) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&packetID)
+	if err := store.Set(types.PacketForwardV2Key(portID, clientID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetForwardedPacketV2 gets the identifier of the forwarded IBC v2 packet from the store.
+func (k Keeper) GetForwardedPacketV2(ctx context.Context, portID, clientID string, sequence uint64) (channeltypes.PacketId, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketForwardV2Key(portID, clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return channeltypes.PacketId{}, false
+	}
+
+	var packetID channeltypes.PacketId
+	k.cdc.MustUnmarshal(bz, &packetID)
+
+	return packetID, true
+}
+
+// DeleteForwardedPacketV2 deletes the identifier of the forwarded IBC v2 packet from the store.
+func (k Keeper) DeleteForwardedPacketV2(ctx context.Context, portID, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PacketForwardV2Key(portID, clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// getAllForwardedPacketsV2 gets the identifiers of all forwarded IBC v2 packets stored in state.
+func (k Keeper) getAllForwardedPacketsV2(ctx context.Context) []types.ForwardedPacketV2 {
+	var packets []types.ForwardedPacketV2
+	k.iterateForwardedPacketsV2(ctx, func(packet types.ForwardedPacketV2) bool {
+		packets = append(packets, packet)
+		return false
+	})
+
+	return packets
+}
+
+// iterateForwardedPacketsV2 iterates over the identifiers of the forwarded IBC v2 packets in the store
+// and performs a callback function.
+func (k Keeper) iterateForwardedPacketsV2(ctx context.Context, cb func(packet types.ForwardedPacketV2) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := append(types.ForwardedPacketV2Key, '/')
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var forwardPacket types.ForwardedPacketV2
+		k.cdc.MustUnmarshal(iterator.Value(), &forwardPacket.PacketId)
+
+		// Iterator key consists of types.ForwardedPacketV2Key/portID/clientID/sequence, where the
+		// sequence is big endian encoded and may thus contain the separator.
+		parts := strings.SplitN(string(iterator.Key()[len(prefix):]), "/", 3)
+		if len(parts) != 3 || len(parts[2]) != 8 {
+			panic(errors.New("key path should always have 4 elements"))
+		}
+
+		portID, clientID := parts[0], parts[1]
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			panic(errors.New("port identifier validation failed while parsing forward key path"))
+		}
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			panic(errors.New("client identifier validation failed while parsing forward key path"))
+		}
+
+		forwardPacket.ForwardKey = channeltypes.NewPacketID(portID, clientID, sdk.BigEndianToUint64([]byte(parts[2])))
+
+		if cb(forwardPacket) {
+			break
+		}
+	}
+}
+
 // getAllForwardedPackets gets all forward packets stored in state.
 func (k Keeper) getAllForwardedPackets(ctx context.Context) []types.ForwardedPacket {
 	var packets []types.ForwardedPacket
@@ -383,6 +467,87 @@ func (k Keeper) iterateForwardedPackets(ctx context.Context, cb func(packet type
 	}
 }
 
+// GetTransferEnabled returns the transfer enabled entry of the denom over the provided channel or client.
+// The entry applying to all channels and clients is returned if the channel or client identifier is empty.
+func (k Keeper) GetTransferEnabled(ctx context.Context, denom, channelOrClientID string) (types.TransferEnabled, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TransferEnabledStoreKey(channelOrClientID, denom))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.TransferEnabled{}, false
+	}
+
+	var transferEnabled types.TransferEnabled
+	k.cdc.MustUnmarshal(bz, &transferEnabled)
+
+	return transferEnabled, true
+}
+
+// setTransferEnabled stores the transfer enabled entry in the store. Entries enabling both sending and
+// receiving are deleted instead, as transfers of all denominations are enabled by default.
+func (k Keeper) setTransferEnabled(ctx context.Context, transferEnabled types.TransferEnabled) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.TransferEnabledStoreKey(transferEnabled.ChannelOrClientId, transferEnabled.Denom)
+
+	if transferEnabled.IsDefault() {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&transferEnabled)
+	if err := store.Set(key, bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllTransferEnabled returns all the transfer enabled entries stored in state.
+func (k Keeper) GetAllTransferEnabled(ctx context.Context) []types.TransferEnabled {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.TransferEnabledKey)
+
+	var transferEnabled []types.TransferEnabled
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var te types.TransferEnabled
+		k.cdc.MustUnmarshal(iterator.Value(), &te)
+
+		transferEnabled = append(transferEnabled, te)
+	}
+
+	return transferEnabled
+}
+
+// IsSendEnabledDenom returns true if tokens of the denom can be sent over the provided channel or client. Sending
+// is enabled unless it is disabled for the denom either over all channels and clients or over the channel or client.
+func (k Keeper) IsSendEnabledDenom(ctx context.Context, denom, channelOrClientID string) bool {
+	return k.isTransferEnabled(ctx, denom, channelOrClientID, func(te types.TransferEnabled) bool { return te.SendEnabled })
+}
+
+// IsReceiveEnabledDenom returns true if tokens of the denom can be received over the provided channel or client. Receiving
+// is enabled unless it is disabled for the denom either over all channels and clients or over the channel or client.
+func (k Keeper) IsReceiveEnabledDenom(ctx context.Context, denom, channelOrClientID string) bool {
+	return k.isTransferEnabled(ctx, denom, channelOrClientID, func(te types.TransferEnabled) bool { return te.ReceiveEnabled })
+}
+
+// isTransferEnabled returns false if the enabled flag of the entry of the denom applying to all channels and clients,
+// or of the entry of the denom over the provided channel or client, is false.
+func (k Keeper) isTransferEnabled(ctx context.Context, denom, channelOrClientID string, enabled func(types.TransferEnabled) bool) bool {
+	if te, found := k.GetTransferEnabled(ctx, denom, ""); found && !enabled(te) {
+		return false
+	}
+
+	if channelOrClientID == "" {
+		return true
+	}
+
+	te, found := k.GetTransferEnabled(ctx, denom, channelOrClientID)
+	return !found || enabled(te)
+}
+
 // IsBlockedAddr checks if the given address is allowed to send or receive tokens.
 // The module account is always allowed to send and receive tokens.
 func (k Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
@@ -62,6 +62,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				suite.chainA.GetSimApp().GetSubspace(types.ModuleName),
 				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
 				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2,
 				suite.chainA.GetSimApp().AccountKeeper,
 				suite.chainA.GetSimApp().BankKeeper,
 				suite.chainA.GetSimApp().ICAControllerKeeper.GetAuthority(),
@@ -74,6 +75,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				suite.chainA.GetSimApp().GetSubspace(types.ModuleName),
 				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
 				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2,
 				authkeeper.AccountKeeper{}, // empty account keeper
 				suite.chainA.GetSimApp().BankKeeper,
 				suite.chainA.GetSimApp().ICAControllerKeeper.GetAuthority(),
@@ -86,6 +88,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				suite.chainA.GetSimApp().GetSubspace(types.ModuleName),
 				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
 				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2,
 				suite.chainA.GetSimApp().AccountKeeper,
 				suite.chainA.GetSimApp().BankKeeper,
 				"", // authority
@@ -380,6 +383,74 @@ func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
 	suite.Require().IsType((*channelkeeper.Keeper)(nil), ics4Wrapper)
 }
 
+func (suite *KeeperTestSuite) TestIsTransferEnabledDenom() {
+	testCases := []struct {
+		name              string
+		transferEnabled   []types.TransferEnabled
+		expSendEnabled    bool
+		expReceiveEnabled bool
+	}{
+		{
+			"no entries",
+			nil,
+			true,
+			true,
+		},
+		{
+			"send disabled over all channels and clients",
+			[]types.TransferEnabled{types.NewTransferEnabled(sdk.DefaultBondDenom, "", false, true)},
+			false,
+			true,
+		},
+		{
+			"receive disabled over channel",
+			[]types.TransferEnabled{types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstChannelID, true, false)},
+			true,
+			false,
+		},
+		{
+			"send disabled over another channel",
+			[]types.TransferEnabled{types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.SecondChannelID, false, true)},
+			true,
+			true,
+		},
+		{
+			"send disabled for another denom",
+			[]types.TransferEnabled{types.NewTransferEnabled(ibctesting.SecondaryDenom, "", false, false)},
+			true,
+			true,
+		},
+		{
+			"entry over channel cannot enable transfers disabled over all channels and clients",
+			[]types.TransferEnabled{
+				types.NewTransferEnabled(sdk.DefaultBondDenom, "", false, false),
+				types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstChannelID, true, false),
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+			if len(tc.transferEnabled) > 0 {
+				_, err := transferKeeper.SetTransferEnabled(ctx, types.NewMsgSetTransferEnabled(transferKeeper.GetAuthority(), tc.transferEnabled...))
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(tc.expSendEnabled, transferKeeper.IsSendEnabledDenom(ctx, sdk.DefaultBondDenom, ibctesting.FirstChannelID))
+			suite.Require().Equal(tc.expReceiveEnabled, transferKeeper.IsReceiveEnabledDenom(ctx, sdk.DefaultBondDenom, ibctesting.FirstChannelID))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIsBlockedAddr() {
 	suite.SetupTest()
 
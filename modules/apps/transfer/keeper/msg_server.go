@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/telemetry"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
@@ -105,6 +106,71 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
+// TransferV2 defines an rpc handler method for MsgTransferV2. The FungibleTokenPacketDataV2 payload is
+// sent from the transfer port over the source client using IBC v2, where the tokens are escrowed or
+// burned by the transfer IBC v2 application when the packet is sent.
+func (k Keeper) TransferV2(goCtx context.Context, msg *types.MsgTransferV2) (*types.MsgTransferV2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).SendEnabled {
+		return nil, types.ErrSendDisabled
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]types.Token, len(msg.Tokens))
+	for i, coin := range msg.Tokens {
+		// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
+		if coin.Amount.Equal(types.UnboundedSpendLimit()) {
+			coin.Amount = k.BankKeeper.SpendableCoin(ctx, sender, coin.Denom).Amount
+			if coin.Amount.IsZero() {
+				return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "empty spendable balance for %s", coin.Denom)
+			}
+		}
+
+		tokens[i], err = k.TokenFromCoin(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If forwarding is needed, move memo to forwarding packet data and set packet.Memo to empty string.
+	memo := msg.Memo
+	var forwardingPacketData types.ForwardingPacketData
+	if hops := msg.Forwarding.GetHops(); len(hops) > 0 {
+		forwardingPacketData = types.NewForwardingPacketData(memo, hops...)
+		memo = ""
+	}
+
+	packetData := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), msg.Receiver, memo, forwardingPacketData)
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V2)
+	}
+
+	encoding := msg.Encoding
+	if encoding == "" {
+		encoding = types.EncodingProtobuf
+	}
+
+	bz, err := types.MarshalPacketDataV2(packetData, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V2, encoding, bz)
+	resp, err := k.channelKeeperV2.SendPacket(ctx, channeltypesv2.NewMsgSendPacket(msg.SourceClient, msg.TimeoutTimestamp, sender.String(), payload))
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC v2 fungible token transfer", "tokens", msg.Tokens, "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgTransferV2Response{Sequence: resp.Sequence}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -117,6 +183,20 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SetTransferEnabled defines an rpc handler method for MsgSetTransferEnabled. Enables or disables the transfers of denominations.
+func (k Keeper) SetTransferEnabled(goCtx context.Context, msg *types.MsgSetTransferEnabled) (*types.MsgSetTransferEnabledResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, transferEnabled := range msg.TransferEnabled {
+		k.setTransferEnabled(ctx, transferEnabled)
+	}
+
+	return &types.MsgSetTransferEnabledResponse{}, nil
+}
+
 // unwindHops unwinds the hops present in the tokens denomination and returns the message modified to reflect
 // the unwound path to take. It assumes that only a single token is present (as this is verified in ValidateBasic)
 // in the tokens list and ensures that the token is not native to the chain.
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
@@ -68,6 +70,33 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			types.ErrSendDisabled,
 		},
+		{
+			"success: send disabled for denom over another channel",
+			func() {
+				transferEnabled := types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.SecondChannelID, false, true)
+				_, err := suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(suite.chainA.GetContext(), types.NewMsgSetTransferEnabled(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), transferEnabled))
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: send disabled for denom",
+			func() {
+				transferEnabled := types.NewTransferEnabled(sdk.DefaultBondDenom, "", false, true)
+				_, err := suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(suite.chainA.GetContext(), types.NewMsgSetTransferEnabled(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), transferEnabled))
+				suite.Require().NoError(err)
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: send disabled for one of the denoms over channel",
+			func() {
+				transferEnabled := types.NewTransferEnabled(ibctesting.SecondaryDenom, path.EndpointA.ChannelID, false, true)
+				_, err := suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(suite.chainA.GetContext(), types.NewMsgSetTransferEnabled(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), transferEnabled))
+				suite.Require().NoError(err)
+			},
+			types.ErrSendDisabled,
+		},
 		{
 			"failure: invalid sender",
 			func() {
@@ -196,6 +225,128 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 	}
 }
 
+// TestMsgTransferV2 tests TransferV2 rpc handler
+func (suite *KeeperTestSuite) TestMsgTransferV2() {
+	var msg *types.MsgTransferV2
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: multiple coins",
+			func() {},
+			nil,
+		},
+		{
+			"success: single coin with json encoding",
+			func() {
+				msg.Tokens = []sdk.Coin{ibctesting.TestCoin}
+				msg.Encoding = types.EncodingJSON
+			},
+			nil,
+		},
+		{
+			"success: forwarding",
+			func() {
+				msg.Forwarding = types.NewForwarding(false, types.NewHop(types.PortID, ibctesting.FirstClientID))
+			},
+			nil,
+		},
+		{
+			"success: abi encoding",
+			func() {
+				msg.Encoding = types.EncodingABI
+			},
+			nil,
+		},
+		{
+			"failure: send transfers disabled",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(),
+					types.Params{
+						SendEnabled: false,
+					},
+				)
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: invalid sender",
+			func() {
+				msg.Sender = "address"
+			},
+			errors.New("decoding bech32 failed"),
+		},
+		{
+			"failure: client does not exist",
+			func() {
+				msg.SourceClient = ibctesting.InvalidID
+			},
+			clienttypes.ErrCounterpartyNotFound,
+		},
+		{
+			"failure: timeout timestamp exceeds the maximum timeout delta",
+			func() {
+				msg.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(channeltypesv2.DefaultMaxTimeoutDelta * 2).Unix())
+			},
+			channeltypesv2.ErrInvalidTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+			msg = types.NewMsgTransferV2(
+				path.EndpointA.ClientID,
+				ibctesting.TestCoins,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				timeoutTimestamp,
+				"memo",
+				nil,
+				"",
+			)
+
+			// send some coins of the second denom from bank module to the sender account as well
+			err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(ibctesting.SecondaryTestCoin))
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(ibctesting.SecondaryTestCoin))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TransferV2(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2.GetPacketCommitment(ctx, path.EndpointA.ClientID, res.Sequence)
+				suite.Require().NotEmpty(commitment)
+
+				escrowAddress := types.GetEscrowAddress(types.PortID, path.EndpointA.ClientID)
+				for _, coin := range msg.Tokens {
+					suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, coin.Denom))
+				}
+			} else {
+				suite.Require().Nil(res)
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
@@ -245,6 +396,74 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+// TestSetTransferEnabled tests SetTransferEnabled rpc handler
+func (suite *KeeperTestSuite) TestSetTransferEnabled() {
+	var msg *types.MsgSetTransferEnabled
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: entry enabling sending and receiving is removed",
+			func() {
+				msg.TransferEnabled = []types.TransferEnabled{types.NewTransferEnabled(sdk.DefaultBondDenom, "", true, true)}
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// disable receiving of the denom which is enabled again in one of the test cases
+			ctx := suite.chainA.GetContext()
+			signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(ctx, types.NewMsgSetTransferEnabled(signer, types.NewTransferEnabled(sdk.DefaultBondDenom, "", true, false)))
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgSetTransferEnabled(
+				signer,
+				types.NewTransferEnabled(sdk.DefaultBondDenom, "", false, true),
+				types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstChannelID, true, false),
+			)
+
+			tc.malleate()
+
+			_, err = suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				var expTransferEnabled []types.TransferEnabled
+				for _, te := range msg.TransferEnabled {
+					if !te.IsDefault() {
+						expTransferEnabled = append(expTransferEnabled, te)
+					}
+				}
+				suite.Require().ElementsMatch(expTransferEnabled, suite.chainA.GetSimApp().TransferKeeper.GetAllTransferEnabled(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUnwindHops() {
 	var msg *types.MsgTransfer
 	var path *ibctesting.Path
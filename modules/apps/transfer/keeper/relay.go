@@ -64,6 +64,14 @@ func (k Keeper) SendTransfer(
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	// check the enablement of every denom before any funds are moved
+	for _, token := range tokens {
+		denom := token.Denom.IBCDenom()
+		if !k.IsSendEnabledDenom(ctx, denom, sourceChannel) {
+			return errorsmod.Wrapf(types.ErrSendDisabled, "transfers of %s are disabled over %s", denom, sourceChannel)
+		}
+	}
+
 	for _, token := range tokens {
 		coin, err := token.ToCoin()
 		if err != nil {
@@ -168,6 +176,10 @@ func (k Keeper) OnRecvPacket(
 
 			coin := sdk.NewCoin(token.Denom.IBCDenom(), transferAmount)
 
+			if !k.IsReceiveEnabledDenom(ctx, coin.Denom, destChannel) {
+				return nil, errorsmod.Wrapf(types.ErrReceiveDisabled, "transfers of %s are disabled over %s", coin.Denom, destChannel)
+			}
+
 			escrowAddress := types.GetEscrowAddress(destPort, destChannel)
 			if err := k.UnescrowCoin(ctx, escrowAddress, receiver, coin); err != nil {
 				return nil, err
@@ -182,6 +194,10 @@ func (k Keeper) OnRecvPacket(
 			trace := []types.Hop{types.NewHop(destPort, destChannel)}
 			token.Denom.Trace = append(trace, token.Denom.Trace...)
 
+			if !k.IsReceiveEnabledDenom(ctx, token.Denom.IBCDenom(), destChannel) {
+				return nil, errorsmod.Wrapf(types.ErrReceiveDisabled, "transfers of %s are disabled over %s", token.Denom.IBCDenom(), destChannel)
+			}
+
 			if !k.HasDenom(ctx, token.Denom.Hash()) {
 				k.SetDenom(ctx, token.Denom)
 			}
@@ -267,7 +283,7 @@ func (k Keeper) HandleForwardedPacketAcknowledgement(
 		// the forwarded packet has failed, thus the funds have been refunded to the intermediate address.
 		// we must revert the changes that came from successfully receiving the tokens on our chain
 		// before propagating the error acknowledgement back to original sender chain
-		if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
+		if err := k.revertForwardedPacket(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, data); err != nil {
 			return err
 		}
 
@@ -292,7 +308,7 @@ func (k Keeper) OnTimeoutPacket(
 // HandleForwardedTimeout processes a timeout packet that was sent from the chain as an intermediate.
 // The packet is reverted and the tokens are refunded to the sender.
 func (k Keeper) HandleForwardedPacketTimeout(ctx context.Context, packet channeltypes.Packet, forwardedPacket channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
-	if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
+	if err := k.revertForwardedPacket(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, data); err != nil {
 		return err
 	}
 
@@ -372,7 +372,10 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 // loop since setup is intensive for all cases. The malleate function allows
 // for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
-	var packetData types.FungibleTokenPacketDataV2
+	var (
+		packetData types.FungibleTokenPacketDataV2
+		path       *ibctesting.Path
+	)
 
 	testCases := []struct {
 		msg      string
@@ -422,6 +425,16 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			},
 			types.ErrReceiveDisabled,
 		},
+		{
+			"failure: receive is disabled for denom over channel",
+			func() {
+				denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				transferEnabled := types.NewTransferEnabled(denom.IBCDenom(), path.EndpointB.ChannelID, true, false)
+				_, err := suite.chainB.GetSimApp().TransferKeeper.SetTransferEnabled(suite.chainB.GetContext(), types.NewMsgSetTransferEnabled(suite.chainB.GetSimApp().TransferKeeper.GetAuthority(), transferEnabled))
+				suite.Require().NoError(err)
+			},
+			types.ErrReceiveDisabled,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -430,7 +443,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			receiver := suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
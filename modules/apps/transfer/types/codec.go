@@ -13,12 +13,13 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgTransfer{}, "cosmos-sdk/MsgTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferV2{}, "cosmos-sdk/MsgTransferV2")
 }
 
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUpdateParams{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgTransferV2{}, &MsgUpdateParams{}, &MsgSetTransferEnabled{})
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -9,6 +9,7 @@ import (
 
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -41,6 +42,13 @@ type ChannelKeeper interface {
 	HasChannel(ctx context.Context, portID, channelID string) bool
 }
 
+// ChannelKeeperV2 defines the expected IBC v2 channel keeper
+type ChannelKeeperV2 interface {
+	SendPacket(ctx context.Context, msg *channeltypesv2.MsgSendPacket) (*channeltypesv2.MsgSendPacketResponse, error)
+	GetAsyncPacket(ctx context.Context, clientID string, sequence uint64) (channeltypesv2.Packet, bool)
+	GetParams(ctx context.Context) channeltypesv2.Params
+}
+
 // ClientKeeper defines the expected IBC client keeper
 type ClientKeeper interface {
 	GetClientConsensusState(ctx sdk.Context, clientID string) (connection ibcexported.ConsensusState, found bool)
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
@@ -35,5 +39,31 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Denoms.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateTransferEnabled(gs.TransferEnabled); err != nil {
+		return err
+	}
+	for i, forwardedPacket := range gs.ForwardedPacketsV2 {
+		if err := forwardedPacket.Validate(); err != nil {
+			return fmt.Errorf("invalid forwarded packet v2 %v index %d: %w", forwardedPacket, i, err)
+		}
+	}
 	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
 }
+
+// Validate performs basic validation of the identifiers of the forwarded IBC v2 packet
+// and of the packet sent on the next hop.
+func (fp ForwardedPacketV2) Validate() error {
+	for _, packetID := range []channeltypes.PacketId{fp.ForwardKey, fp.PacketId} {
+		if err := host.PortIdentifierValidator(packetID.PortId); err != nil {
+			return err
+		}
+		if err := host.ClientIdentifierValidator(packetID.ChannelId); err != nil {
+			return err
+		}
+		if packetID.Sequence == 0 {
+			return errors.New("packet sequence cannot be 0")
+		}
+	}
+
+	return nil
+}
@@ -37,6 +37,12 @@ type GenesisState struct {
 	// forwarded_packets contains the forwarded packets stored as part of the
 	// packet forwarding lifecycle
 	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// transfer_enabled contains the entries enabling or disabling the transfers
+	// of a denomination
+	TransferEnabled []TransferEnabled `protobuf:"bytes,6,rep,name=transfer_enabled,json=transferEnabled,proto3" json:"transfer_enabled"`
+	// forwarded_packets_v2 contains the identifiers of the IBC v2 packets which were received
+	// and forwarded, awaiting the acknowledgement or timeout of the packet sent on the next hop
+	ForwardedPacketsV2 []ForwardedPacketV2 `protobuf:"bytes,7,rep,name=forwarded_packets_v2,json=forwardedPacketsV2,proto3" json:"forwarded_packets_v2"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +113,20 @@ func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
 	return nil
 }
 
+func (m *GenesisState) GetTransferEnabled() []TransferEnabled {
+	if m != nil {
+		return m.TransferEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardedPacketsV2() []ForwardedPacketV2 {
+	if m != nil {
+		return m.ForwardedPacketsV2
+	}
+	return nil
+}
+
 // ForwardedPacket defines the genesis type necessary to retrieve and store forwarded packets.
 type ForwardedPacket struct {
 	ForwardKey types1.PacketId `protobuf:"bytes,1,opt,name=forward_key,json=forwardKey,proto3" json:"forward_key"`
@@ -160,9 +180,65 @@ func (m *ForwardedPacket) GetPacket() types1.Packet {
 	return types1.Packet{}
 }
 
+// ForwardedPacketV2 defines the genesis type necessary to retrieve and store the identifiers of forwarded IBC v2 packets.
+type ForwardedPacketV2 struct {
+	// the identifier of the packet sent on the next hop.
+	ForwardKey types1.PacketId `protobuf:"bytes,1,opt,name=forward_key,json=forwardKey,proto3" json:"forward_key"`
+	// the identifier of the received packet awaiting its asynchronous acknowledgement.
+	PacketId types1.PacketId `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+}
+
+func (m *ForwardedPacketV2) Reset()         { *m = ForwardedPacketV2{} }
+func (m *ForwardedPacketV2) String() string { return proto.CompactTextString(m) }
+func (*ForwardedPacketV2) ProtoMessage()    {}
+func (*ForwardedPacketV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62efebb47a9093ed, []int{2}
+}
+func (m *ForwardedPacketV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedPacketV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedPacketV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardedPacketV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedPacketV2.Merge(m, src)
+}
+func (m *ForwardedPacketV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedPacketV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedPacketV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedPacketV2 proto.InternalMessageInfo
+
+func (m *ForwardedPacketV2) GetForwardKey() types1.PacketId {
+	if m != nil {
+		return m.ForwardKey
+	}
+	return types1.PacketId{}
+}
+
+func (m *ForwardedPacketV2) GetPacketId() types1.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types1.PacketId{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v2.GenesisState")
 	proto.RegisterType((*ForwardedPacket)(nil), "ibc.applications.transfer.v2.ForwardedPacket")
+	proto.RegisterType((*ForwardedPacketV2)(nil), "ibc.applications.transfer.v2.ForwardedPacketV2")
 }
 
 func init() {
@@ -170,38 +246,43 @@ func init() {
 }
 
 var fileDescriptor_62efebb47a9093ed = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0x6d, 0x04, 0xe1, 0xc2, 0x80, 0x08, 0x89, 0x30, 0x20, 0x2b, 0x83, 0x43, 0x04,
-	0xaa, 0x4d, 0xc3, 0x01, 0xed, 0x5a, 0x06, 0x68, 0xda, 0x65, 0x94, 0x1b, 0x97, 0xe2, 0xd8, 0x6e,
-	0x66, 0xb5, 0xc9, 0x8b, 0x6c, 0xaf, 0x53, 0xbf, 0x04, 0x42, 0x7c, 0x0c, 0x3e, 0xc9, 0x8e, 0x3b,
-	0x72, 0x02, 0xd4, 0x7e, 0x11, 0x64, 0xc7, 0x85, 0x01, 0x52, 0x4e, 0x7d, 0xcf, 0xfd, 0xff, 0xff,
-	0xcf, 0xfe, 0xe5, 0xa1, 0xa7, 0x32, 0x67, 0x84, 0xd6, 0xf5, 0x4c, 0x32, 0x6a, 0x24, 0x54, 0x9a,
-	0x18, 0x45, 0x2b, 0x3d, 0x11, 0x8a, 0xcc, 0x33, 0x52, 0x88, 0x4a, 0x68, 0xa9, 0x71, 0xad, 0xc0,
-	0x40, 0xf4, 0x40, 0xe6, 0x0c, 0x5f, 0xd6, 0xe2, 0xb5, 0x16, 0xcf, 0xb3, 0x9d, 0x67, 0x2d, 0x49,
-	0x83, 0xdf, 0x75, 0x13, 0xb5, 0x93, 0xb6, 0x8e, 0x35, 0x30, 0x15, 0x95, 0x57, 0x3e, 0xb2, 0x4a,
-	0x06, 0x4a, 0x10, 0x76, 0x42, 0xab, 0x4a, 0xcc, 0x6c, 0x9a, 0x2f, 0xbd, 0x24, 0x61, 0xa0, 0x4b,
-	0xd0, 0x24, 0xa7, 0x5a, 0x90, 0xf9, 0x20, 0x17, 0x86, 0x0e, 0x08, 0x03, 0xb9, 0x8e, 0xb8, 0x53,
-	0x40, 0x01, 0xae, 0x24, 0xb6, 0x6a, 0x4e, 0xf7, 0x3e, 0x6d, 0xa2, 0xeb, 0x6f, 0x9b, 0xf7, 0xbd,
-	0x37, 0xd4, 0x88, 0xe8, 0x2e, 0xba, 0x5a, 0x83, 0x32, 0x63, 0xc9, 0xe3, 0xa0, 0x17, 0xa4, 0xd7,
-	0x46, 0xa1, 0x6d, 0x0f, 0x79, 0x74, 0x84, 0x42, 0x2e, 0x2a, 0x28, 0x75, 0xbc, 0xd1, 0xdb, 0x4c,
-	0xbb, 0xd9, 0x63, 0xdc, 0x06, 0x02, 0x1f, 0x58, 0xed, 0x70, 0xfb, 0xfc, 0xfb, 0x6e, 0xe7, 0xeb,
-	0x8f, 0xdd, 0xd0, 0xb5, 0x7a, 0xe4, 0x23, 0xa2, 0x21, 0x0a, 0x6b, 0xaa, 0x68, 0xa9, 0xe3, 0xcd,
-	0x5e, 0x90, 0x76, 0xb3, 0x27, 0x6d, 0x61, 0x03, 0x7c, 0xec, 0xb4, 0xc3, 0x2d, 0x9b, 0x36, 0xf2,
-	0xce, 0x48, 0xa1, 0x6d, 0x03, 0x86, 0xce, 0xc6, 0x42, 0x33, 0x05, 0x67, 0x82, 0xc7, 0x5b, 0xee,
-	0x62, 0xf7, 0x70, 0x43, 0x02, 0x5b, 0x12, 0xd8, 0x93, 0xc0, 0xaf, 0x40, 0x56, 0xc3, 0xe7, 0xfe,
-	0x3a, 0x69, 0x21, 0xcd, 0xc9, 0x69, 0x8e, 0x19, 0x94, 0xc4, 0x63, 0x6b, 0x7e, 0xfa, 0x9a, 0x4f,
-	0x89, 0x59, 0xd4, 0x42, 0x3b, 0x83, 0x1e, 0xdd, 0x70, 0x23, 0x5e, 0xfb, 0x09, 0xd1, 0x47, 0x74,
-	0x7b, 0x02, 0xea, 0x8c, 0x2a, 0x2e, 0xf8, 0xb8, 0xa6, 0x6c, 0x2a, 0x8c, 0x8e, 0xaf, 0xb8, 0xb1,
-	0xfd, 0x76, 0x1e, 0x6f, 0xd6, 0xb6, 0x63, 0xe7, 0xf2, 0x6f, 0xb9, 0x35, 0xf9, 0xfb, 0x58, 0xef,
-	0x7d, 0x09, 0xd0, 0xcd, 0x7f, 0xb4, 0xd1, 0x01, 0xea, 0x7a, 0xdd, 0x78, 0x2a, 0x16, 0xee, 0xbb,
-	0x74, 0xb3, 0x87, 0x6e, 0x9e, 0xdd, 0x09, 0xbc, 0x5e, 0x04, 0x47, 0xca, 0x3a, 0x0e, 0xb9, 0xcf,
-	0x47, 0xde, 0x77, 0x24, 0x16, 0xd1, 0xbe, 0x65, 0x6e, 0xff, 0x8d, 0x37, 0x5c, 0xc0, 0xfd, 0x96,
-	0x80, 0x3f, 0xa8, 0x5d, 0xf7, 0xee, 0x7c, 0x99, 0x04, 0x17, 0xcb, 0x24, 0xf8, 0xb9, 0x4c, 0x82,
-	0xcf, 0xab, 0xa4, 0x73, 0xb1, 0x4a, 0x3a, 0xdf, 0x56, 0x49, 0xe7, 0xc3, 0xcb, 0xff, 0x49, 0xca,
-	0x9c, 0xf5, 0x0b, 0x20, 0xf3, 0x7d, 0x52, 0x02, 0x3f, 0x9d, 0x09, 0x6d, 0x57, 0xfc, 0xd2, 0x6a,
-	0x3b, 0xbc, 0x79, 0xe8, 0xf6, 0xef, 0xc5, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x97, 0x02,
-	0x93, 0x7b, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6f, 0xba, 0xfe, 0xb3, 0xff, 0x5c, 0xd8, 0x8b, 0x35, 0x89, 0x30, 0x20, 0x2b, 0x85, 0x43,
+	0x05, 0xaa, 0x4d, 0xc3, 0x01, 0xed, 0x86, 0xca, 0x06, 0x9a, 0x76, 0x19, 0x05, 0xed, 0xc0, 0x81,
+	0xe0, 0x38, 0x6e, 0x16, 0xb5, 0x8d, 0xa3, 0xd8, 0xcb, 0xd4, 0x6f, 0x81, 0xb8, 0xf2, 0x0d, 0xf8,
+	0x24, 0x3b, 0xee, 0x06, 0x27, 0x40, 0xed, 0x17, 0x41, 0x76, 0x1c, 0xd8, 0x56, 0x29, 0x80, 0xc4,
+	0xa9, 0xcf, 0xe3, 0xfe, 0x5e, 0xfc, 0x3c, 0xf9, 0x25, 0xe0, 0x41, 0x1c, 0x50, 0x4c, 0xd2, 0x74,
+	0x1c, 0x53, 0x22, 0x63, 0x9e, 0x08, 0x2c, 0x33, 0x92, 0x88, 0x21, 0xcb, 0x70, 0xee, 0xe1, 0x88,
+	0x25, 0x4c, 0xc4, 0x02, 0xa5, 0x19, 0x97, 0x1c, 0xde, 0x8e, 0x03, 0x8a, 0x2e, 0x62, 0x51, 0x89,
+	0x45, 0xb9, 0xb7, 0xf5, 0xb0, 0x42, 0xa9, 0xf7, 0xb3, 0x2e, 0xa4, 0xb6, 0x3a, 0x95, 0xb6, 0x92,
+	0x8f, 0x58, 0x62, 0x90, 0x77, 0x15, 0x92, 0xf2, 0x8c, 0x61, 0x7a, 0x4c, 0x92, 0x84, 0x8d, 0x95,
+	0x9a, 0x29, 0x0d, 0xc4, 0xa5, 0x5c, 0x4c, 0xb8, 0xc0, 0x01, 0x11, 0x0c, 0xe7, 0xbd, 0x80, 0x49,
+	0xd2, 0xc3, 0x94, 0xc7, 0xa5, 0xc4, 0x66, 0xc4, 0x23, 0xae, 0x4b, 0xac, 0xaa, 0xe2, 0xb4, 0xfd,
+	0xb9, 0x01, 0xae, 0xbd, 0x28, 0xe6, 0x7b, 0x25, 0x89, 0x64, 0xf0, 0x06, 0x58, 0x4e, 0x79, 0x26,
+	0xfd, 0x38, 0x74, 0xac, 0x96, 0xd5, 0x59, 0x19, 0xd8, 0xaa, 0xdd, 0x0f, 0xe1, 0x01, 0xb0, 0x43,
+	0x96, 0xf0, 0x89, 0x70, 0xea, 0xad, 0xa5, 0x4e, 0xd3, 0xbb, 0x87, 0xaa, 0x16, 0x81, 0x76, 0x15,
+	0xb6, 0xbf, 0x7a, 0xf6, 0x75, 0xbb, 0xf6, 0xe9, 0xdb, 0xb6, 0xad, 0x5b, 0x31, 0x30, 0x12, 0xb0,
+	0x0f, 0xec, 0x94, 0x64, 0x64, 0x22, 0x9c, 0xa5, 0x96, 0xd5, 0x69, 0x7a, 0xf7, 0xab, 0xc4, 0x7a,
+	0xe8, 0x50, 0x63, 0xfb, 0x0d, 0xa5, 0x36, 0x30, 0x4c, 0x98, 0x81, 0x55, 0xc9, 0x25, 0x19, 0xfb,
+	0x4c, 0xd0, 0x8c, 0x9f, 0xb2, 0xd0, 0x69, 0xe8, 0x8b, 0xdd, 0x44, 0xc5, 0x26, 0x90, 0xda, 0x04,
+	0x32, 0x9b, 0x40, 0xcf, 0x78, 0x9c, 0xf4, 0x1f, 0x99, 0xeb, 0x74, 0xa2, 0x58, 0x1e, 0x9f, 0x04,
+	0x88, 0xf2, 0x09, 0x36, 0x6b, 0x2b, 0x7e, 0xba, 0x22, 0x1c, 0x61, 0x39, 0x4d, 0x99, 0xd0, 0x04,
+	0x31, 0xb8, 0xae, 0x2d, 0xf6, 0x8c, 0x03, 0x7c, 0x07, 0x36, 0x86, 0x3c, 0x3b, 0x25, 0x59, 0xc8,
+	0x42, 0x3f, 0x25, 0x74, 0xc4, 0xa4, 0x70, 0xfe, 0xd3, 0xb6, 0xdd, 0xea, 0x7d, 0x3c, 0x2f, 0x69,
+	0x87, 0x9a, 0x65, 0x66, 0x59, 0x1f, 0x5e, 0x3e, 0x16, 0xf0, 0x2d, 0x58, 0x2f, 0x69, 0x3e, 0x4b,
+	0x48, 0x30, 0x66, 0xa1, 0x63, 0xff, 0xde, 0xa0, 0x87, 0x5e, 0x9b, 0x7a, 0xaf, 0x20, 0x19, 0x83,
+	0x35, 0x79, 0xf9, 0x18, 0x46, 0x60, 0x73, 0x61, 0x02, 0x3f, 0xf7, 0x9c, 0x65, 0xed, 0x81, 0xff,
+	0x6a, 0x88, 0x23, 0xcf, 0xb8, 0xc0, 0xab, 0x63, 0x1c, 0x79, 0xed, 0x0f, 0x16, 0x58, 0xbb, 0x82,
+	0x87, 0xbb, 0xa0, 0x69, 0x90, 0xfe, 0x88, 0x4d, 0x75, 0xc0, 0x9a, 0xde, 0x1d, 0xed, 0xa9, 0xc2,
+	0x8d, 0xca, 0x44, 0xeb, 0x47, 0xae, 0x18, 0xfb, 0xe5, 0x1c, 0xc0, 0xf0, 0x0e, 0xd8, 0x14, 0xee,
+	0xa8, 0xf0, 0xa8, 0x7f, 0x9d, 0xba, 0x16, 0xb8, 0x55, 0x21, 0xf0, 0x2b, 0x33, 0xaa, 0x6b, 0x7f,
+	0xb4, 0xc0, 0xc6, 0xc2, 0x10, 0xff, 0xe8, 0x5a, 0x4f, 0xc1, 0x4a, 0xe1, 0xa2, 0xde, 0x9d, 0xfa,
+	0x9f, 0x6b, 0xfc, 0x9f, 0x96, 0xfd, 0xcb, 0xb3, 0x99, 0x6b, 0x9d, 0xcf, 0x5c, 0xeb, 0xfb, 0xcc,
+	0xb5, 0xde, 0xcf, 0xdd, 0xda, 0xf9, 0xdc, 0xad, 0x7d, 0x99, 0xbb, 0xb5, 0x37, 0x4f, 0x16, 0x03,
+	0x1b, 0x07, 0xb4, 0x1b, 0x71, 0x9c, 0xef, 0xe0, 0x09, 0x0f, 0x4f, 0xc6, 0x4c, 0xa8, 0x2f, 0xc9,
+	0x85, 0x2f, 0x88, 0x4e, 0x71, 0x60, 0xeb, 0xd7, 0xfc, 0xf1, 0x8f, 0x01, 0x00, 0xa3, 0x4a, 0x17,
+	0x6c, 0xe2, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -224,6 +305,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForwardedPacketsV2) > 0 {
+		for iNdEx := len(m.ForwardedPacketsV2) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPacketsV2[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TransferEnabled) > 0 {
+		for iNdEx := len(m.TransferEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardedPackets) > 0 {
 		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +438,49 @@ func (m *ForwardedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForwardedPacketV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPacketV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedPacketV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ForwardKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -370,6 +522,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferEnabled) > 0 {
+		for _, e := range m.TransferEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardedPacketsV2) > 0 {
+		for _, e := range m.ForwardedPacketsV2 {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -386,6 +550,19 @@ func (m *ForwardedPacket) Size() (n int) {
 	return n
 }
 
+func (m *ForwardedPacketV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwardKey.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PacketId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -588,6 +765,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferEnabled = append(m.TransferEnabled, TransferEnabled{})
+			if err := m.TransferEnabled[len(m.TransferEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPacketsV2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPacketsV2 = append(m.ForwardedPacketsV2, ForwardedPacketV2{})
+			if err := m.ForwardedPacketsV2[len(m.ForwardedPacketsV2)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -725,6 +970,122 @@ func (m *ForwardedPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForwardedPacketV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedPacketV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedPacketV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
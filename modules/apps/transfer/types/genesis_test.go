@@ -6,7 +6,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -34,6 +37,51 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"invalid transfer enabled denom",
+			&types.GenesisState{
+				PortId:          "portidone",
+				TransferEnabled: []types.TransferEnabled{types.NewTransferEnabled("", "", false, false)},
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"duplicate transfer enabled entries",
+			&types.GenesisState{
+				PortId: "portidone",
+				TransferEnabled: []types.TransferEnabled{
+					types.NewTransferEnabled("uatom", "channel-0", false, true),
+					types.NewTransferEnabled("uatom", "channel-0", true, false),
+				},
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"valid forwarded packet v2",
+			&types.GenesisState{
+				PortId: "portidone",
+				ForwardedPacketsV2: []types.ForwardedPacketV2{
+					{
+						ForwardKey: channeltypes.NewPacketID(types.PortID, ibctesting.FirstClientID, 1),
+						PacketId:   channeltypes.NewPacketID(types.PortID, ibctesting.SecondClientID, 1),
+					},
+				},
+			},
+			nil,
+		},
+		{
+			"invalid forwarded packet v2 client",
+			&types.GenesisState{
+				PortId: "portidone",
+				ForwardedPacketsV2: []types.ForwardedPacketV2{
+					{
+						ForwardKey: channeltypes.NewPacketID(types.PortID, ibctesting.FirstClientID, 1),
+						PacketId:   channeltypes.NewPacketID(types.PortID, "(INVALIDCLIENT)", 1),
+					},
+				},
+			},
+			host.ErrInvalidID,
+		},
 	}
 
 	for _, tc := range testCases {
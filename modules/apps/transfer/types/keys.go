@@ -55,6 +55,10 @@ var (
 	DenomKey = []byte{0x03}
 	// ForwardedPacketKey defines the key to store the forwarded packet in store
 	ForwardedPacketKey = []byte{0x04}
+	// ForwardedPacketV2Key defines the key to store the identifier of a forwarded IBC v2 packet in store
+	ForwardedPacketV2Key = []byte{0x05}
+	// TransferEnabledKey defines the key to store the transfer enabled entries of a denomination in store
+	TransferEnabledKey = []byte{0x06}
 
 	// SupportedVersions defines all versions that are supported by the module
 	SupportedVersions = []string{V2, V1}
@@ -87,3 +91,17 @@ func TotalEscrowForDenomKey(denom string) []byte {
 func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
 }
+
+// PacketForwardV2Key returns the store key under which the identifier of a forwarded IBC v2 packet
+// is stored for the provided portID, clientID, and sequence of the packet sent on the next hop.
+func PacketForwardV2Key(portID, clientID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketV2Key, portID, clientID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// TransferEnabledStoreKey returns the store key under which the transfer enabled entry of the denom
+// over the provided channel or client is stored. The channel or client identifier is empty for the
+// entry applying to all channels and clients. It precedes the denom in the key as it cannot contain
+// a slash, contrary to the denom.
+func TransferEnabledStoreKey(channelOrClientID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", TransferEnabledKey, channelOrClientID, denom))
+}
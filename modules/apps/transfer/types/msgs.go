@@ -21,8 +21,12 @@ const (
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgTransferV2)(nil)
+	_ sdk.Msg              = (*MsgSetTransferEnabled)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferV2)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetTransferEnabled)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -43,6 +47,28 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetTransferEnabled creates a new MsgSetTransferEnabled instance
+func NewMsgSetTransferEnabled(signer string, transferEnabled ...TransferEnabled) *MsgSetTransferEnabled {
+	return &MsgSetTransferEnabled{
+		Signer:          signer,
+		TransferEnabled: transferEnabled,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetTransferEnabled) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.TransferEnabled) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "transfer enabled entries cannot be empty")
+	}
+
+	return ValidateTransferEnabled(msg.TransferEnabled)
+}
+
 // NewMsgTransfer creates a new MsgTransfer instance
 func NewMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -177,6 +203,86 @@ func (msg MsgTransfer) validateIdentifiers() error {
 	return nil
 }
 
+// NewMsgTransferV2 creates a new MsgTransferV2 instance
+func NewMsgTransferV2(
+	sourceClient string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutTimestamp uint64,
+	memo string,
+	forwarding *Forwarding,
+	encoding string,
+) *MsgTransferV2 {
+	return &MsgTransferV2{
+		SourceClient:     sourceClient,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+		Tokens:           tokens,
+		Forwarding:       forwarding,
+		Encoding:         encoding,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgTransferV2 fields.
+// NOTE: The recipient addresses format is not validated as the format defined by
+// the chain is not known to IBC.
+func (msg MsgTransferV2) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.SourceClient); err != nil {
+		return errorsmod.Wrapf(err, "invalid source client ID %s", msg.SourceClient)
+	}
+
+	if msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "timeout timestamp must not be 0")
+	}
+
+	if msg.Forwarding != nil {
+		if msg.Forwarding.GetUnwind() {
+			return errorsmod.Wrap(ErrInvalidForwarding, "unwinding is not supported for IBC v2 transfers")
+		}
+
+		if err := msg.Forwarding.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(msg.Tokens) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "tokens must not be empty")
+	}
+
+	if len(msg.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(msg.Receiver) > MaximumReceiverLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", MaximumReceiverLength)
+	}
+	if len(msg.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	switch msg.Encoding {
+	case "", EncodingJSON, EncodingProtobuf, EncodingABI:
+	default:
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, msg.Encoding)
+	}
+
+	for _, coin := range msg.Tokens {
+		if err := validateIBCCoin(coin); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s: %s", err.Error(), coin.String())
+		}
+	}
+
+	return nil
+}
+
 // isValidIBCCoin returns true if the token provided is valid,
 // and should be used to transfer tokens.
 func isValidIBCCoin(coin sdk.Coin) bool {
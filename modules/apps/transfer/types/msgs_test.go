@@ -116,6 +116,46 @@ func TestMsgTransferGetSigners(t *testing.T) {
 	require.Equal(t, addr.Bytes(), signers[0])
 }
 
+// TestMsgTransferV2Validation tests ValidateBasic for MsgTransferV2
+func TestMsgTransferV2Validation(t *testing.T) {
+	validClient := ibctesting.FirstClientID
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgTransferV2
+		expError error
+	}{
+		{"success", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, "", nil, ""), nil},
+		{"success: multidenom with json encoding", types.NewMsgTransferV2(validClient, coins.Add(ibcCoins...), sender, receiver, 100, "", nil, types.EncodingJSON), nil},
+		{"success: abi encoding", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, "", nil, types.EncodingABI), nil},
+		{"success: memo with forwarding path hops not empty", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, "memo", types.NewForwarding(false, validHop), types.EncodingProtobuf), nil},
+		{"failure: invalid client id", types.NewMsgTransferV2(invalidChannel, coins, sender, receiver, 100, "", nil, ""), host.ErrInvalidID},
+		{"failure: zero timeout timestamp", types.NewMsgTransferV2(validClient, coins, sender, receiver, 0, "", nil, ""), types.ErrInvalidPacketTimeout},
+		{"failure: unwind is set", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, "", types.NewForwarding(true), ""), types.ErrInvalidForwarding},
+		{"failure: invalid forwarding info channel", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, "", types.NewForwarding(false, types.NewHop(validPort, invalidChannel)), ""), types.ErrInvalidForwarding},
+		{"failure: empty coins", types.NewMsgTransferV2(validClient, sdk.NewCoins(), sender, receiver, 100, "", nil, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: too many coins", types.NewMsgTransferV2(validClient, make([]sdk.Coin, types.MaximumTokensLength+1), sender, receiver, 100, "", nil, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: invalid ibc denom", types.NewMsgTransferV2(validClient, invalidIBCCoins, sender, receiver, 100, "", nil, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: zero coins", types.NewMsgTransferV2(validClient, zeroCoins, sender, receiver, 100, "", nil, ""), ibcerrors.ErrInvalidCoins},
+		{"failure: missing sender address", types.NewMsgTransferV2(validClient, coins, emptyAddr, receiver, 100, "", nil, ""), ibcerrors.ErrInvalidAddress},
+		{"failure: missing recipient address", types.NewMsgTransferV2(validClient, coins, sender, "", 100, "", nil, ""), ibcerrors.ErrInvalidAddress},
+		{"failure: too long memo", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, ibctesting.GenerateString(types.MaximumMemoLength+1), nil, ""), types.ErrInvalidMemo},
+		{"failure: invalid encoding", types.NewMsgTransferV2(validClient, coins, sender, receiver, 100, "", nil, "invalid-encoding"), ibcerrors.ErrInvalidType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	testCases := []struct {
@@ -141,6 +181,37 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	}
 }
 
+// TestMsgSetTransferEnabledValidateBasic tests ValidateBasic for MsgSetTransferEnabled
+func TestMsgSetTransferEnabledValidateBasic(t *testing.T) {
+	transferEnabled := types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstChannelID, false, true)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetTransferEnabled
+		expError error
+	}{
+		{"success: valid signer and entries", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, transferEnabled, types.NewTransferEnabled(sdk.DefaultBondDenom, "", true, false)), nil},
+		{"success: client identifier", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, types.NewTransferEnabled(sdk.DefaultBondDenom, ibctesting.FirstClientID, false, false)), nil},
+		{"failure: invalid signer", types.NewMsgSetTransferEnabled(invalidAddress, transferEnabled), ibcerrors.ErrInvalidAddress},
+		{"failure: no entries", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress), ibcerrors.ErrInvalidRequest},
+		{"failure: invalid denom", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, types.NewTransferEnabled("", "", false, true)), ibcerrors.ErrInvalidCoins},
+		{"failure: invalid channel or client identifier", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, types.NewTransferEnabled(sdk.DefaultBondDenom, invalidChannel, false, true)), host.ErrInvalidID},
+		{"failure: duplicate entries", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, transferEnabled, transferEnabled), ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestMsgUpdateParamsGetSigners tests GetSigners for MsgUpdateParams
 func TestMsgUpdateParamsGetSigners(t *testing.T) {
 	testCases := []struct {
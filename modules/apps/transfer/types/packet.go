@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
@@ -258,16 +259,17 @@ func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (Fungi
 			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, errorMsgVersion, err.Error())
 		}
 	case EncodingABI:
-		if ics20Version != V1 {
-			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "encoding %s is only supported for ICS20-V1", EncodingABI)
-		}
 		var err error
-		data, err = DecodeABIFungibleTokenPacketData(bz)
+		if ics20Version == V1 {
+			data, err = DecodeABIFungibleTokenPacketData(bz)
+		} else {
+			data, err = DecodeABIFungibleTokenPacketDataV2(bz)
+		}
 		if err != nil {
 			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, errorMsgVersion, err.Error())
 		}
 	default:
-		return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q], got %s", EncodingJSON, EncodingProtobuf, encoding)
+		return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
 	}
 
 	// When the unmarshaling is done, we want to retrieve the underlying data type based on the value of ics20Version
@@ -296,6 +298,48 @@ func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (Fungi
 	return *datav2, nil
 }
 
+// MarshalPacketDataV2 marshals the provided FungibleTokenPacketDataV2 using the given encoding.
+// Protobuf encoding is used if the encoding is empty.
+func MarshalPacketDataV2(data FungibleTokenPacketDataV2, encoding string) ([]byte, error) {
+	switch encoding {
+	case "", EncodingProtobuf:
+		return proto.Marshal(&data)
+	case EncodingJSON:
+		return json.Marshal(data)
+	case EncodingABI:
+		return EncodeABIFungibleTokenPacketDataV2(&data)
+	default:
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
+	}
+}
+
+// MarshalAcknowledgement marshals the ICS20 acknowledgement of a packet with the given ics20 version and encoding.
+// The acknowledgement is encoded using the solidity ABI only if the packet data is ICS20 v2 packet data encoded
+// using the solidity ABI, and using JSON otherwise.
+func MarshalAcknowledgement(ack channeltypes.Acknowledgement, ics20Version string, encoding string) ([]byte, error) {
+	if ics20Version == V2 && encoding == EncodingABI {
+		return EncodeABIAcknowledgement(ack)
+	}
+
+	return ack.Acknowledgement(), nil
+}
+
+// UnmarshalAcknowledgement unmarshals the ICS20 acknowledgement of a packet with the given ics20 version and encoding.
+// The acknowledgement is decoded using the solidity ABI only if the packet data is ICS20 v2 packet data encoded
+// using the solidity ABI, and using JSON otherwise.
+func UnmarshalAcknowledgement(bz []byte, ics20Version string, encoding string) (channeltypes.Acknowledgement, error) {
+	if ics20Version == V2 && encoding == EncodingABI {
+		return DecodeABIAcknowledgement(bz)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+
+	return ack, nil
+}
+
 // PacketDataV1ToV2 converts a v1 packet data to a v2 packet data. The packet data is validated
 // before conversion.
 func PacketDataV1ToV2(packetData FungibleTokenPacketData) (FungibleTokenPacketDataV2, error) {
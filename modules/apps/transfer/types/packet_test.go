@@ -12,6 +12,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -798,13 +799,33 @@ func TestUnmarshalPacketData(t *testing.T) {
 			nil,
 		},
 		{
-			"failure: v2 with ABI encoding (not supported)",
+			"success: v2 with abi encoding",
 			func() {
-				packetDataBz = []byte("should fail before this gets relevant")
+				packetData := types.NewFungibleTokenPacketDataV2(
+					[]types.Token{
+						{
+							Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0")),
+							Amount: "1000",
+						},
+					}, sender, receiver, "", types.ForwardingPacketData{})
+
+				bz, err := types.EncodeABIFungibleTokenPacketDataV2(&packetData)
+				require.NoError(t, err)
+
+				packetDataBz = bz
+				version = types.V2
+				encoding = types.EncodingABI
+			},
+			nil,
+		},
+		{
+			"failure: invalid type for v2 with abi encoding",
+			func() {
+				packetDataBz = []byte("invalid")
 				version = types.V2
 				encoding = types.EncodingABI
 			},
-			errors.New("encoding application/x-solidity-abi is only supported for ICS20-V1"),
+			ibcerrors.ErrInvalidType,
 		},
 		{
 			"failure: invalid encoding",
@@ -872,6 +893,37 @@ func TestUnmarshalPacketData(t *testing.T) {
 // TestV2ForwardsCompatibilityFails asserts that new fields being added to a future proto definition of
 // FungibleTokenPacketDataV2 fail to unmarshal with previous versions. In essence, permit backwards compatibility
 // but restrict forward one.
+func TestMarshalAcknowledgement(t *testing.T) {
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrReceiveFailed)
+
+	abiAck, err := types.EncodeABIAcknowledgement(ack)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		ics20Version string
+		encoding     string
+		expAck       []byte
+	}{
+		{"v1 with json encoding", types.V1, types.EncodingJSON, ack.Acknowledgement()},
+		{"v1 with abi encoding", types.V1, types.EncodingABI, ack.Acknowledgement()},
+		{"v2 with protobuf encoding", types.V2, types.EncodingProtobuf, ack.Acknowledgement()},
+		{"v2 with abi encoding", types.V2, types.EncodingABI, abiAck},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := types.MarshalAcknowledgement(ack, tc.ics20Version, tc.encoding)
+			require.NoError(t, err)
+			require.Equal(t, tc.expAck, bz)
+
+			decodedAck, err := types.UnmarshalAcknowledgement(bz, tc.ics20Version, tc.encoding)
+			require.NoError(t, err)
+			require.Equal(t, ack, decodedAck)
+		})
+	}
+}
+
 func TestV2ForwardsCompatibilityFails(t *testing.T) {
 	var (
 		packet       types.FungibleTokenPacketDataV2
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -398,6 +399,218 @@ func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// QueryTransferEnabledRequest is the request type for the Query/TransferEnabled RPC method.
+type QueryTransferEnabledRequest struct {
+	// the denomination of the tokens on this chain.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the channel or client identifier, only the entry applying to all channels and
+	// clients is considered if empty.
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+}
+
+func (m *QueryTransferEnabledRequest) Reset()         { *m = QueryTransferEnabledRequest{} }
+func (m *QueryTransferEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferEnabledRequest) ProtoMessage()    {}
+func (*QueryTransferEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{8}
+}
+func (m *QueryTransferEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferEnabledRequest.Merge(m, src)
+}
+func (m *QueryTransferEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferEnabledRequest proto.InternalMessageInfo
+
+func (m *QueryTransferEnabledRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryTransferEnabledRequest) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+// QueryTransferEnabledResponse is the response type for the Query/TransferEnabled RPC method.
+type QueryTransferEnabledResponse struct {
+	// send_enabled is true if the tokens can be sent from this chain.
+	SendEnabled bool `protobuf:"varint,1,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled is true if the tokens can be received on this chain.
+	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *QueryTransferEnabledResponse) Reset()         { *m = QueryTransferEnabledResponse{} }
+func (m *QueryTransferEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferEnabledResponse) ProtoMessage()    {}
+func (*QueryTransferEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{9}
+}
+func (m *QueryTransferEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferEnabledResponse.Merge(m, src)
+}
+func (m *QueryTransferEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferEnabledResponse proto.InternalMessageInfo
+
+func (m *QueryTransferEnabledResponse) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *QueryTransferEnabledResponse) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// QueryAllTransferEnabledRequest is the request type for the Query/AllTransferEnabled RPC method.
+type QueryAllTransferEnabledRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTransferEnabledRequest) Reset()         { *m = QueryAllTransferEnabledRequest{} }
+func (m *QueryAllTransferEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTransferEnabledRequest) ProtoMessage()    {}
+func (*QueryAllTransferEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{10}
+}
+func (m *QueryAllTransferEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTransferEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTransferEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTransferEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTransferEnabledRequest.Merge(m, src)
+}
+func (m *QueryAllTransferEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTransferEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTransferEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTransferEnabledRequest proto.InternalMessageInfo
+
+func (m *QueryAllTransferEnabledRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllTransferEnabledResponse is the response type for the Query/AllTransferEnabled RPC method.
+type QueryAllTransferEnabledResponse struct {
+	// transfer_enabled returns the entries enabling or disabling the transfers of a denomination.
+	TransferEnabled []TransferEnabled `protobuf:"bytes,1,rep,name=transfer_enabled,json=transferEnabled,proto3" json:"transfer_enabled"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTransferEnabledResponse) Reset()         { *m = QueryAllTransferEnabledResponse{} }
+func (m *QueryAllTransferEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTransferEnabledResponse) ProtoMessage()    {}
+func (*QueryAllTransferEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{11}
+}
+func (m *QueryAllTransferEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTransferEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTransferEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTransferEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTransferEnabledResponse.Merge(m, src)
+}
+func (m *QueryAllTransferEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTransferEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTransferEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTransferEnabledResponse proto.InternalMessageInfo
+
+func (m *QueryAllTransferEnabledResponse) GetTransferEnabled() []TransferEnabled {
+	if m != nil {
+		return m.TransferEnabled
+	}
+	return nil
+}
+
+func (m *QueryAllTransferEnabledResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
@@ -407,6 +620,10 @@ func init() {
 	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
 	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
 	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+	proto.RegisterType((*QueryTransferEnabledRequest)(nil), "ibc.applications.transfer.v1.QueryTransferEnabledRequest")
+	proto.RegisterType((*QueryTransferEnabledResponse)(nil), "ibc.applications.transfer.v1.QueryTransferEnabledResponse")
+	proto.RegisterType((*QueryAllTransferEnabledRequest)(nil), "ibc.applications.transfer.v1.QueryAllTransferEnabledRequest")
+	proto.RegisterType((*QueryAllTransferEnabledResponse)(nil), "ibc.applications.transfer.v1.QueryAllTransferEnabledResponse")
 }
 
 func init() {
@@ -414,47 +631,62 @@ func init() {
 }
 
 var fileDescriptor_a638e2800a01538c = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xab, 0x26, 0x28, 0x8b, 0xca, 0x61, 0x1b, 0x3e, 0x6a, 0x05, 0xb7, 0xb2, 0x8a, 0x54,
-	0x15, 0xe2, 0x25, 0x6d, 0x21, 0x20, 0xb5, 0x48, 0xb4, 0x80, 0x28, 0xe2, 0x40, 0x53, 0x4e, 0x70,
-	0x88, 0xd6, 0xf6, 0xe2, 0x58, 0x8a, 0xbd, 0xae, 0x77, 0x13, 0x54, 0x45, 0xb9, 0xf0, 0x0b, 0x90,
-	0xfa, 0x3b, 0xf8, 0x1f, 0x3d, 0x56, 0x20, 0x21, 0x4e, 0x08, 0x25, 0xfc, 0x0b, 0x2e, 0xc8, 0xeb,
-	0x49, 0x49, 0x42, 0x1a, 0x25, 0x3d, 0x25, 0xbb, 0xf3, 0xde, 0x9b, 0x37, 0x9e, 0x67, 0xa3, 0x35,
-	0xdf, 0x76, 0x08, 0x8d, 0xa2, 0x86, 0xef, 0x50, 0xe9, 0xf3, 0x50, 0x10, 0x19, 0xd3, 0x50, 0x7c,
-	0x60, 0x31, 0x69, 0x95, 0xc9, 0x51, 0x93, 0xc5, 0xc7, 0x56, 0x14, 0x73, 0xc9, 0x71, 0xd1, 0xb7,
-	0x1d, 0x6b, 0x10, 0x69, 0xf5, 0x91, 0x56, 0xab, 0xac, 0x17, 0x3c, 0xee, 0x71, 0x05, 0x24, 0xc9,
-	0xbf, 0x94, 0xa3, 0x1b, 0x0e, 0x17, 0x01, 0x17, 0xc4, 0xa6, 0x82, 0x91, 0x56, 0xd9, 0x66, 0x92,
-	0x96, 0x89, 0xc3, 0xfd, 0x10, 0xea, 0x77, 0x27, 0x76, 0x3f, 0xd7, 0x4f, 0xc1, 0x45, 0x8f, 0x73,
-	0xaf, 0xc1, 0x08, 0x8d, 0x7c, 0x42, 0xc3, 0x90, 0x4b, 0xb0, 0xa1, 0xaa, 0x66, 0x01, 0xe1, 0x83,
-	0xc4, 0xed, 0x1b, 0x1a, 0xd3, 0x40, 0x54, 0xd9, 0x51, 0x93, 0x09, 0x69, 0x1e, 0xa2, 0xc5, 0xa1,
-	0x5b, 0x11, 0xf1, 0x50, 0x30, 0xbc, 0x8d, 0x72, 0x91, 0xba, 0xb9, 0xa5, 0xad, 0x68, 0x6b, 0x57,
-	0x37, 0x56, 0xad, 0x49, 0xc3, 0x59, 0xc0, 0x06, 0x8e, 0x59, 0x42, 0xd7, 0x95, 0xe8, 0x33, 0x16,
-	0xf2, 0xe0, 0x25, 0x15, 0x75, 0xe8, 0x86, 0x0b, 0x28, 0x2b, 0x63, 0xea, 0x30, 0xa5, 0x9a, 0xaf,
-	0xa6, 0x07, 0xf3, 0x1e, 0xba, 0x31, 0x0a, 0x07, 0x1b, 0x18, 0xcd, 0xd7, 0xa9, 0xa8, 0x03, 0x5c,
-	0xfd, 0x37, 0x0f, 0xd1, 0x92, 0x42, 0x3f, 0x17, 0x4e, 0xcc, 0x3f, 0x3e, 0x75, 0xdd, 0x98, 0x89,
-	0xfe, 0x38, 0xf8, 0x26, 0xba, 0x12, 0xf1, 0x58, 0xd6, 0x7c, 0x17, 0x38, 0xb9, 0xe4, 0xb8, 0xef,
-	0xe2, 0xdb, 0x08, 0x39, 0x75, 0x1a, 0x86, 0xac, 0x91, 0xd4, 0xe6, 0x54, 0x2d, 0x0f, 0x37, 0xfb,
-	0xae, 0xb9, 0x87, 0xf4, 0x71, 0xa2, 0x60, 0xe3, 0x0e, 0xba, 0xc6, 0x54, 0xa1, 0x46, 0xd3, 0x0a,
-	0x88, 0x2f, 0xb0, 0x41, 0xb8, 0x59, 0x41, 0xcb, 0x4a, 0xe4, 0x2d, 0x97, 0xb4, 0x91, 0x2a, 0xbd,
-	0xe0, 0xb1, 0x9a, 0x6a, 0xe0, 0x01, 0xb8, 0xc9, 0xb9, 0xff, 0x00, 0xd4, 0xc1, 0x7c, 0x8f, 0x56,
-	0x2e, 0x26, 0x82, 0x87, 0x0a, 0xca, 0xd1, 0x80, 0x37, 0x43, 0x09, 0x1b, 0x59, 0xb2, 0xd2, 0xe8,
-	0x58, 0x49, 0x74, 0x2c, 0x88, 0x8e, 0xb5, 0xc7, 0xfd, 0x70, 0x77, 0xfe, 0xf4, 0xe7, 0x72, 0xa6,
-	0x0a, 0xf0, 0x8d, 0x3f, 0x59, 0x94, 0x55, 0xea, 0xf8, 0x44, 0x43, 0xb9, 0x74, 0x53, 0xf8, 0xfe,
-	0xe4, 0x7d, 0xfe, 0x1f, 0x14, 0xbd, 0x3c, 0x03, 0x23, 0xb5, 0x6c, 0xae, 0x7e, 0xfa, 0xf6, 0xfb,
-	0x64, 0xce, 0xc0, 0x45, 0x02, 0x29, 0x1e, 0x4e, 0x6f, 0x1a, 0x16, 0xfc, 0x45, 0x43, 0xf9, 0xf3,
-	0xcd, 0xe3, 0xcd, 0x29, 0xda, 0x8c, 0xc6, 0x4a, 0xdf, 0x9a, 0x8d, 0x04, 0xf6, 0x1e, 0x28, 0x7b,
-	0x04, 0x97, 0xc6, 0xdb, 0x53, 0xab, 0xa9, 0x25, 0x91, 0x63, 0x82, 0xb4, 0x55, 0x52, 0x77, 0xd6,
-	0xd7, 0x3b, 0xf8, 0xbb, 0x86, 0x16, 0x86, 0x62, 0x82, 0x2b, 0x53, 0xb4, 0x1f, 0x97, 0x56, 0xfd,
-	0xd1, 0xec, 0x44, 0xf0, 0x5e, 0x55, 0xde, 0x5f, 0xe3, 0x57, 0xe3, 0xbd, 0x43, 0xb0, 0x05, 0x69,
-	0xff, 0x0b, 0x7d, 0x87, 0x24, 0xaf, 0x82, 0x20, 0x6d, 0x78, 0x41, 0x3a, 0x64, 0x38, 0xd3, 0xf8,
-	0xab, 0x86, 0x16, 0xc7, 0x24, 0x10, 0xef, 0x4c, 0xe1, 0xf2, 0xe2, 0xc8, 0xeb, 0x4f, 0x2e, 0x4b,
-	0x87, 0x51, 0xb7, 0xd5, 0xa8, 0x0f, 0xf1, 0xd6, 0x84, 0x35, 0x09, 0xd2, 0x56, 0xbf, 0xc9, 0x82,
-	0x88, 0x4c, 0xc4, 0x6a, 0xe9, 0x70, 0xbb, 0x07, 0xa7, 0x5d, 0x43, 0x3b, 0xeb, 0x1a, 0xda, 0xaf,
-	0xae, 0xa1, 0x7d, 0xee, 0x19, 0x99, 0xb3, 0x9e, 0x91, 0xf9, 0xd1, 0x33, 0x32, 0xef, 0x2a, 0x9e,
-	0x2f, 0xeb, 0x4d, 0xdb, 0x72, 0x78, 0x40, 0xe0, 0x2b, 0xec, 0xdb, 0x4e, 0xc9, 0xe3, 0xa4, 0xf5,
-	0x98, 0x04, 0xdc, 0x6d, 0x36, 0x98, 0x18, 0x69, 0x27, 0x8f, 0x23, 0x26, 0xec, 0x9c, 0xfa, 0x9e,
-	0x6e, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xe4, 0x12, 0x71, 0x1a, 0x06, 0x00, 0x00,
+	// 879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0x8e, 0xc3, 0x16, 0xc8, 0x29, 0x5b, 0xe1, 0x2e, 0xfc, 0x98, 0x09, 0xee, 0xb0, 0xc6, 0x16,
+	0x15, 0xe2, 0x4b, 0xba, 0x41, 0xb6, 0xa9, 0x45, 0x6c, 0x65, 0x83, 0x22, 0x24, 0xd6, 0x94, 0x27,
+	0x90, 0x88, 0xae, 0xed, 0x8b, 0x63, 0xe4, 0xf8, 0xba, 0xbe, 0x4e, 0x50, 0x15, 0xf5, 0x85, 0x67,
+	0x1e, 0x90, 0xfa, 0x77, 0xf0, 0x47, 0xf0, 0x56, 0xf5, 0xa9, 0x02, 0x09, 0xf1, 0x84, 0x50, 0xcb,
+	0x1f, 0x82, 0x7c, 0x7d, 0x92, 0x26, 0xa9, 0x13, 0xd2, 0x3e, 0x25, 0xbe, 0xe7, 0x7c, 0xe7, 0x7c,
+	0xdf, 0xb9, 0x3e, 0x9f, 0x0c, 0x35, 0xdf, 0x76, 0x28, 0x8b, 0xa2, 0xc0, 0x77, 0x58, 0xe2, 0x8b,
+	0x50, 0xd2, 0x24, 0x66, 0xa1, 0xfc, 0x9e, 0xc7, 0xb4, 0xdf, 0xa0, 0xbb, 0x3d, 0x1e, 0xef, 0x59,
+	0x51, 0x2c, 0x12, 0x41, 0xaa, 0xbe, 0xed, 0x58, 0xe3, 0x99, 0xd6, 0x30, 0xd3, 0xea, 0x37, 0xf4,
+	0x8a, 0x27, 0x3c, 0xa1, 0x12, 0x69, 0xfa, 0x2f, 0xc3, 0xe8, 0x86, 0x23, 0x64, 0x57, 0x48, 0x6a,
+	0x33, 0xc9, 0x69, 0xbf, 0x61, 0xf3, 0x84, 0x35, 0xa8, 0x23, 0xfc, 0x10, 0xe3, 0xef, 0xcd, 0xed,
+	0x3e, 0xaa, 0x9f, 0x25, 0xaf, 0x8e, 0x17, 0x53, 0xcc, 0x46, 0x25, 0x23, 0xe6, 0xf9, 0xa1, 0xaa,
+	0x80, 0xb9, 0x55, 0x4f, 0x08, 0x2f, 0xe0, 0x94, 0x45, 0x3e, 0x65, 0x61, 0x28, 0x12, 0xa4, 0xac,
+	0xa2, 0x66, 0x05, 0xc8, 0x76, 0x8a, 0x7f, 0xce, 0x62, 0xd6, 0x95, 0x2d, 0xbe, 0xdb, 0xe3, 0x32,
+	0x31, 0x77, 0xe0, 0xc6, 0xc4, 0xa9, 0x8c, 0x44, 0x28, 0x39, 0x59, 0x87, 0x52, 0xa4, 0x4e, 0xde,
+	0xd4, 0x6e, 0x69, 0xb5, 0xa5, 0xb5, 0xdb, 0xd6, 0xbc, 0x41, 0x58, 0x88, 0x46, 0x8c, 0x59, 0x87,
+	0xd7, 0x54, 0xd1, 0x4f, 0x79, 0x28, 0xba, 0x9f, 0x33, 0xd9, 0xc1, 0x6e, 0xa4, 0x02, 0x57, 0x93,
+	0x98, 0x39, 0x5c, 0x55, 0x2d, 0xb7, 0xb2, 0x07, 0xf3, 0x7d, 0x78, 0x7d, 0x3a, 0x1d, 0x69, 0x10,
+	0xb8, 0xd2, 0x61, 0xb2, 0x83, 0xe9, 0xea, 0xbf, 0xb9, 0x03, 0x37, 0x55, 0xf6, 0x53, 0xe9, 0xc4,
+	0xe2, 0xc7, 0xc7, 0xae, 0x1b, 0x73, 0x39, 0x94, 0x43, 0xde, 0x80, 0x17, 0x23, 0x11, 0x27, 0x6d,
+	0xdf, 0x45, 0x4c, 0x29, 0x7d, 0xdc, 0x72, 0xc9, 0xdb, 0x00, 0x4e, 0x87, 0x85, 0x21, 0x0f, 0xd2,
+	0x58, 0x51, 0xc5, 0xca, 0x78, 0xb2, 0xe5, 0x9a, 0x9b, 0xa0, 0xe7, 0x15, 0x45, 0x1a, 0xef, 0xc2,
+	0x75, 0xae, 0x02, 0x6d, 0x96, 0x45, 0xb0, 0xf8, 0x35, 0x3e, 0x9e, 0x6e, 0x36, 0x61, 0x45, 0x15,
+	0xf9, 0x5a, 0x24, 0x2c, 0xc8, 0x2a, 0x3d, 0x13, 0xb1, 0x52, 0x35, 0x36, 0x00, 0x37, 0x7d, 0x1e,
+	0x0e, 0x40, 0x3d, 0x98, 0xdf, 0xc2, 0xad, 0xd9, 0x40, 0xe4, 0xd0, 0x84, 0x12, 0xeb, 0x8a, 0x5e,
+	0x98, 0xe0, 0x8d, 0xdc, 0xb4, 0xb2, 0x37, 0xc3, 0x4a, 0xdf, 0x0c, 0x0b, 0xdf, 0x09, 0x6b, 0x53,
+	0xf8, 0xe1, 0x93, 0x2b, 0x87, 0x7f, 0xaf, 0x14, 0x5a, 0x98, 0x6e, 0xba, 0xf0, 0x56, 0x56, 0x1c,
+	0xef, 0xeb, 0x69, 0xc8, 0xec, 0x80, 0xbb, 0x73, 0x19, 0x11, 0x0a, 0x95, 0xe1, 0xb8, 0x44, 0xdc,
+	0x76, 0x02, 0x9f, 0x87, 0xc9, 0xd9, 0xe0, 0x5e, 0xc5, 0xd8, 0x57, 0xf1, 0xa6, 0x8a, 0x6c, 0xb9,
+	0xe6, 0x0f, 0x50, 0xcd, 0xef, 0x82, 0xf4, 0xdf, 0x81, 0x97, 0x25, 0x0f, 0xdd, 0x36, 0xcf, 0xce,
+	0x55, 0xb7, 0x97, 0x5a, 0x4b, 0xe9, 0x19, 0xa6, 0x92, 0xbb, 0xb0, 0x1c, 0x73, 0x87, 0xfb, 0x7d,
+	0x3e, 0xca, 0x2a, 0xaa, 0xac, 0xeb, 0x78, 0x8c, 0x89, 0x66, 0x07, 0x0c, 0xd5, 0xeb, 0x71, 0x10,
+	0xcc, 0x10, 0xf5, 0x0c, 0xe0, 0x6c, 0x3b, 0x70, 0x60, 0x77, 0x26, 0x06, 0x96, 0x2d, 0xf9, 0x70,
+	0x6c, 0xcf, 0x99, 0xc7, 0x11, 0xdb, 0x1a, 0x43, 0x9a, 0x47, 0x1a, 0xac, 0xcc, 0x6c, 0x85, 0xca,
+	0xbe, 0x83, 0x57, 0x86, 0xab, 0x30, 0xa6, 0xee, 0x85, 0xda, 0xd2, 0x5a, 0x7d, 0xfe, 0xd2, 0x4c,
+	0x15, 0xc4, 0x6b, 0x5b, 0x4e, 0x26, 0x8f, 0xc9, 0x67, 0x13, 0x5a, 0x8a, 0x4a, 0xcb, 0xdd, 0xff,
+	0xd5, 0x92, 0x91, 0x1b, 0x17, 0xb3, 0xf6, 0x73, 0x19, 0xae, 0x2a, 0x31, 0xe4, 0x40, 0x83, 0x52,
+	0xb6, 0xb2, 0xe4, 0x83, 0xf9, 0x1c, 0xcf, 0x3b, 0x86, 0xde, 0xb8, 0x00, 0x22, 0x63, 0x61, 0xde,
+	0xfe, 0xe9, 0x8f, 0x7f, 0x0f, 0x8a, 0x06, 0xa9, 0x52, 0xb4, 0xbe, 0x49, 0xcb, 0xcb, 0x5c, 0x83,
+	0xfc, 0xaa, 0x41, 0x79, 0x64, 0x01, 0xe4, 0xde, 0x02, 0x6d, 0xa6, 0xfd, 0x45, 0xbf, 0x7f, 0x31,
+	0x10, 0xd2, 0xfb, 0x50, 0xd1, 0xa3, 0xa4, 0x9e, 0x4f, 0x4f, 0x6d, 0x44, 0x3b, 0xf5, 0x1e, 0x2e,
+	0xe9, 0x40, 0x59, 0xd6, 0xc6, 0xea, 0xea, 0x3e, 0xf9, 0x53, 0x83, 0x6b, 0x13, 0x7e, 0x41, 0x9a,
+	0x0b, 0xb4, 0xcf, 0xb3, 0x2d, 0xfd, 0xc1, 0xc5, 0x81, 0xc8, 0xbd, 0xa5, 0xb8, 0x7f, 0x49, 0xbe,
+	0xc8, 0xe7, 0x8e, 0x8b, 0x2a, 0xe9, 0xe0, 0xcc, 0xfd, 0xf6, 0x69, 0xea, 0x89, 0x92, 0x0e, 0xd0,
+	0x29, 0xf7, 0xe9, 0xa4, 0xb9, 0x91, 0xdf, 0x35, 0xb8, 0x91, 0x63, 0x45, 0x64, 0x63, 0x01, 0x96,
+	0xb3, 0xbd, 0x4f, 0xff, 0xf8, 0xb2, 0x70, 0x94, 0xba, 0xae, 0xa4, 0x7e, 0x44, 0xee, 0xcf, 0xb9,
+	0x26, 0x49, 0x07, 0xea, 0x37, 0xbd, 0x20, 0x9a, 0xa4, 0xc5, 0xda, 0x99, 0x38, 0x72, 0xa4, 0xc1,
+	0xf2, 0xd4, 0xc6, 0x91, 0x87, 0x8b, 0x30, 0xca, 0x75, 0x18, 0xfd, 0xd1, 0x65, 0xa0, 0x28, 0xe4,
+	0x13, 0x25, 0xe4, 0x11, 0x79, 0xb0, 0xb0, 0x90, 0x29, 0x7f, 0x21, 0xbf, 0x69, 0x40, 0xce, 0x5b,
+	0x12, 0x59, 0x5f, 0x80, 0xd4, 0x4c, 0xd3, 0xd4, 0x37, 0x2e, 0x89, 0x46, 0x55, 0x96, 0x52, 0x55,
+	0x23, 0x77, 0xf2, 0x55, 0x4d, 0x6b, 0x78, 0xb2, 0x7d, 0x78, 0x62, 0x68, 0xc7, 0x27, 0x86, 0xf6,
+	0xcf, 0x89, 0xa1, 0xfd, 0x72, 0x6a, 0x14, 0x8e, 0x4f, 0x8d, 0xc2, 0x5f, 0xa7, 0x46, 0xe1, 0x9b,
+	0xa6, 0xe7, 0x27, 0x9d, 0x9e, 0x6d, 0x39, 0xa2, 0x4b, 0xf1, 0xf3, 0xc7, 0xb7, 0x9d, 0xba, 0x27,
+	0x68, 0xff, 0x21, 0xed, 0x0a, 0xb7, 0x17, 0x70, 0x39, 0xd5, 0x20, 0xd9, 0x8b, 0xb8, 0xb4, 0x4b,
+	0xea, 0x4b, 0xe7, 0xde, 0x7f, 0x03, 0x00, 0xe5, 0x2f, 0x4b, 0xdf, 0xe0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -477,6 +709,10 @@ type QueryClient interface {
 	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
 	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
 	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+	// TransferEnabled returns whether tokens of a denomination can be sent and received over a channel or client.
+	TransferEnabled(ctx context.Context, in *QueryTransferEnabledRequest, opts ...grpc.CallOption) (*QueryTransferEnabledResponse, error)
+	// AllTransferEnabled returns all the entries enabling or disabling the transfers of a denomination.
+	AllTransferEnabled(ctx context.Context, in *QueryAllTransferEnabledRequest, opts ...grpc.CallOption) (*QueryAllTransferEnabledResponse, error)
 }
 
 type queryClient struct {
@@ -523,6 +759,24 @@ func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEsc
 	return out, nil
 }
 
+func (c *queryClient) TransferEnabled(ctx context.Context, in *QueryTransferEnabledRequest, opts ...grpc.CallOption) (*QueryTransferEnabledResponse, error) {
+	out := new(QueryTransferEnabledResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TransferEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTransferEnabled(ctx context.Context, in *QueryAllTransferEnabledRequest, opts ...grpc.CallOption) (*QueryAllTransferEnabledResponse, error) {
+	out := new(QueryAllTransferEnabledResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/AllTransferEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ibc-transfer module.
@@ -533,6 +787,10 @@ type QueryServer interface {
 	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
 	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
 	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+	// TransferEnabled returns whether tokens of a denomination can be sent and received over a channel or client.
+	TransferEnabled(context.Context, *QueryTransferEnabledRequest) (*QueryTransferEnabledResponse, error)
+	// AllTransferEnabled returns all the entries enabling or disabling the transfers of a denomination.
+	AllTransferEnabled(context.Context, *QueryAllTransferEnabledRequest) (*QueryAllTransferEnabledResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -551,6 +809,12 @@ func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEs
 func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
 }
+func (*UnimplementedQueryServer) TransferEnabled(ctx context.Context, req *QueryTransferEnabledRequest) (*QueryTransferEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEnabled not implemented")
+}
+func (*UnimplementedQueryServer) AllTransferEnabled(ctx context.Context, req *QueryAllTransferEnabledRequest) (*QueryAllTransferEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTransferEnabled not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -628,6 +892,42 @@ func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TransferEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TransferEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferEnabled(ctx, req.(*QueryTransferEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTransferEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransferEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTransferEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/AllTransferEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTransferEnabled(ctx, req.(*QueryAllTransferEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -648,6 +948,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalEscrowForDenom",
 			Handler:    _Query_TotalEscrowForDenom_Handler,
 		},
+		{
+			MethodName: "TransferEnabled",
+			Handler:    _Query_TransferEnabled_Handler,
+		},
+		{
+			MethodName: "AllTransferEnabled",
+			Handler:    _Query_AllTransferEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/query.proto",
@@ -901,80 +1209,244 @@ func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTransferEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryTransferEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTransferEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Trace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTransferEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTransferEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEscrowAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTransferEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransferEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransferEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransferEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransferEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransferEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransferEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferEnabled) > 0 {
+		for iNdEx := len(m.TransferEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryEscrowAddressResponse) Size() (n int) {
@@ -1000,27 +1472,391 @@ func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTransferEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllTransferEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTransferEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferEnabled) > 0 {
+		for _, e := range m.TransferEnabled {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1043,12 +1879,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1070,7 +1970,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,17 +1993,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1113,27 +2013,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1156,7 +2052,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1179,15 +2075,15 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1215,7 +2111,7 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trace = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1238,7 +2134,7 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,17 +2157,17 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1281,23 +2177,24 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1320,7 +2217,7 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1343,15 +2240,15 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1379,11 +2276,11 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1411,7 +2308,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1434,7 +2331,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,17 +2354,17 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1477,24 +2374,32 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReceiveEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1516,7 +2421,7 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTransferEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,17 +2444,17 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTransferEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTransferEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1559,23 +2464,27 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1598,7 +2507,7 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTransferEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1621,15 +2530,15 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTransferEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTransferEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1656,7 +2565,44 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferEnabled = append(m.TransferEnabled, TransferEnabled{})
+			if err := m.TransferEnabled[len(m.TransferEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
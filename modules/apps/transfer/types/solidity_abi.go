@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	errorsmod "cosmossdk.io/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 )
 
 // getICS20ABI returns an abi.Arguments slice describing the Solidity types of the struct.
@@ -109,3 +111,309 @@ func EncodeABIFungibleTokenPacketData(data *FungibleTokenPacketData) ([]byte, er
 
 	return encodedData, nil
 }
+
+// abiHop is the Go representation of the solidity ABI tuple of a Hop.
+type abiHop struct {
+	PortID    string `abi:"portId"`
+	ChannelID string `abi:"channelId"`
+}
+
+// abiDenom is the Go representation of the solidity ABI tuple of a Denom.
+type abiDenom struct {
+	Base  string
+	Trace []abiHop
+}
+
+// abiToken is the Go representation of the solidity ABI tuple of a Token.
+type abiToken struct {
+	Denom  abiDenom
+	Amount *big.Int
+}
+
+// abiForwardingPacketData is the Go representation of the solidity ABI tuple of a ForwardingPacketData.
+type abiForwardingPacketData struct {
+	DestinationMemo string
+	Hops            []abiHop
+}
+
+// abiFungibleTokenPacketDataV2 is the Go representation of the solidity ABI tuple of a FungibleTokenPacketDataV2.
+type abiFungibleTokenPacketDataV2 struct {
+	Tokens     []abiToken
+	Sender     string
+	Receiver   string
+	Memo       string
+	Forwarding abiForwardingPacketData
+}
+
+// abiAcknowledgement is the Go representation of the solidity ABI tuple of an Acknowledgement.
+type abiAcknowledgement struct {
+	Success bool
+	Result  []byte
+	Error   string
+}
+
+// getICS20V2ABI returns an abi.Arguments slice describing the Solidity types of the FungibleTokenPacketDataV2 struct.
+func getICS20V2ABI() abi.Arguments {
+	// The Solidity types used are:
+	// - string for Sender, Receiver, Memo, Base, DestinationMemo and the hop identifiers.
+	// - uint256 for Amount.
+	// - dynamic arrays of tuples for Tokens, Trace and Hops.
+	hopComponents := []abi.ArgumentMarshaling{
+		{
+			Name: "portId",
+			Type: "string",
+		},
+		{
+			Name: "channelId",
+			Type: "string",
+		},
+	}
+
+	tupleType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "tokens",
+			Type: "tuple[]",
+			Components: []abi.ArgumentMarshaling{
+				{
+					Name: "denom",
+					Type: "tuple",
+					Components: []abi.ArgumentMarshaling{
+						{
+							Name: "base",
+							Type: "string",
+						},
+						{
+							Name:       "trace",
+							Type:       "tuple[]",
+							Components: hopComponents,
+						},
+					},
+				},
+				{
+					Name: "amount",
+					Type: "uint256",
+				},
+			},
+		},
+		{
+			Name: "sender",
+			Type: "string",
+		},
+		{
+			Name: "receiver",
+			Type: "string",
+		},
+		{
+			Name: "memo",
+			Type: "string",
+		},
+		{
+			Name: "forwarding",
+			Type: "tuple",
+			Components: []abi.ArgumentMarshaling{
+				{
+					Name: "destinationMemo",
+					Type: "string",
+				},
+				{
+					Name:       "hops",
+					Type:       "tuple[]",
+					Components: hopComponents,
+				},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{
+		{
+			Type: tupleType,
+		},
+	}
+}
+
+// getAcknowledgementABI returns an abi.Arguments slice describing the Solidity types of the Acknowledgement struct.
+func getAcknowledgementABI() abi.Arguments {
+	// The Solidity types used are:
+	// - bool for Success.
+	// - bytes for Result.
+	// - string for Error.
+	tupleType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "success",
+			Type: "bool",
+		},
+		{
+			Name: "result",
+			Type: "bytes",
+		},
+		{
+			Name: "error",
+			Type: "string",
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{
+		{
+			Type: tupleType,
+		},
+	}
+}
+
+// DecodeABIFungibleTokenPacketDataV2 decodes a solidity ABI encoded FungibleTokenPacketDataV2
+// and converts it into an ibc-go FungibleTokenPacketDataV2.
+func DecodeABIFungibleTokenPacketDataV2(data []byte) (*FungibleTokenPacketDataV2, error) {
+	arguments := getICS20V2ABI()
+
+	packetDataI, err := arguments.Unpack(data)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to unpack data: %s", err)
+	}
+
+	// the unpacked tuple is copied into the first field of the wrapping struct.
+	var packetData struct {
+		Data abiFungibleTokenPacketDataV2
+	}
+	if err := arguments.Copy(&packetData, packetDataI); err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to parse packet data: %s", err)
+	}
+
+	tokens := make([]Token, len(packetData.Data.Tokens))
+	for i, token := range packetData.Data.Tokens {
+		if token.Amount == nil {
+			return nil, errorsmod.Wrapf(ErrAbiDecoding, "amount of token %d is missing", i)
+		}
+
+		tokens[i] = Token{
+			Denom:  NewDenom(token.Denom.Base, hopsFromABI(token.Denom.Trace)...),
+			Amount: token.Amount.String(),
+		}
+	}
+
+	return &FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   packetData.Data.Sender,
+		Receiver: packetData.Data.Receiver,
+		Memo:     packetData.Data.Memo,
+		Forwarding: ForwardingPacketData{
+			DestinationMemo: packetData.Data.Forwarding.DestinationMemo,
+			Hops:            hopsFromABI(packetData.Data.Forwarding.Hops),
+		},
+	}, nil
+}
+
+// EncodeABIFungibleTokenPacketDataV2 encodes the FungibleTokenPacketDataV2 using the solidity ABI.
+func EncodeABIFungibleTokenPacketDataV2(data *FungibleTokenPacketDataV2) ([]byte, error) {
+	tokens := make([]abiToken, len(data.Tokens))
+	for i, token := range data.Tokens {
+		amount, ok := new(big.Int).SetString(token.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to parse amount: %s", token.Amount)
+		}
+
+		tokens[i] = abiToken{
+			Denom: abiDenom{
+				Base:  token.Denom.Base,
+				Trace: hopsToABI(token.Denom.Trace),
+			},
+			Amount: amount,
+		}
+	}
+
+	packetData := abiFungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+		Forwarding: abiForwardingPacketData{
+			DestinationMemo: data.Forwarding.DestinationMemo,
+			Hops:            hopsToABI(data.Forwarding.Hops),
+		},
+	}
+
+	arguments := getICS20V2ABI()
+	encodedData, err := arguments.Pack(packetData)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to pack data: %s", err)
+	}
+
+	return encodedData, nil
+}
+
+// DecodeABIAcknowledgement decodes a solidity ABI encoded acknowledgement and converts it into
+// an ibc-go channel Acknowledgement.
+func DecodeABIAcknowledgement(data []byte) (channeltypes.Acknowledgement, error) {
+	arguments := getAcknowledgementABI()
+
+	ackI, err := arguments.Unpack(data)
+	if err != nil {
+		return channeltypes.Acknowledgement{}, errorsmod.Wrapf(ErrAbiDecoding, "failed to unpack acknowledgement: %s", err)
+	}
+
+	// the unpacked tuple is copied into the first field of the wrapping struct.
+	var ack struct {
+		Data abiAcknowledgement
+	}
+	if err := arguments.Copy(&ack, ackI); err != nil {
+		return channeltypes.Acknowledgement{}, errorsmod.Wrapf(ErrAbiDecoding, "failed to parse acknowledgement: %s", err)
+	}
+
+	if !ack.Data.Success {
+		return channeltypes.Acknowledgement{
+			Response: &channeltypes.Acknowledgement_Error{Error: ack.Data.Error},
+		}, nil
+	}
+
+	return channeltypes.NewResultAcknowledgement(ack.Data.Result), nil
+}
+
+// EncodeABIAcknowledgement encodes the acknowledgement using the solidity ABI.
+func EncodeABIAcknowledgement(ack channeltypes.Acknowledgement) ([]byte, error) {
+	abiAck := abiAcknowledgement{
+		Success: ack.Success(),
+		Result:  ack.GetResult(),
+		Error:   ack.GetError(),
+	}
+
+	// the result of a successful acknowledgement must not be nil for it to be packed as bytes.
+	if abiAck.Result == nil {
+		abiAck.Result = []byte{}
+	}
+
+	arguments := getAcknowledgementABI()
+	encodedAck, err := arguments.Pack(abiAck)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to pack acknowledgement: %s", err)
+	}
+
+	return encodedAck, nil
+}
+
+// hopsFromABI converts the solidity ABI representation of hops into ibc-go hops.
+func hopsFromABI(abiHops []abiHop) []Hop {
+	if len(abiHops) == 0 {
+		return nil
+	}
+
+	hops := make([]Hop, len(abiHops))
+	for i, hop := range abiHops {
+		hops[i] = NewHop(hop.PortID, hop.ChannelID)
+	}
+
+	return hops
+}
+
+// hopsToABI converts ibc-go hops into their solidity ABI representation.
+func hopsToABI(hops []Hop) []abiHop {
+	abiHops := make([]abiHop, len(hops))
+	for i, hop := range hops {
+		abiHops[i] = abiHop{PortID: hop.PortId, ChannelID: hop.ChannelId}
+	}
+
+	return abiHops
+}
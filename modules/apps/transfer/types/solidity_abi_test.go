@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 )
 
 func (suite *TypesTestSuite) TestFTPD() {
@@ -21,3 +22,65 @@ func (suite *TypesTestSuite) TestFTPD() {
 
 	suite.Require().Equal(packetData, *decodedPacketData)
 }
+
+func (suite *TypesTestSuite) TestFTPDV2() {
+	packetData := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{
+			{
+				Denom:  types.NewDenom("uatom", types.NewHop("transfer", "07-tendermint-0"), types.NewHop("transfer", "channel-1")),
+				Amount: "1000000",
+			},
+			{
+				Denom:  types.NewDenom("uosmo"),
+				Amount: "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			},
+		},
+		"sender",
+		"receiver",
+		"",
+		types.NewForwardingPacketData("destination memo", types.NewHop("transfer", "07-tendermint-1")),
+	)
+
+	bz, err := types.EncodeABIFungibleTokenPacketDataV2(&packetData)
+	suite.Require().NoError(err)
+
+	decodedPacketData, err := types.DecodeABIFungibleTokenPacketDataV2(bz)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(packetData, *decodedPacketData)
+
+	packetData.Tokens[0].Amount = "-1"
+	_, err = types.EncodeABIFungibleTokenPacketDataV2(&packetData)
+	suite.Require().ErrorIs(err, types.ErrAbiEncoding)
+}
+
+func (suite *TypesTestSuite) TestABIAcknowledgement() {
+	testCases := []struct {
+		name string
+		ack  channeltypes.Acknowledgement
+	}{
+		{
+			"result acknowledgement",
+			channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+		},
+		{
+			"error acknowledgement",
+			channeltypes.NewErrorAcknowledgement(types.ErrReceiveFailed),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			bz, err := types.EncodeABIAcknowledgement(tc.ack)
+			suite.Require().NoError(err)
+
+			decodedAck, err := types.DecodeABIAcknowledgement(bz)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.ack, decodedAck)
+		})
+	}
+
+	_, err := types.DecodeABIAcknowledgement([]byte("invalid"))
+	suite.Require().ErrorIs(err, types.ErrAbiDecoding)
+}
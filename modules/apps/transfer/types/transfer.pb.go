@@ -24,9 +24,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of IBC transfer parameters.
-// NOTE: To prevent a single token from being transferred, set the
-// TransfersEnabled parameter to true and then set the bank module's SendEnabled
-// parameter for the denomination to false.
+// NOTE: To prevent a single token from being transferred, keep the
+// send_enabled and receive_enabled parameters set to true and disable the
+// transfers of the denomination using MsgSetTransferEnabled.
 type Params struct {
 	// send_enabled enables or disables all cross-chain token transfers from this
 	// chain.
@@ -83,6 +83,85 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+// TransferEnabled defines whether tokens of a denomination can be sent and
+// received using ICS-20 transfers, either over all channels and clients or over
+// a single channel or client.
+type TransferEnabled struct {
+	// the denomination of the tokens on this chain, i.e. its base denomination or
+	// its ibc/{hash} denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the channel (IBC v1) or client (IBC v2) identifier over which the transfers
+	// are enabled or disabled. The flags apply to all channels and clients if empty.
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// send_enabled enables or disables cross-chain transfers of the denomination
+	// from this chain.
+	SendEnabled bool `protobuf:"varint,3,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables cross-chain transfers of the denomination
+	// to this chain.
+	ReceiveEnabled bool `protobuf:"varint,4,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *TransferEnabled) Reset()         { *m = TransferEnabled{} }
+func (m *TransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*TransferEnabled) ProtoMessage()    {}
+func (*TransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{1}
+}
+func (m *TransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferEnabled.Merge(m, src)
+}
+func (m *TransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferEnabled proto.InternalMessageInfo
+
+func (m *TransferEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferEnabled) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *TransferEnabled) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *TransferEnabled) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
 // Forwarding defines a list of port ID, channel ID pairs determining the path
 // through which a packet must be forwarded, and an unwind boolean indicating if
 // the coin should be unwinded to its native chain before forwarding.
@@ -97,7 +176,7 @@ func (m *Forwarding) Reset()         { *m = Forwarding{} }
 func (m *Forwarding) String() string { return proto.CompactTextString(m) }
 func (*Forwarding) ProtoMessage()    {}
 func (*Forwarding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5041673e96e97901, []int{1}
+	return fileDescriptor_5041673e96e97901, []int{2}
 }
 func (m *Forwarding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,7 +229,7 @@ type Hop struct {
 func (m *Hop) Reset()      { *m = Hop{} }
 func (*Hop) ProtoMessage() {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5041673e96e97901, []int{2}
+	return fileDescriptor_5041673e96e97901, []int{3}
 }
 func (m *Hop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,6 +274,7 @@ func (m *Hop) GetChannelId() string {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*TransferEnabled)(nil), "ibc.applications.transfer.v1.TransferEnabled")
 	proto.RegisterType((*Forwarding)(nil), "ibc.applications.transfer.v1.Forwarding")
 	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
 }
@@ -204,28 +284,32 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0x87, 0x93, 0xb6, 0x44, 0xbb, 0x15, 0x85, 0x45, 0xb4, 0x88, 0xa6, 0x7f, 0x2e, 0x16, 0xc4,
-	0x2c, 0xd5, 0x83, 0xa8, 0xb7, 0x8a, 0xd2, 0xde, 0xb4, 0x78, 0xf2, 0x52, 0x36, 0x9b, 0x35, 0x5d,
-	0x48, 0x76, 0x96, 0xdd, 0x34, 0xc5, 0xb7, 0xf0, 0xe8, 0xd1, 0xc7, 0xe9, 0xb1, 0x47, 0x4f, 0x22,
-	0xed, 0x8b, 0x48, 0xd2, 0x58, 0x7a, 0xf2, 0x36, 0xf3, 0xcd, 0x37, 0xc3, 0xb2, 0x3f, 0x74, 0x26,
-	0x7c, 0x46, 0xa8, 0x52, 0x91, 0x60, 0x34, 0x11, 0x20, 0x0d, 0x49, 0x34, 0x95, 0xe6, 0x95, 0x6b,
-	0x92, 0x76, 0xd7, 0xb5, 0xa7, 0x34, 0x24, 0x80, 0x8f, 0x85, 0xcf, 0xbc, 0x4d, 0xd9, 0x5b, 0x0b,
-	0x69, 0xf7, 0x68, 0x3f, 0x84, 0x10, 0x72, 0x91, 0x64, 0xd5, 0x6a, 0xa7, 0xfd, 0x8c, 0x9c, 0x47,
-	0xaa, 0x69, 0x6c, 0x70, 0x0b, 0xed, 0x18, 0x2e, 0x83, 0x11, 0x97, 0xd4, 0x8f, 0x78, 0x50, 0xb7,
-	0x9b, 0x76, 0x67, 0x7b, 0x58, 0xcb, 0xd8, 0xfd, 0x0a, 0xe1, 0x53, 0xb4, 0xa7, 0x39, 0xe3, 0x22,
-	0xe5, 0x6b, 0xab, 0x94, 0x5b, 0xbb, 0x05, 0x2e, 0xc4, 0x36, 0x45, 0xe8, 0x01, 0xf4, 0x94, 0xea,
-	0x40, 0xc8, 0x10, 0x1f, 0x20, 0x67, 0x22, 0xa7, 0x42, 0xfe, 0xdd, 0x2c, 0x3a, 0x7c, 0x8b, 0x2a,
-	0x63, 0x50, 0xa6, 0x5e, 0x6a, 0x96, 0x3b, 0xb5, 0x8b, 0x96, 0xf7, 0xdf, 0xf3, 0xbd, 0x3e, 0xa8,
-	0x5e, 0x65, 0xf6, 0xdd, 0xb0, 0x86, 0xf9, 0x52, 0xfb, 0x0e, 0x95, 0xfb, 0xa0, 0xf0, 0x21, 0xda,
-	0x52, 0xa0, 0x93, 0x91, 0x58, 0x1d, 0xaf, 0x0e, 0x9d, 0xac, 0x1d, 0x04, 0xf8, 0x04, 0x21, 0x36,
-	0xa6, 0x52, 0xf2, 0x28, 0x9b, 0x95, 0xf2, 0x59, 0xb5, 0x20, 0x83, 0xe0, 0xa6, 0xf2, 0xf1, 0xd9,
-	0xb0, 0x7a, 0x4f, 0xb3, 0x85, 0x6b, 0xcf, 0x17, 0xae, 0xfd, 0xb3, 0x70, 0xed, 0xf7, 0xa5, 0x6b,
-	0xcd, 0x97, 0xae, 0xf5, 0xb5, 0x74, 0xad, 0x97, 0xab, 0x50, 0x24, 0xe3, 0x89, 0xef, 0x31, 0x88,
-	0x09, 0x03, 0x13, 0x83, 0x21, 0xc2, 0x67, 0xe7, 0x21, 0x90, 0xf4, 0x9a, 0xc4, 0x10, 0x4c, 0x22,
-	0x6e, 0xb2, 0x60, 0x36, 0x02, 0x49, 0xde, 0x14, 0x37, 0xbe, 0x93, 0xff, 0xeb, 0xe5, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x58, 0xc2, 0xeb, 0x86, 0xba, 0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xab, 0xd3, 0x40,
+	0x10, 0xc7, 0x93, 0xd7, 0x18, 0xed, 0x3e, 0xf1, 0xe1, 0x52, 0xf4, 0x21, 0x9a, 0xf7, 0xda, 0x8b,
+	0x05, 0x31, 0x4b, 0xf5, 0x20, 0xea, 0xad, 0x45, 0x69, 0x4f, 0x6a, 0xe8, 0xc9, 0x4b, 0xd8, 0xec,
+	0xae, 0xe9, 0x42, 0xb2, 0xb3, 0xec, 0xa6, 0x29, 0xfe, 0x17, 0x1e, 0x3d, 0x7a, 0xf0, 0x8f, 0xe9,
+	0xb1, 0x47, 0x4f, 0x22, 0xed, 0x3f, 0x22, 0xf9, 0xd1, 0x50, 0x50, 0xc4, 0xdb, 0xcc, 0x77, 0x3e,
+	0x33, 0x99, 0x6f, 0x76, 0xd0, 0x13, 0x99, 0x30, 0x42, 0xb5, 0xce, 0x24, 0xa3, 0x85, 0x04, 0x65,
+	0x49, 0x61, 0xa8, 0xb2, 0x9f, 0x84, 0x21, 0xe5, 0xa4, 0x8b, 0x43, 0x6d, 0xa0, 0x00, 0xfc, 0x50,
+	0x26, 0x2c, 0x3c, 0x85, 0xc3, 0x0e, 0x28, 0x27, 0x0f, 0x06, 0x29, 0xa4, 0x50, 0x83, 0xa4, 0x8a,
+	0x9a, 0x9e, 0xd1, 0x12, 0xf9, 0xef, 0xa9, 0xa1, 0xb9, 0xc5, 0x43, 0x74, 0xdb, 0x0a, 0xc5, 0x63,
+	0xa1, 0x68, 0x92, 0x09, 0x7e, 0xe9, 0x5e, 0xbb, 0xe3, 0x5b, 0xd1, 0x79, 0xa5, 0xbd, 0x69, 0x24,
+	0xfc, 0x18, 0x5d, 0x18, 0xc1, 0x84, 0x2c, 0x45, 0x47, 0x9d, 0xd5, 0xd4, 0x9d, 0x56, 0x6e, 0xc1,
+	0xd1, 0x77, 0x17, 0x5d, 0x2c, 0xdb, 0x6f, 0x1f, 0x9b, 0x07, 0xe8, 0x06, 0x17, 0x0a, 0xf2, 0x7a,
+	0x70, 0x3f, 0x6a, 0x12, 0x4c, 0xd0, 0x80, 0xad, 0xa8, 0x52, 0x22, 0x8b, 0xc1, 0xc4, 0x2c, 0x93,
+	0x42, 0x15, 0xb1, 0x6c, 0xe6, 0xf6, 0xa3, 0xbb, 0x6d, 0xed, 0x9d, 0x99, 0xd5, 0x95, 0x05, 0xff,
+	0x63, 0xcd, 0xde, 0x7f, 0xad, 0xe9, 0xfd, 0x75, 0x4d, 0x8a, 0xd0, 0x5b, 0x30, 0x1b, 0x6a, 0xb8,
+	0x54, 0x29, 0xbe, 0x87, 0xfc, 0xb5, 0xda, 0x48, 0x75, 0xb4, 0xde, 0x66, 0xf8, 0x35, 0xf2, 0x56,
+	0xa0, 0xed, 0xe5, 0xd9, 0x75, 0x6f, 0x7c, 0xfe, 0x6c, 0x18, 0xfe, 0xeb, 0x2f, 0x87, 0x73, 0xd0,
+	0x53, 0x6f, 0xfb, 0xf3, 0xca, 0x89, 0xea, 0xa6, 0xd1, 0x0c, 0xf5, 0xe6, 0xa0, 0xf1, 0x7d, 0x74,
+	0x53, 0x83, 0xa9, 0x9d, 0x35, 0xf6, 0xfd, 0x2a, 0x5d, 0x70, 0xfc, 0x08, 0xa1, 0xa3, 0xff, 0xce,
+	0x75, 0xbf, 0x55, 0x16, 0xfc, 0x95, 0xf7, 0xf5, 0xdb, 0x95, 0x33, 0xfd, 0xb0, 0xdd, 0x07, 0xee,
+	0x6e, 0x1f, 0xb8, 0xbf, 0xf6, 0x81, 0xfb, 0xe5, 0x10, 0x38, 0xbb, 0x43, 0xe0, 0xfc, 0x38, 0x04,
+	0xce, 0xc7, 0x17, 0xa9, 0x2c, 0x56, 0xeb, 0x24, 0x64, 0x90, 0x13, 0x06, 0x36, 0x07, 0x4b, 0x64,
+	0xc2, 0x9e, 0xa6, 0x40, 0xca, 0x97, 0x24, 0x07, 0xbe, 0xce, 0x84, 0xad, 0xee, 0xe7, 0xe4, 0x6e,
+	0x8a, 0xcf, 0x5a, 0xd8, 0xc4, 0xaf, 0x9f, 0xff, 0xf9, 0xef, 0x01, 0x00, 0x07, 0xb2, 0x84, 0xbe,
+	0x61, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -271,6 +355,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Forwarding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -381,6 +522,29 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *TransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *Forwarding) Size() (n int) {
 	if m == nil {
 		return 0
@@ -512,6 +676,160 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Forwarding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
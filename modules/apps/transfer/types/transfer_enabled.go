@@ -0,0 +1,66 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewTransferEnabled creates a new TransferEnabled instance. An empty channelOrClientID applies
+// the flags to all channels and clients.
+func NewTransferEnabled(denom, channelOrClientID string, sendEnabled, receiveEnabled bool) TransferEnabled {
+	return TransferEnabled{
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+		SendEnabled:       sendEnabled,
+		ReceiveEnabled:    receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the TransferEnabled fields.
+func (te TransferEnabled) Validate() error {
+	if err := sdk.ValidateDenom(te.Denom); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if strings.TrimSpace(te.ChannelOrClientId) == "" {
+		return nil
+	}
+
+	// the identifier validation of channels is used as it also accepts all valid client identifiers.
+	if err := host.ChannelIdentifierValidator(te.ChannelOrClientId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel or client identifier %s", te.ChannelOrClientId)
+	}
+
+	return nil
+}
+
+// IsDefault returns true if both sending and receiving are enabled, which is the default for all denominations.
+func (te TransferEnabled) IsDefault() bool {
+	return te.SendEnabled && te.ReceiveEnabled
+}
+
+// ValidateTransferEnabled validates each of the transfer enabled entries and returns an error if
+// more than one entry is provided for the same denomination and channel or client.
+func ValidateTransferEnabled(transferEnabled []TransferEnabled) error {
+	seen := make(map[string]bool)
+	for _, te := range transferEnabled {
+		if err := te.Validate(); err != nil {
+			return err
+		}
+
+		key := string(TransferEnabledStoreKey(te.ChannelOrClientId, te.Denom))
+		if seen[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate transfer enabled entry for denom (%s) and channel or client (%s)", te.Denom, te.ChannelOrClientId)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
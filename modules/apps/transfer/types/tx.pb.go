@@ -132,6 +132,101 @@ func (m *MsgTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferResponse proto.InternalMessageInfo
 
+// MsgTransferV2 defines a msg to transfer fungible tokens (i.e Coins) over IBC v2.
+// The packet is sent from the transfer port over the given source client, with
+// a FungibleTokenPacketDataV2 payload encoded using the given encoding.
+type MsgTransferV2 struct {
+	// the client by which the packet will be sent
+	SourceClient string `protobuf:"bytes,1,opt,name=source_client,json=sourceClient,proto3" json:"source_client,omitempty"`
+	// the sender address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Timeout timestamp in absolute seconds since unix epoch.
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// optional memo
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// tokens to be transferred
+	Tokens []types.Coin `protobuf:"bytes,6,rep,name=tokens,proto3" json:"tokens"`
+	// optional forwarding information, the channel identifier of each hop is
+	// interpreted as the client identifier of the next hop.
+	Forwarding *Forwarding `protobuf:"bytes,7,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// the encoding of the payload value, defaults to protobuf when empty.
+	Encoding string `protobuf:"bytes,8,opt,name=encoding,proto3" json:"encoding,omitempty"`
+}
+
+func (m *MsgTransferV2) Reset()         { *m = MsgTransferV2{} }
+func (m *MsgTransferV2) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferV2) ProtoMessage()    {}
+func (*MsgTransferV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{2}
+}
+func (m *MsgTransferV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferV2.Merge(m, src)
+}
+func (m *MsgTransferV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferV2 proto.InternalMessageInfo
+
+// MsgTransferV2Response defines the Msg/TransferV2 response type.
+type MsgTransferV2Response struct {
+	// sequence number of the transfer packet sent
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgTransferV2Response) Reset()         { *m = MsgTransferV2Response{} }
+func (m *MsgTransferV2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferV2Response) ProtoMessage()    {}
+func (*MsgTransferV2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{3}
+}
+func (m *MsgTransferV2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferV2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferV2Response.Merge(m, src)
+}
+func (m *MsgTransferV2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferV2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferV2Response proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// signer address
@@ -146,7 +241,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7401ed9bed2f8e09, []int{2}
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +279,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7401ed9bed2f8e09, []int{3}
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,11 +308,96 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetTransferEnabled is the Msg/SetTransferEnabled request type.
+type MsgSetTransferEnabled struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// transfer_enabled defines the denominations for which transfers are enabled
+	// or disabled. Entries enabling both sending and receiving are removed from
+	// state, as transfers of all denominations are enabled by default.
+	TransferEnabled []TransferEnabled `protobuf:"bytes,2,rep,name=transfer_enabled,json=transferEnabled,proto3" json:"transfer_enabled"`
+}
+
+func (m *MsgSetTransferEnabled) Reset()         { *m = MsgSetTransferEnabled{} }
+func (m *MsgSetTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferEnabled) ProtoMessage()    {}
+func (*MsgSetTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{6}
+}
+func (m *MsgSetTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferEnabled.Merge(m, src)
+}
+func (m *MsgSetTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferEnabled proto.InternalMessageInfo
+
+// MsgSetTransferEnabledResponse defines the response structure for executing a
+// MsgSetTransferEnabled message.
+type MsgSetTransferEnabledResponse struct {
+}
+
+func (m *MsgSetTransferEnabledResponse) Reset()         { *m = MsgSetTransferEnabledResponse{} }
+func (m *MsgSetTransferEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferEnabledResponse) ProtoMessage()    {}
+func (*MsgSetTransferEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{7}
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
+	proto.RegisterType((*MsgTransferV2)(nil), "ibc.applications.transfer.v1.MsgTransferV2")
+	proto.RegisterType((*MsgTransferV2Response)(nil), "ibc.applications.transfer.v1.MsgTransferV2Response")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetTransferEnabled)(nil), "ibc.applications.transfer.v1.MsgSetTransferEnabled")
+	proto.RegisterType((*MsgSetTransferEnabledResponse)(nil), "ibc.applications.transfer.v1.MsgSetTransferEnabledResponse")
 }
 
 func init() {
@@ -225,49 +405,59 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0x58, 0x57, 0x36, 0x97, 0x6d, 0xcc, 0xa0, 0x2d, 0x8b, 0x50, 0x5a, 0x55, 0x4c, 0x2a,
-	0x9d, 0x66, 0xab, 0x43, 0x68, 0x62, 0xe2, 0xd4, 0x49, 0x68, 0x07, 0x26, 0x8d, 0x68, 0x5c, 0xb8,
-	0x4c, 0x49, 0xea, 0xa5, 0xd6, 0x1a, 0x3b, 0xd8, 0x6e, 0x81, 0x0b, 0x42, 0x48, 0x48, 0x88, 0x13,
-	0x1f, 0x81, 0x23, 0xc7, 0x7d, 0x8c, 0x1d, 0x77, 0xe4, 0x84, 0xd0, 0x76, 0xd8, 0x85, 0x0f, 0x81,
-	0xec, 0x38, 0x25, 0x70, 0x28, 0x70, 0x49, 0xfc, 0xde, 0xfb, 0xbd, 0x3f, 0xbf, 0x9f, 0x9f, 0x0c,
-	0xd6, 0x69, 0x14, 0xe3, 0x30, 0xcb, 0x86, 0x34, 0x0e, 0x15, 0xe5, 0x4c, 0x62, 0x25, 0x42, 0x26,
-	0x8f, 0x89, 0xc0, 0xe3, 0x2e, 0x56, 0xaf, 0x50, 0x26, 0xb8, 0xe2, 0xf0, 0x0e, 0x8d, 0x62, 0x54,
-	0x86, 0xa1, 0x02, 0x86, 0xc6, 0x5d, 0x6f, 0x39, 0x4c, 0x29, 0xe3, 0xd8, 0x7c, 0xf3, 0x04, 0xef,
-	0x76, 0xc2, 0x13, 0x6e, 0x8e, 0x58, 0x9f, 0xac, 0x77, 0x35, 0xe6, 0x32, 0xe5, 0x12, 0xa7, 0x32,
-	0xd1, 0xe5, 0x53, 0x99, 0xd8, 0x80, 0x6f, 0x03, 0x51, 0x28, 0x09, 0x1e, 0x77, 0x23, 0xa2, 0xc2,
-	0x2e, 0x8e, 0x39, 0x65, 0x36, 0xde, 0xd0, 0x63, 0xc6, 0x5c, 0x10, 0x1c, 0x0f, 0x29, 0x61, 0x4a,
-	0x67, 0xe7, 0x27, 0x0b, 0xd8, 0x98, 0xce, 0xa3, 0x18, 0xd6, 0x80, 0x5b, 0xef, 0xab, 0xa0, 0xbe,
-	0x2f, 0x93, 0x43, 0xeb, 0x85, 0x0d, 0x50, 0x97, 0x7c, 0x24, 0x62, 0x72, 0x94, 0x71, 0xa1, 0x5c,
-	0xa7, 0xe9, 0xb4, 0xe7, 0x03, 0x90, 0xbb, 0x0e, 0xb8, 0x50, 0x70, 0x1d, 0x2c, 0x5a, 0x40, 0x3c,
-	0x08, 0x19, 0x23, 0x43, 0xf7, 0x9a, 0xc1, 0x2c, 0xe4, 0xde, 0xdd, 0xdc, 0x09, 0x1f, 0x81, 0x59,
-	0xc5, 0x4f, 0x08, 0x73, 0x67, 0x9a, 0x4e, 0xbb, 0xbe, 0xb5, 0x86, 0x72, 0x56, 0x48, 0xb3, 0x42,
-	0x96, 0x15, 0xda, 0xe5, 0x94, 0xf5, 0xea, 0x67, 0xdf, 0x1a, 0x95, 0x2f, 0x57, 0xa7, 0x1d, 0xc7,
-	0x75, 0x82, 0x3c, 0x09, 0xae, 0x80, 0x9a, 0x24, 0xac, 0x4f, 0x84, 0x5b, 0x35, 0xc5, 0xad, 0x05,
-	0x3d, 0x30, 0x27, 0x48, 0x4c, 0xe8, 0x98, 0x08, 0x77, 0xd6, 0x44, 0x26, 0x36, 0x7c, 0x02, 0x16,
-	0x15, 0x4d, 0x09, 0x1f, 0xa9, 0xa3, 0x01, 0xa1, 0xc9, 0x40, 0xb9, 0x35, 0xd3, 0xda, 0x43, 0xfa,
-	0xc2, 0xb4, 0x60, 0xc8, 0xca, 0x34, 0xee, 0xa2, 0x3d, 0x83, 0xe8, 0xcd, 0x4f, 0x7a, 0x07, 0x0b,
-	0x36, 0x39, 0x8f, 0xc0, 0x0d, 0xb0, 0x5c, 0x54, 0xd3, 0x7f, 0xa9, 0xc2, 0x34, 0x73, 0xaf, 0x37,
-	0x9d, 0x76, 0x35, 0xb8, 0x69, 0x03, 0x87, 0x85, 0x1f, 0x42, 0x50, 0x4d, 0x49, 0xca, 0xdd, 0x39,
-	0x33, 0x92, 0x39, 0xc3, 0x6d, 0x50, 0x33, 0x5c, 0xa4, 0x3b, 0xdf, 0x9c, 0x99, 0xae, 0x40, 0x55,
-	0x4f, 0x11, 0x58, 0x38, 0xdc, 0x03, 0xe0, 0x98, 0x8b, 0x97, 0xa1, 0xe8, 0x53, 0x96, 0xb8, 0xc0,
-	0x70, 0x68, 0xa3, 0x69, 0x4b, 0x87, 0x1e, 0x4f, 0xf0, 0x41, 0x29, 0x77, 0xa7, 0xf3, 0xe1, 0x73,
-	0xa3, 0xf2, 0xee, 0xea, 0xb4, 0x63, 0xe5, 0xfb, 0x78, 0x75, 0xda, 0x59, 0xc9, 0xa7, 0xd8, 0x94,
-	0xfd, 0x13, 0x5c, 0xba, 0xf7, 0xd6, 0x36, 0xb8, 0x55, 0x32, 0x03, 0x22, 0x33, 0xce, 0x24, 0xd1,
-	0x82, 0x4b, 0xf2, 0x62, 0x44, 0x58, 0x4c, 0xcc, 0x2e, 0x54, 0x83, 0x89, 0xbd, 0x53, 0xd5, 0xe5,
-	0x5b, 0x6f, 0xc0, 0xd2, 0xbe, 0x4c, 0x9e, 0x65, 0xfd, 0x50, 0x91, 0x83, 0x50, 0x84, 0xa9, 0x34,
-	0xb7, 0x47, 0x13, 0x46, 0x84, 0x5d, 0x1f, 0x6b, 0xc1, 0x1e, 0xa8, 0x65, 0x06, 0x61, 0x56, 0xa6,
-	0xbe, 0x75, 0x77, 0x3a, 0xab, 0xbc, 0x5a, 0xa1, 0x4e, 0x9e, 0xb9, 0xb3, 0xf4, 0x8b, 0x93, 0x29,
-	0xda, 0x5a, 0x03, 0xab, 0x7f, 0xf4, 0x2f, 0x86, 0xdf, 0xfa, 0xe1, 0x80, 0x99, 0x7d, 0x99, 0xc0,
-	0x01, 0x98, 0x9b, 0xec, 0xf7, 0xbd, 0xe9, 0x3d, 0x4b, 0x1a, 0x78, 0xdd, 0x7f, 0x86, 0x4e, 0xe4,
-	0x52, 0xe0, 0xc6, 0x6f, 0x4a, 0x6c, 0xfe, 0xb5, 0x44, 0x19, 0xee, 0x3d, 0xf8, 0x2f, 0x78, 0xd1,
-	0xd5, 0x9b, 0x7d, 0xab, 0x37, 0xb8, 0xf7, 0xf4, 0xec, 0xc2, 0x77, 0xce, 0x2f, 0x7c, 0xe7, 0xfb,
-	0x85, 0xef, 0x7c, 0xba, 0xf4, 0x2b, 0xe7, 0x97, 0x7e, 0xe5, 0xeb, 0xa5, 0x5f, 0x79, 0xbe, 0x9d,
-	0x50, 0x35, 0x18, 0x45, 0x28, 0xe6, 0x29, 0xb6, 0xaf, 0x0b, 0x8d, 0xe2, 0xcd, 0x84, 0xe3, 0xf1,
-	0x43, 0x9c, 0xf2, 0xfe, 0x68, 0x48, 0xa4, 0x7e, 0x31, 0x4a, 0x2f, 0x85, 0x7a, 0x9d, 0x11, 0x19,
-	0xd5, 0xcc, 0x23, 0x71, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x3b, 0x79, 0x37, 0x1b,
-	0x05, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x13, 0x27, 0xc0, 0x84, 0xf0, 0x63, 0xda, 0x82, 0xb1, 0xda, 0x24, 0x4a, 0x8b, 0x94,
+	0x86, 0xc6, 0x56, 0x82, 0x2a, 0x44, 0xca, 0x29, 0xa8, 0x15, 0x87, 0x22, 0x51, 0x97, 0x72, 0xe8,
+	0xa1, 0xc8, 0x76, 0x06, 0xc7, 0x22, 0x9e, 0x71, 0x3d, 0x93, 0xb4, 0xbd, 0x54, 0x55, 0xa5, 0x56,
+	0x55, 0x4f, 0xfb, 0x27, 0x70, 0xdc, 0x23, 0x7f, 0x06, 0x47, 0x8e, 0x7b, 0x5a, 0xad, 0x40, 0x2b,
+	0xfe, 0x88, 0xbd, 0xac, 0x3c, 0x1e, 0x7b, 0x0d, 0x84, 0x04, 0xb8, 0xc0, 0xcc, 0x9b, 0xef, 0xbd,
+	0xf9, 0xbe, 0xf7, 0xbd, 0x78, 0xc0, 0xba, 0x6b, 0xd9, 0xba, 0xe9, 0xfb, 0x03, 0xd7, 0x36, 0x99,
+	0x4b, 0x30, 0xd5, 0x59, 0x60, 0x62, 0x7a, 0x82, 0x02, 0x7d, 0xd4, 0xd2, 0xd9, 0xef, 0x9a, 0x1f,
+	0x10, 0x46, 0xe0, 0xa7, 0xae, 0x65, 0x6b, 0x69, 0x98, 0x16, 0xc3, 0xb4, 0x51, 0x4b, 0x5d, 0x36,
+	0x3d, 0x17, 0x13, 0x9d, 0xff, 0x8d, 0x12, 0xd4, 0x8f, 0x1d, 0xe2, 0x10, 0xbe, 0xd4, 0xc3, 0x95,
+	0x88, 0xae, 0xda, 0x84, 0x7a, 0x84, 0xea, 0x1e, 0x75, 0xc2, 0xf2, 0x1e, 0x75, 0xc4, 0x41, 0x59,
+	0x1c, 0x58, 0x26, 0x45, 0xfa, 0xa8, 0x65, 0x21, 0x66, 0xb6, 0x74, 0x9b, 0xb8, 0x58, 0x9c, 0x57,
+	0x42, 0x9a, 0x36, 0x09, 0x90, 0x6e, 0x0f, 0x5c, 0x84, 0x59, 0x98, 0x1d, 0xad, 0x04, 0x60, 0x63,
+	0xb2, 0x8e, 0x98, 0x2c, 0x07, 0xd7, 0xfe, 0x91, 0x41, 0x71, 0x9f, 0x3a, 0x87, 0x22, 0x0a, 0x2b,
+	0xa0, 0x48, 0xc9, 0x30, 0xb0, 0xd1, 0xb1, 0x4f, 0x02, 0xa6, 0x48, 0x55, 0xa9, 0x3e, 0x67, 0x80,
+	0x28, 0x74, 0x40, 0x02, 0x06, 0xd7, 0xc1, 0x82, 0x00, 0xd8, 0x7d, 0x13, 0x63, 0x34, 0x50, 0xb2,
+	0x1c, 0x53, 0x8a, 0xa2, 0xbb, 0x51, 0x10, 0xee, 0x80, 0x3c, 0x23, 0xa7, 0x08, 0x2b, 0xb9, 0xaa,
+	0x54, 0x2f, 0xb6, 0xd7, 0xb4, 0x48, 0x95, 0x16, 0xaa, 0xd2, 0x84, 0x2a, 0x6d, 0x97, 0xb8, 0xb8,
+	0x5b, 0xbc, 0x78, 0x5d, 0xc9, 0xbc, 0xbc, 0x39, 0x6f, 0x48, 0x8a, 0x64, 0x44, 0x49, 0x70, 0x05,
+	0x14, 0x28, 0xc2, 0x3d, 0x14, 0x28, 0x32, 0x2f, 0x2e, 0x76, 0x50, 0x05, 0xb3, 0x01, 0xb2, 0x91,
+	0x3b, 0x42, 0x81, 0x92, 0xe7, 0x27, 0xc9, 0x1e, 0x7e, 0x0f, 0x16, 0x98, 0xeb, 0x21, 0x32, 0x64,
+	0xc7, 0x7d, 0xe4, 0x3a, 0x7d, 0xa6, 0x14, 0xf8, 0xd5, 0xaa, 0x16, 0x1a, 0x16, 0x36, 0x4c, 0x13,
+	0x6d, 0x1a, 0xb5, 0xb4, 0x3d, 0x8e, 0xe8, 0xce, 0x25, 0x77, 0x1b, 0x25, 0x91, 0x1c, 0x9d, 0xc0,
+	0x0d, 0xb0, 0x1c, 0x57, 0x0b, 0xff, 0x53, 0x66, 0x7a, 0xbe, 0x32, 0x53, 0x95, 0xea, 0xb2, 0xb1,
+	0x24, 0x0e, 0x0e, 0xe3, 0x38, 0x84, 0x40, 0xf6, 0x90, 0x47, 0x94, 0x59, 0x4e, 0x89, 0xaf, 0xe1,
+	0x16, 0x28, 0x70, 0x2d, 0x54, 0x99, 0xab, 0xe6, 0x26, 0x77, 0x40, 0x0e, 0x59, 0x18, 0x02, 0x0e,
+	0xf7, 0x00, 0x38, 0x21, 0xc1, 0x6f, 0x66, 0xd0, 0x73, 0xb1, 0xa3, 0x00, 0xae, 0xa1, 0xae, 0x4d,
+	0x1a, 0x3a, 0xed, 0xbb, 0x04, 0x6f, 0xa4, 0x72, 0x3b, 0x8d, 0xff, 0xce, 0x2a, 0x99, 0xbf, 0x6f,
+	0xce, 0x1b, 0xa2, 0x7d, 0xff, 0xdf, 0x9c, 0x37, 0x56, 0x22, 0x16, 0x4d, 0xda, 0x3b, 0xd5, 0x53,
+	0xbe, 0xd7, 0xb6, 0xc0, 0x47, 0xa9, 0xad, 0x81, 0xa8, 0x4f, 0x30, 0x45, 0x61, 0xc3, 0x29, 0xfa,
+	0x75, 0x88, 0xb0, 0x8d, 0xf8, 0x2c, 0xc8, 0x46, 0xb2, 0xef, 0xc8, 0x61, 0xf9, 0xda, 0xbb, 0x2c,
+	0x28, 0xa5, 0x32, 0x8f, 0xda, 0xf0, 0x73, 0x50, 0x8a, 0x27, 0x84, 0xf7, 0x5b, 0x0c, 0xd1, 0xbc,
+	0x18, 0x10, 0x1e, 0x4b, 0x39, 0x9c, 0x7d, 0xd0, 0xe1, 0xdc, 0x1d, 0x87, 0xc7, 0x7a, 0x22, 0x4f,
+	0xf1, 0x24, 0x9f, 0xf2, 0x64, 0x27, 0xf1, 0xa4, 0x30, 0xcd, 0x93, 0xd4, 0x64, 0x8c, 0x37, 0x66,
+	0xe6, 0xf9, 0xc6, 0x84, 0x22, 0x11, 0xb6, 0x09, 0xaf, 0x13, 0xcd, 0x4c, 0xb2, 0xef, 0x7c, 0x35,
+	0xc6, 0x34, 0x65, 0xbc, 0x69, 0x47, 0xed, 0xda, 0x36, 0xf8, 0xe4, 0x56, 0xe0, 0x09, 0xc6, 0xfd,
+	0x09, 0x16, 0xf7, 0xa9, 0xf3, 0x93, 0xdf, 0x33, 0x19, 0x3a, 0x30, 0x03, 0xd3, 0xa3, 0xdc, 0x14,
+	0xd7, 0xc1, 0x28, 0x10, 0x96, 0x89, 0x1d, 0xec, 0x82, 0x82, 0xcf, 0x11, 0xdc, 0xac, 0x62, 0xfb,
+	0x8b, 0xc9, 0xaa, 0xa3, 0x6a, 0xf1, 0x58, 0x47, 0x99, 0x9d, 0xc5, 0x0f, 0xba, 0x78, 0xd1, 0xda,
+	0x1a, 0x58, 0xbd, 0x73, 0x7f, 0x4c, 0xbe, 0x76, 0x26, 0x71, 0x59, 0x3f, 0x22, 0x16, 0x2b, 0xfb,
+	0x16, 0x9b, 0xd6, 0x00, 0xf5, 0x1e, 0x64, 0xf8, 0x0b, 0x58, 0x8a, 0x19, 0x1c, 0xa3, 0x08, 0xab,
+	0x64, 0xb9, 0xc7, 0xcd, 0xc9, 0x5c, 0xef, 0x5c, 0x20, 0x48, 0x2f, 0xb2, 0xdb, 0xe1, 0xfb, 0xec,
+	0x2b, 0xe0, 0xb3, 0xb1, 0x0c, 0x63, 0x0d, 0xed, 0xb7, 0x39, 0x90, 0xdb, 0xa7, 0x0e, 0xec, 0x83,
+	0xd9, 0xe4, 0xe3, 0xfa, 0xe5, 0x64, 0x2e, 0x29, 0x27, 0xd5, 0xd6, 0xa3, 0xa1, 0x89, 0xe5, 0x18,
+	0x80, 0xd4, 0xaf, 0x70, 0xe3, 0xd1, 0x05, 0x8e, 0xda, 0xea, 0xe6, 0x13, 0xc0, 0xc9, 0x7d, 0x0c,
+	0xcc, 0xdf, 0x9a, 0x9e, 0xe6, 0xd4, 0x22, 0x69, 0xb8, 0xfa, 0xf5, 0x93, 0xe0, 0xc9, 0xad, 0xff,
+	0x4a, 0x00, 0x8e, 0x19, 0x8c, 0xe9, 0x0a, 0xee, 0x27, 0xa9, 0xdf, 0x3c, 0x23, 0x29, 0x26, 0xa2,
+	0xe6, 0xff, 0x0a, 0xbf, 0x0e, 0xdd, 0x1f, 0x2e, 0xae, 0xca, 0xd2, 0xe5, 0x55, 0x59, 0x7a, 0x73,
+	0x55, 0x96, 0x5e, 0x5c, 0x97, 0x33, 0x97, 0xd7, 0xe5, 0xcc, 0xab, 0xeb, 0x72, 0xe6, 0xe7, 0x2d,
+	0xc7, 0x65, 0xfd, 0xa1, 0xa5, 0xd9, 0xc4, 0xd3, 0xc5, 0x9b, 0xee, 0x5a, 0x76, 0xd3, 0x21, 0xfa,
+	0x68, 0x5b, 0xf7, 0x48, 0x6f, 0x38, 0x40, 0x34, 0x7c, 0xa7, 0x53, 0xef, 0x33, 0xfb, 0xc3, 0x47,
+	0xd4, 0x2a, 0xf0, 0xa7, 0x79, 0xf3, 0xfd, 0x00, 0xad, 0xf2, 0x28, 0x60, 0x91, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,8 +474,12 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+	// TransferV2 defines a rpc handler method for MsgTransferV2.
+	TransferV2(ctx context.Context, in *MsgTransferV2, opts ...grpc.CallOption) (*MsgTransferV2Response, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetTransferEnabled defines a rpc handler for MsgSetTransferEnabled.
+	SetTransferEnabled(ctx context.Context, in *MsgSetTransferEnabled, opts ...grpc.CallOption) (*MsgSetTransferEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -305,6 +499,15 @@ func (c *msgClient) Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) TransferV2(ctx context.Context, in *MsgTransferV2, opts ...grpc.CallOption) (*MsgTransferV2Response, error) {
+	out := new(MsgTransferV2Response)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/TransferV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateParams", in, out, opts...)
@@ -314,12 +517,25 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetTransferEnabled(ctx context.Context, in *MsgSetTransferEnabled, opts ...grpc.CallOption) (*MsgSetTransferEnabledResponse, error) {
+	out := new(MsgSetTransferEnabledResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetTransferEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
+	// TransferV2 defines a rpc handler method for MsgTransferV2.
+	TransferV2(context.Context, *MsgTransferV2) (*MsgTransferV2Response, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetTransferEnabled defines a rpc handler for MsgSetTransferEnabled.
+	SetTransferEnabled(context.Context, *MsgSetTransferEnabled) (*MsgSetTransferEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -329,9 +545,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (*MsgTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
 }
+func (*UnimplementedMsgServer) TransferV2(ctx context.Context, req *MsgTransferV2) (*MsgTransferV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferV2 not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetTransferEnabled(ctx context.Context, req *MsgSetTransferEnabled) (*MsgSetTransferEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -355,6 +577,24 @@ func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/TransferV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferV2(ctx, req.(*MsgTransferV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -373,6 +613,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTransferEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetTransferEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferEnabled(ctx, req.(*MsgSetTransferEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -381,10 +639,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _Msg_Transfer_Handler,
 		},
+		{
+			MethodName: "TransferV2",
+			Handler:    _Msg_TransferV2_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetTransferEnabled",
+			Handler:    _Msg_SetTransferEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/tx.proto",
@@ -527,6 +793,123 @@ func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Forwarding != nil {
+		{
+			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceClient) > 0 {
+		i -= len(m.SourceClient)
+		copy(dAtA[i:], m.SourceClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceClient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -590,19 +973,86 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
+
+func (m *MsgSetTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferEnabled) > 0 {
+		for iNdEx := len(m.TransferEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -659,6 +1109,60 @@ func (m *MsgTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Forwarding != nil {
+		l = m.Forwarding.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -683,6 +1187,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TransferEnabled) > 0 {
+		for _, e := range m.TransferEnabled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTransferEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -712,15 +1244,449 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &Forwarding{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -748,11 +1714,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			m.SourceClient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -780,13 +1746,13 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -796,30 +1762,29 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -829,27 +1794,14 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -877,11 +1829,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -908,15 +1860,16 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -926,14 +1879,31 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &Forwarding{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -961,13 +1931,132 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Encoding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -977,29 +2066,27 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, types.Coin{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1026,10 +2113,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forwarding == nil {
-				m.Forwarding = &Forwarding{}
-			}
-			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1054,7 +2138,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1077,31 +2161,12 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1123,7 +2188,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1146,10 +2211,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1186,7 +2251,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1213,7 +2278,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferEnabled = append(m.TransferEnabled, TransferEnabled{})
+			if err := m.TransferEnabled[len(m.TransferEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1238,7 +2304,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +2327,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,157 @@
+package v2
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// forwardPacket forwards a FungibleTokenPacketDataV2 received over IBC v2 to the next hop in the forwarding path.
+// The channel identifier of each hop is interpreted as the client identifier used to send the packet on this chain.
+// The identifier of the received packet is stored so that its asynchronous acknowledgement can be written once
+// the packet sent on the next hop is acknowledged or times out.
+func (im *IBCModule) forwardPacket(ctx context.Context, destinationClient string, sequence uint64, payload channeltypesv2.Payload, data types.FungibleTokenPacketDataV2, receivedCoins sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var nextForwardingPath types.ForwardingPacketData
+	memo := data.Forwarding.DestinationMemo
+	if len(data.Forwarding.Hops) > 1 {
+		// remove the first hop since we are going to send to the first hop now and we want to propagate the rest of the hops to the receiver
+		nextForwardingPath = types.NewForwardingPacketData(memo, data.Forwarding.Hops[1:]...)
+		memo = ""
+	}
+
+	tokens := make(types.Tokens, 0, len(receivedCoins))
+	for _, coin := range receivedCoins {
+		token, err := im.keeper.TokenFromCoin(sdkCtx, coin)
+		if err != nil {
+			return err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	// sending from module account (used as a temporary forward escrow) to the original receiver address.
+	sender := im.keeper.AuthKeeper.GetModuleAddress(types.ModuleName)
+
+	packetData := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), data.Receiver, memo, nextForwardingPath)
+	if err := packetData.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(err, "failed to validate %s packet data", types.V2)
+	}
+
+	hop := data.Forwarding.Hops[0]
+	forwardPayload := channeltypesv2.NewPayload(hop.PortId, hop.PortId, types.V2, types.EncodingProtobuf, packetData.GetBytes())
+
+	// the receipt for the received packet has already been written, thus it can no longer time out on the
+	// sending chain. The forwarded packet is therefore sent with the maximum timeout accepted by IBC core.
+	maxTimeoutDelta := im.chanKeeperV2.GetParams(ctx).MaxTimeoutDelta
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(maxTimeoutDelta).Unix())
+
+	msg := channeltypesv2.NewMsgSendPacket(hop.ChannelId, timeoutTimestamp, sender.String(), forwardPayload)
+	resp, err := im.chanKeeperV2.SendPacket(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	im.keeper.SetForwardedPacketV2(ctx, hop.PortId, hop.ChannelId, resp.Sequence, channeltypes.NewPacketID(payload.DestinationPort, destinationClient, sequence))
+	return nil
+}
+
+// handleForwardedPacketAcknowledgement processes an acknowledgement for an IBC v2 packet that was sent from the chain as an intermediate.
+//
+// If the acknowledgement was a success, a successful acknowledgement is written
+// for the forwarded packet. Otherwise, if the acknowledgement failed, after refunding the sender, the
+// tokens of the forwarded packet that were received are in turn either refunded or burned.
+func (im *IBCModule) handleForwardedPacketAcknowledgement(
+	ctx context.Context,
+	sourcePort string,
+	sourceClient string,
+	sequence uint64,
+	forwardedPacketID channeltypes.PacketId,
+	data types.FungibleTokenPacketDataV2,
+	ack channeltypes.Acknowledgement,
+) error {
+	var forwardAckErr error
+
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		// Write a successful async ack for the forwarded packet
+	case *channeltypes.Acknowledgement_Error:
+		// the forwarded packet has failed, thus the funds have been refunded to the intermediate address.
+		// we must revert the changes that came from successfully receiving the tokens on our chain
+		// before propagating the error acknowledgement back to original sender chain
+		if err := im.keeper.RevertForwardedPacketV2(ctx, forwardedPacketID.PortId, forwardedPacketID.ChannelId, data); err != nil {
+			return err
+		}
+
+		forwardAckErr = types.ErrForwardedPacketFailed
+	default:
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected one of [%T, %T], got %T", channeltypes.Acknowledgement_Result{}, channeltypes.Acknowledgement_Error{}, ack.Response)
+	}
+
+	return im.acknowledgeForwardedPacket(ctx, sourcePort, sourceClient, sequence, forwardedPacketID, forwardAckErr)
+}
+
+// handleForwardedPacketTimeout processes a timeout for an IBC v2 packet that was sent from the chain as an intermediate.
+// The packet is reverted and the error acknowledgement is written for the forwarded packet.
+func (im *IBCModule) handleForwardedPacketTimeout(
+	ctx context.Context,
+	sourcePort string,
+	sourceClient string,
+	sequence uint64,
+	forwardedPacketID channeltypes.PacketId,
+	data types.FungibleTokenPacketDataV2,
+) error {
+	if err := im.keeper.RevertForwardedPacketV2(ctx, forwardedPacketID.PortId, forwardedPacketID.ChannelId, data); err != nil {
+		return err
+	}
+
+	return im.acknowledgeForwardedPacket(ctx, sourcePort, sourceClient, sequence, forwardedPacketID, types.ErrForwardedPacketTimedOut)
+}
+
+// acknowledgeForwardedPacket writes the async acknowledgement for the packet identified by forwardedPacketID,
+// which is stored in IBC core awaiting its acknowledgement. An error acknowledgement is written if ackErr is
+// not nil, otherwise a successful acknowledgement is written using the encoding of the stored packet.
+// If the packet is no longer awaiting its acknowledgement, e.g. as an acknowledgement was written on behalf of
+// the application once its deadline elapsed, the forwarding is considered finished.
+func (im *IBCModule) acknowledgeForwardedPacket(
+	ctx context.Context,
+	sourcePort string,
+	sourceClient string,
+	sequence uint64,
+	forwardedPacketID channeltypes.PacketId,
+	ackErr error,
+) error {
+	packet, found := im.chanKeeperV2.GetAsyncPacket(ctx, forwardedPacketID.ChannelId, forwardedPacketID.Sequence)
+	if !found {
+		im.keeper.Logger(ctx).Info("forwarded packet already acknowledged", "client-id", forwardedPacketID.ChannelId, "sequence", forwardedPacketID.Sequence)
+		im.keeper.DeleteForwardedPacketV2(ctx, sourcePort, sourceClient, sequence)
+		return nil
+	}
+
+	var ack channeltypesv2.Acknowledgement
+	if ackErr != nil {
+		ack = channeltypesv2.NewErrorAcknowledgement(ackErr)
+	} else {
+		bz, err := types.MarshalAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}), packet.Payloads[0].Version, packet.Payloads[0].Encoding)
+		if err != nil {
+			return err
+		}
+
+		ack = channeltypesv2.NewAcknowledgement(bz)
+	}
+
+	if err := im.writeAckWrapper.WriteAcknowledgement(ctx, forwardedPacketID.ChannelId, forwardedPacketID.Sequence, ack); err != nil {
+		return err
+	}
+
+	im.keeper.DeleteForwardedPacketV2(ctx, sourcePort, sourceClient, sequence)
+	return nil
+}
@@ -1,8 +1,8 @@
 package v2
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -16,21 +16,52 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/api"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
-var _ api.IBCModule = (*IBCModule)(nil)
+var (
+	_ api.IBCModule                        = (*IBCModule)(nil)
+	_ api.AsyncAcknowledgementExpiryExempt = (*IBCModule)(nil)
+)
+
+// NewIBCModule creates a new IBCModule given the keeper, the WriteAcknowledgementWrapper used to write
+// asynchronous acknowledgements for forwarded packets and the IBC v2 channel keeper.
+func NewIBCModule(k keeper.Keeper, writeAckWrapper api.WriteAcknowledgementWrapper, chanKeeperV2 types.ChannelKeeperV2) *IBCModule {
+	if writeAckWrapper == nil {
+		panic(errors.New("write acknowledgement wrapper cannot be nil"))
+	}
+
+	if chanKeeperV2 == nil {
+		panic(errors.New("channel keeper v2 cannot be nil"))
+	}
 
-// NewIBCModule creates a new IBCModule given the keeper
-func NewIBCModule(k keeper.Keeper) *IBCModule {
 	return &IBCModule{
-		keeper: k,
+		keeper:          k,
+		writeAckWrapper: writeAckWrapper,
+		chanKeeperV2:    chanKeeperV2,
 	}
 }
 
 type IBCModule struct {
 	keeper keeper.Keeper
+
+	writeAckWrapper api.WriteAcknowledgementWrapper
+	chanKeeperV2    types.ChannelKeeperV2
+}
+
+// WithWriteAckWrapper sets the WriteAcknowledgementWrapper. This function may be used after
+// the module's creation to set the middleware which is above this module in the IBC application stack.
+func (im *IBCModule) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	im.writeAckWrapper = writeAckWrapper
+}
+
+// ExemptFromAsyncAcknowledgementExpiry opts out of the expiry of packets awaiting an asynchronous acknowledgement.
+// Transfers are only acknowledged asynchronously when they are forwarded, in which case an error acknowledgement
+// written on behalf of the module would refund the sender while the forwarded packet may still be received.
+func (*IBCModule) ExemptFromAsyncAcknowledgementExpiry() bool {
+	return true
 }
 
 func (im *IBCModule) OnSendPacket(goCtx context.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
@@ -56,16 +87,16 @@ func (im *IBCModule) OnSendPacket(goCtx context.Context, sourceChannel string, d
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "sender %s is different from signer %s", sender, signer)
 	}
 
-	// Enforce that the base denom does not contain any slashes
+	// Enforce that the base denom of ICS20 v1 packet data does not contain any slashes
 	// Since IBC v2 packets will no longer have channel identifiers, we cannot rely
 	// on the channel format to easily divide the trace from the base denomination in ICS20 v1 packets
-	// The simplest way to prevent any potential issues from arising is to simply disallow any slashes in the base denomination
-	// This prevents such denominations from being sent with IBCV v2 packets, however we can still support them in IBC v1 packets
-	// If we enforce that IBC v2 packets are sent with ICS20 v2 and above versions that separate the trace from the base denomination
-	// in the packet data, then we can remove this restriction.
-	for _, token := range data.Tokens {
-		if strings.Contains(token.Denom.Base, "/") {
-			return errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s cannot contain slashes for IBC v2 packet", token.Denom.Base)
+	// The structured trace of ICS20 v2 packet data separates the trace from the base denomination,
+	// thus any valid base denomination can be sent with ICS20 v2 packet data
+	if payload.Version == types.V1 {
+		for _, token := range data.Tokens {
+			if strings.Contains(token.Denom.Base, "/") {
+				return errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s cannot contain slashes for IBC v2 packet with ICS20 v1 packet data", token.Denom.Base)
+			}
 		}
 	}
 
@@ -88,12 +119,14 @@ func (im *IBCModule) OnRecvPacket(ctx context.Context, sourceChannel string, des
 	// rather than just the destination port
 	if payload.SourcePort != types.PortID || payload.DestinationPort != types.PortID {
 		return channeltypesv2.RecvPacketResult{
-			Status: channeltypesv2.PacketStatus_Failure,
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: channeltypesv2.NewErrorAppAcknowledgement(errorsmod.Wrapf(porttypes.ErrInvalidPort, "expected source and destination ports to be %s", types.PortID)),
 		}
 	}
 	var (
-		ackErr error
-		data   types.FungibleTokenPacketDataV2
+		ackErr        error
+		data          types.FungibleTokenPacketDataV2
+		receivedCoins sdk.Coins
 	)
 
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
@@ -111,11 +144,12 @@ func (im *IBCModule) OnRecvPacket(ctx context.Context, sourceChannel string, des
 	if ackErr != nil {
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
 		return channeltypesv2.RecvPacketResult{
-			Status: channeltypesv2.PacketStatus_Failure,
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: channeltypesv2.NewErrorAppAcknowledgement(ackErr),
 		}
 	}
 
-	if _, ackErr = im.keeper.OnRecvPacket(
+	if receivedCoins, ackErr = im.keeper.OnRecvPacket(
 		ctx,
 		data,
 		payload.SourcePort,
@@ -125,7 +159,17 @@ func (im *IBCModule) OnRecvPacket(ctx context.Context, sourceChannel string, des
 	); ackErr != nil {
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
 		return channeltypesv2.RecvPacketResult{
-			Status: channeltypesv2.PacketStatus_Failure,
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: channeltypesv2.NewErrorAppAcknowledgement(ackErr),
+		}
+	}
+
+	// the acknowledgement of ICS20 v2 packet data sent with the solidity ABI encoding is encoded with the same encoding.
+	if recvResult.Acknowledgement, ackErr = types.MarshalAcknowledgement(ack, payload.Version, payload.Encoding); ackErr != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: channeltypesv2.NewErrorAppAcknowledgement(ackErr),
 		}
 	}
 
@@ -135,19 +179,18 @@ func (im *IBCModule) OnRecvPacket(ctx context.Context, sourceChannel string, des
 
 	if data.HasForwarding() {
 		// we are now sending from the forward escrow address to the final receiver address.
-		return channeltypesv2.RecvPacketResult{
-			Status: channeltypesv2.PacketStatus_Failure,
+		if ackErr = im.forwardPacket(ctx, destinationChannel, sequence, payload, data, receivedCoins); ackErr != nil {
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
+			return channeltypesv2.RecvPacketResult{
+				Status:          channeltypesv2.PacketStatus_Failure,
+				Acknowledgement: channeltypesv2.NewErrorAppAcknowledgement(ackErr),
+			}
 		}
-		// TODO: handle forwarding
-		// TODO: inside this version of the function, we should fetch the packet that was stored in IBC core in order to set it for forwarding.
-		//	if err := k.forwardPacket(ctx, data, packet, receivedCoins); err != nil {
-		//		return err
-		//	}
 
 		// NOTE: acknowledgement will be written asynchronously
-		// return types.RecvPacketResult{
-		// 	Status: types.PacketStatus_Async,
-		// }
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Async,
+		}
 	}
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
@@ -165,7 +208,11 @@ func (im *IBCModule) OnTimeoutPacket(ctx context.Context, sourceChannel string,
 		return err
 	}
 
-	// TODO: handle forwarding
+	if forwardedPacketID, isForwarded := im.keeper.GetForwardedPacketV2(ctx, payload.SourcePort, sourceChannel, sequence); isForwarded {
+		if err := im.handleForwardedPacketTimeout(ctx, payload.SourcePort, sourceChannel, sequence, forwardedPacketID, data); err != nil {
+			return err
+		}
+	}
 
 	events.EmitOnTimeoutEvent(ctx, data)
 
@@ -174,12 +221,18 @@ func (im *IBCModule) OnTimeoutPacket(ctx context.Context, sourceChannel string,
 
 func (im *IBCModule) OnAcknowledgementPacket(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
 	var ack channeltypes.Acknowledgement
-	// construct an error acknowledgement if the acknowledgement bytes are the sentinel error acknowledgement so we can use the shared transfer logic
-	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
-		// the specific error does not matter
-		ack = channeltypes.NewErrorAcknowledgement(types.ErrReceiveFailed)
+	// construct an error acknowledgement if the acknowledgement bytes are an error acknowledgement so we can use the shared transfer logic
+	if channeltypesv2.IsErrorAppAcknowledgement(acknowledgement) {
+		// the specific error does not matter for the refund, but the codespace and code of a
+		// structured error acknowledgement are preserved so that they are emitted in events
+		var ackErr error = types.ErrReceiveFailed
+		if acknowledgementError, ok := channeltypesv2.ParseAcknowledgementError(acknowledgement); ok {
+			ackErr = errorsmod.ABCIError(acknowledgementError.Codespace, acknowledgementError.Code, "")
+		}
+		ack = channeltypes.NewErrorAcknowledgement(ackErr)
 	} else {
-		if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		var err error
+		if ack, err = types.UnmarshalAcknowledgement(acknowledgement, payload.Version, payload.Encoding); err != nil {
 			return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 		}
 		if !ack.Success() {
@@ -196,7 +249,11 @@ func (im *IBCModule) OnAcknowledgementPacket(ctx context.Context, sourceChannel
 		return err
 	}
 
-	// TODO: handle forwarding
+	if forwardedPacketID, isForwarded := im.keeper.GetForwardedPacketV2(ctx, payload.SourcePort, sourceChannel, sequence); isForwarded {
+		if err := im.handleForwardedPacketAcknowledgement(ctx, payload.SourcePort, sourceChannel, sequence, forwardedPacketID, data, ack); err != nil {
+			return err
+		}
+	}
 
 	events.EmitOnAcknowledgementPacketEvent(ctx, data, ack)
 
@@ -9,10 +9,12 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v9/modules/core/24-host/v2"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -58,6 +60,17 @@ func TestTransferTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(TransferTestSuite))
 }
 
+// toICS20V1Payload converts the payload of ICS20 v2 packet data with a single token into a payload of
+// JSON encoded ICS20 v1 packet data.
+func (suite *TransferTestSuite) toICS20V1Payload(payload channeltypesv2.Payload) channeltypesv2.Payload {
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	suite.Require().NoError(err)
+	suite.Require().Len(data.Tokens, 1)
+
+	dataV1 := types.NewFungibleTokenPacketData(data.Tokens[0].Denom.Path(), data.Tokens[0].Amount, data.Sender, data.Receiver, data.Memo)
+	return channeltypesv2.NewPayload(payload.SourcePort, payload.DestinationPort, types.V1, types.EncodingJSON, dataV1.GetBytes())
+}
+
 func (suite *TransferTestSuite) TestOnSendPacket() {
 	var payload channeltypesv2.Payload
 	testCases := []struct {
@@ -95,9 +108,19 @@ func (suite *TransferTestSuite) TestOnSendPacket() {
 			channeltypesv2.ErrInvalidPacket,
 		},
 		{
-			"transfer with slashes in base denom",
+			"transfer with ics20-1 version",
+			[]string{sdk.DefaultBondDenom},
+			func() {
+				payload = suite.toICS20V1Payload(payload)
+			},
+			nil,
+		},
+		{
+			"transfer with slashes in base denom with ics20-1 version",
 			[]string{"base/coin"},
-			func() {},
+			func() {
+				payload = suite.toICS20V1Payload(payload)
+			},
 			types.ErrInvalidDenomForTransfer,
 		},
 	}
@@ -206,6 +229,14 @@ func (suite *TransferTestSuite) TestOnRecvPacket() {
 			},
 			true,
 		},
+		{
+			"transfer with ics20-1 version",
+			[]string{sdk.DefaultBondDenom},
+			func() {
+				payload = suite.toICS20V1Payload(payload)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -295,11 +326,179 @@ func (suite *TransferTestSuite) TestOnRecvPacket() {
 				}
 			} else {
 				suite.Require().Equal(channeltypesv2.PacketStatus_Failure, recvResult.Status)
+
+				// the failed recv result contains a structured error acknowledgement
+				_, found := channeltypesv2.ParseAcknowledgementError(recvResult.Acknowledgement)
+				suite.Require().True(found)
 			}
 		})
 	}
 }
 
+func (suite *TransferTestSuite) TestABIEncodedPacket() {
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	token, err := suite.chainA.GetSimApp().TransferKeeper.TokenFromCoin(suite.chainA.GetContext(), ibctesting.TestCoin)
+	suite.Require().NoError(err)
+
+	transferData := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{token},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+		types.ForwardingPacketData{},
+	)
+	bz, err := types.EncodeABIFungibleTokenPacketDataV2(&transferData)
+	suite.Require().NoError(err)
+
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V2, types.EncodingABI, bz)
+	msg := channeltypesv2.NewMsgSendPacket(
+		suite.pathAToB.EndpointA.ClientID,
+		timeoutTimestamp,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		payload,
+	)
+
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	cbs := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(ibctesting.TransferPort)
+	recvResult := cbs.OnRecvPacket(
+		suite.chainB.GetContext(), suite.pathAToB.EndpointA.ClientID, suite.pathAToB.EndpointB.ClientID,
+		1, payload, suite.chainB.SenderAccount.GetAddress(),
+	)
+	suite.Require().Equal(channeltypesv2.PacketStatus_Success, recvResult.Status)
+
+	// the acknowledgement is encoded using the solidity ABI
+	expAck, err := types.EncodeABIAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAck, recvResult.Acknowledgement)
+
+	cbs = suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(ibctesting.TransferPort)
+	err = cbs.OnAcknowledgementPacket(
+		suite.chainA.GetContext(), suite.pathAToB.EndpointA.ClientID, suite.pathAToB.EndpointB.ClientID,
+		1, recvResult.Acknowledgement, payload, suite.chainA.SenderAccount.GetAddress(),
+	)
+	suite.Require().NoError(err)
+
+	// the tokens remain escrowed on chain A after the successful acknowledgement
+	escrowAddress := types.GetEscrowAddress(types.PortID, suite.pathAToB.EndpointA.ClientID)
+	escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, ibctesting.TestCoin.Denom)
+	suite.Require().Equal(ibctesting.TestCoin, escrowBalance)
+}
+
+func (suite *TransferTestSuite) TestBaseDenomWithSlashes() {
+	successAck := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	// a token factory style base denomination which cannot be separated from its trace in an ICS20 v1 denomination path
+	baseDenom := "factory/" + suite.chainA.SenderAccount.GetAddress().String() + "/sub/denom"
+	coin := sdk.NewCoin(baseDenom, ibctesting.DefaultCoinAmount)
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(coin)))
+
+	// send the native tokens from chainA to chainB
+	token, err := suite.chainA.GetSimApp().TransferKeeper.TokenFromCoin(suite.chainA.GetContext(), coin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewDenom(baseDenom), token.Denom)
+
+	transferData := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{token},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+		types.ForwardingPacketData{},
+	)
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V2, types.EncodingProtobuf, transferData.GetBytes())
+
+	packet, err := suite.pathAToB.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointA.MsgAcknowledgePacket(packet, successAck)
+	suite.Require().NoError(err)
+
+	escrowAddress := types.GetEscrowAddress(types.PortID, suite.pathAToB.EndpointA.ClientID)
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, baseDenom))
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), baseDenom).IsZero())
+
+	// the voucher on chainB keeps the base denomination separate from its trace
+	denomOnB := types.NewDenom(baseDenom, types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+	voucher := sdk.NewCoin(denomOnB.IBCDenom(), coin.Amount)
+	suite.Require().Equal(voucher, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denomOnB.IBCDenom()))
+
+	storedDenom, found := suite.chainB.GetSimApp().TransferKeeper.GetDenom(suite.chainB.GetContext(), denomOnB.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(denomOnB, storedDenom)
+
+	// send the vouchers back from chainB to chainA, unwinding the trace
+	token, err = suite.chainB.GetSimApp().TransferKeeper.TokenFromCoin(suite.chainB.GetContext(), voucher)
+	suite.Require().NoError(err)
+	suite.Require().Equal(denomOnB, token.Denom)
+
+	transferData = types.NewFungibleTokenPacketDataV2(
+		[]types.Token{token},
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		"",
+		types.ForwardingPacketData{},
+	)
+	payload = channeltypesv2.NewPayload(types.PortID, types.PortID, types.V2, types.EncodingProtobuf, transferData.GetBytes())
+
+	packet, err = suite.pathAToB.EndpointB.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointA.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointB.MsgAcknowledgePacket(packet, successAck)
+	suite.Require().NoError(err)
+
+	// the vouchers are burned on chainB and the native tokens are unescrowed on chainA
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB.IBCDenom()).IsZero())
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, baseDenom).IsZero())
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), baseDenom))
+}
+
+func (suite *TransferTestSuite) TestICS20V1Packet() {
+	successAck := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	transferData := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, ibctesting.DefaultCoinAmount.String(), sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, types.EncodingJSON, transferData.GetBytes())
+
+	// the packet is received and acknowledged
+	packet, err := suite.pathAToB.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointA.MsgAcknowledgePacket(packet, successAck)
+	suite.Require().NoError(err)
+
+	denomOnB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+	suite.Require().Equal(ibctesting.DefaultCoinAmount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denomOnB.IBCDenom()).Amount)
+
+	// the packet times out and the tokens are refunded
+	packet, err = suite.pathAToB.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	suite.chainB.NextBlock()
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+
+	err = suite.pathAToB.EndpointA.MsgTimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	expBalance := originalBalance.SubAmount(ibctesting.DefaultCoinAmount)
+	suite.Require().Equal(expBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+}
+
 func (suite *TransferTestSuite) TestOnAckPacket() {
 	testCases := []struct {
 		name                   string
@@ -517,3 +716,167 @@ func (suite *TransferTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+func (suite *TransferTestSuite) TestForwarding() {
+	var (
+		receiver        string
+		forwardedPacket channeltypesv2.Packet
+	)
+
+	successAck := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	// the final receiver on chainC is invalid, thus the forwarded packet is acknowledged with an error acknowledgement.
+	// No counterparty has opted in to structured error acknowledgements, thus the sentinel error acknowledgement is written.
+	errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		// relay relays the forwarded packet from chainB to chainC and back
+		relay  func()
+		expAck channeltypesv2.Acknowledgement
+	}{
+		{
+			"success: forwarded packet is acknowledged",
+			func() {},
+			func() {
+				err := suite.pathBToC.EndpointB.MsgRecvPacket(forwardedPacket)
+				suite.Require().NoError(err)
+
+				err = suite.pathBToC.EndpointA.MsgAcknowledgePacket(forwardedPacket, successAck)
+				suite.Require().NoError(err)
+			},
+			successAck,
+		},
+		{
+			"failure: forwarded packet is acknowledged with error acknowledgement",
+			func() {
+				receiver = "invalid"
+			},
+			func() {
+				err := suite.pathBToC.EndpointB.MsgRecvPacket(forwardedPacket)
+				suite.Require().NoError(err)
+
+				err = suite.pathBToC.EndpointA.MsgAcknowledgePacket(forwardedPacket, errorAck)
+				suite.Require().NoError(err)
+			},
+			errorAck,
+		},
+		{
+			"failure: forwarded packet fails after the received packet was acknowledged on expiry",
+			func() {
+				receiver = "invalid"
+			},
+			func() {
+				// the acknowledgement of the received packet is written on behalf of the application, as done once its deadline elapses
+				forwardedPacketID, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacketV2(suite.chainB.GetContext(), types.PortID, forwardedPacket.SourceClient, forwardedPacket.Sequence)
+				suite.Require().True(found)
+				err := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.WriteAcknowledgement(suite.chainB.GetContext(), forwardedPacketID.ChannelId, forwardedPacketID.Sequence, channeltypesv2.NewErrorAcknowledgement(channeltypesv2.ErrAsyncPacketExpired))
+				suite.Require().NoError(err)
+
+				err = suite.pathBToC.EndpointB.MsgRecvPacket(forwardedPacket)
+				suite.Require().NoError(err)
+
+				// the forwarding is considered finished, thus the error acknowledgement does not fail on the missing async packet
+				err = suite.pathBToC.EndpointA.MsgAcknowledgePacket(forwardedPacket, errorAck)
+				suite.Require().NoError(err)
+			},
+			errorAck,
+		},
+		{
+			"failure: forwarded packet times out",
+			func() {},
+			func() {
+				suite.coordinator.IncrementTimeBy(channeltypesv2.DefaultMaxTimeoutDelta + time.Hour)
+				suite.chainC.NextBlock()
+				suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+
+				err := suite.pathBToC.EndpointA.MsgTimeoutPacket(forwardedPacket)
+				suite.Require().NoError(err)
+			},
+			errorAck,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			receiver = suite.chainC.SenderAccount.GetAddress().String()
+
+			tc.malleate()
+
+			originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, ibctesting.DefaultCoinAmount)
+
+			token, err := suite.chainA.GetSimApp().TransferKeeper.TokenFromCoin(suite.chainA.GetContext(), coin)
+			suite.Require().NoError(err)
+
+			forwarding := types.NewForwardingPacketData("", types.NewHop(types.PortID, suite.pathBToC.EndpointA.ClientID))
+			transferData := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{token},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				receiver,
+				"",
+				forwarding,
+			)
+			payload := channeltypesv2.NewPayload(
+				types.PortID, types.PortID, types.V2,
+				types.EncodingProtobuf, transferData.GetBytes(),
+			)
+
+			timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+			packet, err := suite.pathAToB.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+			suite.Require().NoError(err)
+
+			// receive the packet on chainB, which forwards it to chainC
+			proof, proofHeight := suite.chainA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+			res, err := suite.chainB.SendMsgs(channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+			suite.Require().NoError(err)
+
+			forwardedPacket, err = ibctesting.ParsePacketV2FromEvents(res.Events)
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.pathBToC.EndpointA.ClientID, forwardedPacket.SourceClient)
+
+			// the acknowledgement for the received packet is written asynchronously
+			_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacket(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().False(suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+
+			forwardedPacketID, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacketV2(suite.chainB.GetContext(), types.PortID, forwardedPacket.SourceClient, forwardedPacket.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.NewPacketID(types.PortID, packet.DestinationClient, packet.Sequence), forwardedPacketID)
+
+			suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+			suite.Require().NoError(suite.pathBToC.EndpointB.UpdateClient())
+
+			tc.relay()
+
+			// the asynchronous acknowledgement has been written on chainB and can be relayed to chainA
+			suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+			err = suite.pathAToB.EndpointA.MsgAcknowledgePacket(packet, tc.expAck)
+			suite.Require().NoError(err)
+
+			_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacketV2(suite.chainB.GetContext(), types.PortID, forwardedPacket.SourceClient, forwardedPacket.Sequence)
+			suite.Require().False(found)
+
+			// the forward escrow on chainB must not hold any funds
+			denomOnB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+			forwardAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), forwardAddr, denomOnB.IBCDenom()).IsZero())
+
+			denomOnC := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, suite.pathBToC.EndpointB.ClientID), types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+			chainABalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			if tc.expAck.Success() {
+				// the tokens are received by the final receiver on chainC
+				chainCBalance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), denomOnC.IBCDenom())
+				suite.Require().Equal(sdk.NewCoin(denomOnC.IBCDenom(), coin.Amount), chainCBalance)
+				suite.Require().Equal(originalBalance.Sub(coin), chainABalance)
+			} else {
+				// the vouchers minted on chainB are burned and the sender on chainA is refunded
+				suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB.IBCDenom()).IsZero())
+				suite.Require().Equal(originalBalance, chainABalance)
+			}
+		})
+	}
+}
@@ -8,7 +8,7 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// BeginBlocker is used to perform IBC client upgrades and to prune expired consensus states
 func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 	plan, err := k.GetUpgradePlan(ctx)
 	if err == nil {
@@ -32,4 +32,6 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 			keeper.EmitUpgradeChainEvent(ctx, plan.Height)
 		}
 	}
+
+	k.PruneExpiredConsensusStates(ctx)
 }
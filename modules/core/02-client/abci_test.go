@@ -114,6 +114,87 @@ func (suite *ClientTestSuite) TestBeginBlockerUpgradeEventsAbsence() {
 	suite.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeUpgradeChain, false)
 }
 
+func (suite *ClientTestSuite) TestBeginBlockerPruneExpiredConsensusStates() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	initialHeight := path.EndpointA.GetClientLatestHeight()
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	// pruning is disabled by default
+	client.BeginBlocker(suite.chainA.GetContext(), clientKeeper)
+
+	_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, initialHeight)
+	suite.Require().True(found)
+
+	params := clientKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxPrunedConsensusStatesPerBlock = 10
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	client.BeginBlocker(suite.chainA.GetContext(), clientKeeper)
+
+	_, found = suite.chainA.GetConsensusState(path.EndpointA.ClientID, initialHeight)
+	suite.Require().False(found)
+
+	// the consensus state at the latest height is kept
+	_, found = suite.chainA.GetConsensusState(path.EndpointA.ClientID, path.EndpointA.GetClientLatestHeight())
+	suite.Require().True(found)
+}
+
+func (suite *ClientTestSuite) TestBeginBlockerPruneExpiredConsensusStatesCursor() {
+	pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathA.SetupClients()
+	pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathB.SetupClients()
+
+	initialHeightA := pathA.EndpointA.GetClientLatestHeight()
+	initialHeightB := pathB.EndpointA.GetClientLatestHeight()
+	suite.Require().NoError(pathA.EndpointA.UpdateClient())
+	suite.Require().NoError(pathB.EndpointA.UpdateClient())
+
+	clientState, ok := pathA.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	params := clientKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxPrunedConsensusStatesPerBlock = 1
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// the first client reaches the limit, thus pruning resumes from it in the next block
+	client.BeginBlocker(suite.chainA.GetContext(), clientKeeper)
+
+	_, found := suite.chainA.GetConsensusState(pathA.EndpointA.ClientID, initialHeightA)
+	suite.Require().False(found)
+	_, found = suite.chainA.GetConsensusState(pathB.EndpointA.ClientID, initialHeightB)
+	suite.Require().True(found)
+
+	// the first client holds no further expired consensus states and the client limit is reached
+	client.BeginBlocker(suite.chainA.GetContext(), clientKeeper)
+
+	_, found = suite.chainA.GetConsensusState(pathB.EndpointA.ClientID, initialHeightB)
+	suite.Require().True(found)
+
+	// pruning resumes from the second client
+	client.BeginBlocker(suite.chainA.GetContext(), clientKeeper)
+
+	_, found = suite.chainA.GetConsensusState(pathB.EndpointA.ClientID, initialHeightB)
+	suite.Require().False(found)
+
+	// the consensus states at the latest heights are kept
+	_, found = suite.chainA.GetConsensusState(pathA.EndpointA.ClientID, pathA.EndpointA.GetClientLatestHeight())
+	suite.Require().True(found)
+	_, found = suite.chainA.GetConsensusState(pathB.EndpointA.ClientID, pathB.EndpointA.GetClientLatestHeight())
+	suite.Require().True(found)
+}
+
 // requireContainsEvent verifies if an event of a specific type was emitted.
 func (suite *ClientTestSuite) requireContainsEvent(events sdk.Events, eventType string, shouldContain bool) {
 	found := false
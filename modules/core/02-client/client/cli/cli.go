@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientCounterparty(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
@@ -125,6 +125,41 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientCounterparty defines the command to query the counterparty registered for a client with a given id
+func GetCmdQueryClientCounterparty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty [client-id]",
+		Short:   "Query the counterparty registered for a client",
+		Long:    "Query the counterparty merkle prefix and client identifier registered for an IBC v2 client",
+		Example: fmt.Sprintf("%s query %s %s counterparty [client-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClientCounterpartyRequest{
+				ClientId: clientID,
+			}
+
+			res, err := queryClient.ClientCounterparty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
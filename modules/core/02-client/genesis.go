@@ -49,11 +49,18 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	for _, ci := range gs.CounterpartyInfos {
+		k.SetClientCounterparty(ctx, ci.ClientId, ci.CounterpartyInfo)
+		if ci.Pending {
+			k.SetCounterpartyPending(ctx, ci.ClientId)
+		}
+	}
+
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
-// NOTE: the export process is not optimized, it will iterate three
+// NOTE: the export process is not optimized, it will iterate four
 // times over the 02-client sub-store.
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 	genClients := k.GetAllGenesisClients(ctx)
@@ -62,10 +69,11 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		panic(err)
 	}
 	return types.GenesisState{
-		Clients:          genClients,
-		ClientsMetadata:  clientsMetadata,
-		ClientsConsensus: k.GetAllConsensusStates(ctx),
-		Params:           k.GetParams(ctx),
+		Clients:           genClients,
+		ClientsMetadata:   clientsMetadata,
+		ClientsConsensus:  k.GetAllConsensusStates(ctx),
+		CounterpartyInfos: k.GetAllClientCounterparties(ctx, genClients),
+		Params:            k.GetParams(ctx),
 		// Warning: CreateLocalhost is deprecated
 		CreateLocalhost:    false,
 		NextClientSequence: k.GetNextClientSequence(ctx),
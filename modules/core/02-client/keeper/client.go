@@ -150,3 +150,54 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 
 	return nil
 }
+
+// RegisterCounterparty stores the counterparty information for the client with the given identifier.
+// If pending is true, the counterparty registration must be confirmed before it may be used to send
+// or receive packets.
+func (k *Keeper) RegisterCounterparty(ctx sdk.Context, clientID string, counterparty types.CounterpartyInfo, pending bool) {
+	k.SetClientCounterparty(ctx, clientID, counterparty)
+	if pending {
+		k.SetCounterpartyPending(ctx, clientID)
+	}
+
+	k.Logger(ctx).Info("counterparty registered", "client-id", clientID, "counterparty-client-id", counterparty.ClientId, "pending", pending)
+
+	emitRegisterCounterpartyEvent(ctx, clientID, counterparty, pending)
+}
+
+// ConfirmCounterparty confirms the pending counterparty registration of the client with the given identifier.
+// An error is returned if no counterparty registration is pending for the client.
+func (k *Keeper) ConfirmCounterparty(ctx sdk.Context, clientID string) error {
+	counterparty, found := k.GetClientCounterparty(ctx, clientID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCounterpartyNotFound, "counterparty not registered for client %s", clientID)
+	}
+
+	if !k.IsCounterpartyPending(ctx, clientID) {
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "counterparty registration of client %s is not pending confirmation", clientID)
+	}
+
+	k.DeleteCounterpartyPending(ctx, clientID)
+
+	k.Logger(ctx).Info("counterparty confirmed", "client-id", clientID, "counterparty-client-id", counterparty.ClientId)
+
+	emitConfirmCounterpartyEvent(ctx, clientID, counterparty)
+
+	return nil
+}
+
+// UpdateCounterparty overwrites the counterparty information registered for the client with the given identifier.
+// An error is returned if no counterparty has been registered for the client.
+func (k *Keeper) UpdateCounterparty(ctx sdk.Context, clientID string, counterparty types.CounterpartyInfo) error {
+	if _, found := k.GetClientCounterparty(ctx, clientID); !found {
+		return errorsmod.Wrapf(types.ErrCounterpartyNotFound, "counterparty not registered for client %s", clientID)
+	}
+
+	k.SetClientCounterparty(ctx, clientID, counterparty)
+
+	k.Logger(ctx).Info("counterparty updated", "client-id", clientID, "counterparty-client-id", counterparty.ClientId)
+
+	emitUpdateCounterpartyEvent(ctx, clientID, counterparty)
+
+	return nil
+}
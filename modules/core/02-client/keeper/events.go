@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -105,6 +106,64 @@ func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	})
 }
 
+// emitRegisterCounterpartyEvent emits a register counterparty event
+func emitRegisterCounterpartyEvent(ctx sdk.Context, clientID string, counterparty types.CounterpartyInfo, pending bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterCounterparty,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyMerklePrefix, merklePrefixAttribute(counterparty.MerklePrefix)),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyPending, strconv.FormatBool(pending)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitUpdateCounterpartyEvent emits an update counterparty event
+func emitUpdateCounterpartyEvent(ctx sdk.Context, clientID string, counterparty types.CounterpartyInfo) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateCounterparty,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyMerklePrefix, merklePrefixAttribute(counterparty.MerklePrefix)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConfirmCounterpartyEvent emits a confirm counterparty event
+func emitConfirmCounterpartyEvent(ctx sdk.Context, clientID string, counterparty types.CounterpartyInfo) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConfirmCounterparty,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, counterparty.ClientId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// merklePrefixAttribute returns the comma separated hex encoded elements of the merkle prefix.
+func merklePrefixAttribute(merklePrefix [][]byte) string {
+	elements := make([]string, len(merklePrefix))
+	for i, element := range merklePrefix {
+		elements[i] = hex.EncodeToString(element)
+	}
+
+	return strings.Join(elements, ",")
+}
+
 // emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
 func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -411,3 +411,28 @@ func (q *queryServer) VerifyMembership(c context.Context, req *types.QueryVerify
 		Success: true,
 	}, nil
 }
+
+// ClientCounterparty implements the Query/ClientCounterparty gRPC method
+func (q *queryServer) ClientCounterparty(c context.Context, req *types.QueryClientCounterpartyRequest) (*types.QueryClientCounterpartyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	counterparty, found := q.GetClientCounterparty(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrCounterpartyNotFound, req.ClientId).Error(),
+		)
+	}
+
+	return &types.QueryClientCounterpartyResponse{
+		Counterparty: counterparty,
+		Pending:      q.IsCounterpartyPending(ctx, req.ClientId),
+	}, nil
+}
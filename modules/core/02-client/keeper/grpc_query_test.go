@@ -605,6 +605,81 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientCounterparty() {
+	var (
+		req             *types.QueryClientCounterpartyRequest
+		expCounterparty types.CounterpartyInfo
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expCounterparty = types.NewCounterpartyInfo(path.EndpointB.MerklePathPrefix.KeyPath, path.EndpointB.ClientID)
+				req = &types.QueryClientCounterpartyRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryClientCounterpartyRequest{}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"counterparty not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				req = &types.QueryClientCounterpartyRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("%s: counterparty not found", ibctesting.FirstClientID)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+			queryServer := keeper.NewQueryServer(suite.chainA.GetSimApp().IBCKeeper.ClientKeeper)
+			res, err := queryServer.ClientCounterparty(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expCounterparty, res.Counterparty)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
 	var (
 		req            *types.QueryUpgradedClientStateRequest
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	corestore "cosmossdk.io/core/store"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
 	localhost "github.com/cosmos/ibc-go/v9/modules/light-clients/09-localhost"
@@ -161,6 +163,24 @@ func (k *Keeper) GetClientCounterparty(ctx context.Context, clientID string) (ty
 	return counterparty, true
 }
 
+// SetCounterpartyPending marks the counterparty registered for a given clientID as pending confirmation
+func (k *Keeper) SetCounterpartyPending(ctx context.Context, clientID string) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set(types.CounterpartyPendingKey(), []byte{byte(1)})
+}
+
+// IsCounterpartyPending returns true if the counterparty registered for a given clientID is pending confirmation
+func (k *Keeper) IsCounterpartyPending(ctx context.Context, clientID string) bool {
+	store := k.ClientStore(ctx, clientID)
+	return store.Has(types.CounterpartyPendingKey())
+}
+
+// DeleteCounterpartyPending deletes the pending confirmation marker of the counterparty registered for a given clientID
+func (k *Keeper) DeleteCounterpartyPending(ctx context.Context, clientID string) {
+	store := k.ClientStore(ctx, clientID)
+	store.Delete(types.CounterpartyPendingKey())
+}
+
 // GetClientConsensusState gets the stored consensus state from a client at a given height.
 func (k *Keeper) GetClientConsensusState(ctx context.Context, clientID string, height exported.Height) (exported.ConsensusState, bool) {
 	store := k.ClientStore(ctx, clientID)
@@ -248,6 +268,11 @@ func (k *Keeper) iterateMetadata(ctx context.Context, cb func(clientID string, k
 			continue
 		}
 
+		if len(split) == 3 && split[2] == types.KeyCounterparty {
+			// skip counterparty keys, they are exported separately
+			continue
+		}
+
 		if split[0] != string(host.KeyClientStorePrefix) {
 			panic(errorsmod.Wrapf(host.ErrInvalidPath, "path does not begin with client store prefix: expected %s, got %s", host.KeyClientStorePrefix, split[0]))
 		}
@@ -300,6 +325,21 @@ func (k *Keeper) GetAllClientMetadata(ctx context.Context, genClients []types.Id
 	return genMetadata, nil
 }
 
+// GetAllClientCounterparties returns the counterparty info of each of the provided clients
+// which has registered a counterparty.
+func (k *Keeper) GetAllClientCounterparties(ctx context.Context, genClients []types.IdentifiedClientState) []types.IdentifiedCounterpartyInfo {
+	counterpartyInfos := make([]types.IdentifiedCounterpartyInfo, 0)
+	for _, ic := range genClients {
+		if counterparty, found := k.GetClientCounterparty(ctx, ic.ClientId); found {
+			identifiedCounterparty := types.NewIdentifiedCounterpartyInfo(ic.ClientId, counterparty)
+			identifiedCounterparty.Pending = k.IsCounterpartyPending(ctx, ic.ClientId)
+			counterpartyInfos = append(counterpartyInfos, identifiedCounterparty)
+		}
+	}
+
+	return counterpartyInfos
+}
+
 // SetAllClientMetadata takes a list of IdentifiedGenesisMetadata and stores all of the metadata in the client store at the appropriate paths.
 func (k *Keeper) SetAllClientMetadata(ctx context.Context, genMetadata []types.IdentifiedGenesisMetadata) {
 	for _, igm := range genMetadata {
@@ -382,6 +422,192 @@ func (k *Keeper) VerifyNonMembership(ctx context.Context, clientID string, heigh
 	return clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path)
 }
 
+// VerifyMembershipBatch retrieves the light client module for the clientID and verifies the proof of the existence of
+// each key-value pair at a specified height. Light client modules which do not implement exported.BatchVerifier verify
+// the proof against each path in turn.
+func (k *Keeper) VerifyMembershipBatch(ctx context.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of paths (%d) must equal number of values (%d)", len(paths), len(values))
+	}
+
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify membership batch on client (%s) with status %s", clientID, status)
+	}
+
+	if batchVerifier, ok := clientModule.(exported.BatchVerifier); ok {
+		return batchVerifier.VerifyMembershipBatch(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+	}
+
+	for i, path := range paths {
+		if err := clientModule.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, values[i]); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify membership of path at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyNonMembershipBatch retrieves the light client module for the clientID and verifies the absence of each of the given keys
+// at a specified height. Light client modules which do not implement exported.BatchVerifier verify the proof against each path in turn.
+func (k *Keeper) VerifyNonMembershipBatch(ctx context.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify non membership batch on client (%s) with status %s", clientID, status)
+	}
+
+	if batchVerifier, ok := clientModule.(exported.BatchVerifier); ok {
+		return batchVerifier.VerifyNonMembershipBatch(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths)
+	}
+
+	for i, path := range paths {
+		if err := clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify non-membership of path at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// PruneExpiredConsensusStates deletes expired consensus states of the clients whose light client module implements
+// exported.ConsensusStatePruner, up to the maximum number per block set in the params. Clients are visited in order
+// of their identifiers, starting from the pruning cursor, and at most the same maximum number of clients is visited
+// per block. The cursor is then set to the next client to be visited, such that pruning resumes from there in the
+// next block and starts over from the first client once all clients have been visited. The total number of
+// consensus states pruned is returned.
+func (k *Keeper) PruneExpiredConsensusStates(ctx context.Context) uint64 {
+	limit := k.GetParams(ctx).MaxPrunedConsensusStatesPerBlock
+	if limit == 0 {
+		return 0
+	}
+
+	var totalPruned uint64
+	cursor := k.getPruneConsensusStatesCursor(ctx)
+	for visited := uint64(0); visited < limit; visited++ {
+		clientID, found := k.nextClientID(ctx, cursor)
+		if !found {
+			// all clients have been visited, pruning starts over from the first client in the next block
+			cursor = nil
+			break
+		}
+
+		pruned, err := k.pruneClientExpiredConsensusStates(ctx, clientID, limit-totalPruned)
+		if err != nil {
+			k.Logger(ctx).Error("failed to prune expired consensus states", "client-id", clientID, "error", err)
+		}
+
+		totalPruned += pruned
+		if totalPruned >= limit {
+			// the client may hold further expired consensus states, thus it is visited again in the next block
+			cursor = clientStoreCursor(clientID)
+			break
+		}
+
+		cursor = storetypes.PrefixEndBytes(clientStoreCursor(clientID))
+	}
+
+	k.setPruneConsensusStatesCursor(ctx, cursor)
+
+	return totalPruned
+}
+
+// pruneClientExpiredConsensusStates deletes at most limit expired consensus states of the given client,
+// if its light client module implements exported.ConsensusStatePruner.
+func (k *Keeper) pruneClientExpiredConsensusStates(ctx context.Context, clientID string, limit uint64) (uint64, error) {
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+
+	pruner, ok := clientModule.(exported.ConsensusStatePruner)
+	if !ok {
+		return 0, nil
+	}
+
+	return pruner.PruneExpiredConsensusStates(ctx, clientID, limit)
+}
+
+// nextClientID returns the identifier of the first client whose store is at or after the given cursor.
+// The keys of a client store are skipped at once if it holds no client state.
+func (k *Keeper) nextClientID(ctx context.Context, cursor []byte) (string, bool) {
+	for {
+		clientID, found := k.firstClientStore(ctx, cursor)
+		if !found {
+			return "", false
+		}
+
+		if _, found := k.GetClientState(ctx, clientID); found {
+			return clientID, true
+		}
+
+		cursor = storetypes.PrefixEndBytes(clientStoreCursor(clientID))
+	}
+}
+
+// firstClientStore returns the identifier of the first client store at or after the given cursor.
+func (k *Keeper) firstClientStore(ctx context.Context, cursor []byte) (string, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storePrefix := host.PrefixedClientStoreKey(nil)
+	iterator := store.Iterator(append(slices.Clone(storePrefix), cursor...), storetypes.PrefixEndBytes(storePrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	clientID, _, _ := strings.Cut(string(iterator.Key()[len(storePrefix):]), "/")
+	return clientID, true
+}
+
+// clientStoreCursor returns the pruning cursor positioned at the store of the given client.
+func clientStoreCursor(clientID string) []byte {
+	return []byte(clientID + "/")
+}
+
+// getPruneConsensusStatesCursor returns the position in the client stores from which the pruning of expired
+// consensus states resumes.
+func (k *Keeper) getPruneConsensusStatesCursor(ctx context.Context) []byte {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.KeyPruneConsensusStatesCursor))
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// setPruneConsensusStatesCursor sets the position in the client stores from which the pruning of expired
+// consensus states resumes.
+func (k *Keeper) setPruneConsensusStatesCursor(ctx context.Context, cursor []byte) {
+	store := k.storeService.OpenKVStore(ctx)
+	if len(cursor) == 0 {
+		if err := store.Delete([]byte(types.KeyPruneConsensusStatesCursor)); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if err := store.Set([]byte(types.KeyPruneConsensusStatesCursor), cursor); err != nil {
+		panic(err)
+	}
+}
+
 // GetUpgradePlan executes the upgrade keeper GetUpgradePlan function.
 func (k *Keeper) GetUpgradePlan(ctx context.Context) (upgradetypes.Plan, error) {
 	return k.upgradeKeeper.GetUpgradePlan(ctx)
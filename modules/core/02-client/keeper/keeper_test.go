@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -225,6 +226,9 @@ func (suite *KeeperTestSuite) TestGetAllGenesisMetadata() {
 
 	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetAllClientMetadata(suite.chainA.GetContext(), expectedGenMetadata)
 
+	// the counterparty info is exported separately from the client metadata
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientCounterparty(suite.chainA.GetContext(), clientA, types.NewCounterpartyInfo([][]byte{[]byte("ibc")}, testClientID2))
+
 	actualGenMetadata, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetAllClientMetadata(suite.chainA.GetContext(), genClients)
 	suite.Require().NoError(err, "get client metadata returned error unexpectedly")
 	suite.Require().Equal(expectedGenMetadata, actualGenMetadata, "retrieved metadata is unexpected")
@@ -237,6 +241,31 @@ func (suite *KeeperTestSuite) TestGetAllGenesisMetadata() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestGetAllClientCounterparties() {
+	clientA, clientB, clientC := "07-tendermint-1", "07-tendermint-2", "07-tendermint-3"
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientA, &ibctm.ClientState{})
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientB, &ibctm.ClientState{})
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientC, &ibctm.ClientState{})
+
+	counterparty := types.NewCounterpartyInfo([][]byte{[]byte("ibc"), []byte("")}, testClientID2)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientCounterparty(suite.chainA.GetContext(), clientA, counterparty)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientCounterparty(suite.chainA.GetContext(), clientC, counterparty)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetCounterpartyPending(suite.chainA.GetContext(), clientC)
+
+	genClients := []types.IdentifiedClientState{
+		types.NewIdentifiedClientState(clientA, &ibctm.ClientState{}), types.NewIdentifiedClientState(clientB, &ibctm.ClientState{}),
+		types.NewIdentifiedClientState(clientC, &ibctm.ClientState{}),
+	}
+
+	pendingCounterparty := types.NewIdentifiedCounterpartyInfo(clientC, counterparty)
+	pendingCounterparty.Pending = true
+	expCounterpartyInfos := []types.IdentifiedCounterpartyInfo{types.NewIdentifiedCounterpartyInfo(clientA, counterparty), pendingCounterparty}
+
+	counterpartyInfos := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetAllClientCounterparties(suite.chainA.GetContext(), genClients)
+	suite.Require().Equal(expCounterpartyInfos, counterpartyInfos)
+}
+
 // 2 clients in total are created on chainA. The first client is updated so it contains an initial consensus state
 // and a consensus state at the update height.
 func (suite *KeeperTestSuite) TestGetAllConsensusStates() {
@@ -611,6 +640,168 @@ func (suite *KeeperTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyMembershipBatch() {
+	var (
+		path        *ibctesting.Path
+		merklePaths []exported.Path
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid client id",
+			func() {
+				path.EndpointA.ClientID = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotActive,
+		},
+		{
+			"failure: empty paths",
+			func() {
+				merklePaths = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// create default proof, merklePaths, and values which pass
+			clientStateKey := host.FullClientStateKey(path.EndpointB.ClientID)
+			connectionKey := host.ConnectionKey(path.EndpointB.ConnectionID)
+
+			merklePaths = nil
+			for _, key := range [][]byte{clientStateKey, connectionKey} {
+				merklePrefixPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				suite.Require().NoError(err)
+
+				merklePaths = append(merklePaths, merklePrefixPath)
+			}
+
+			proof, proofHeight := path.EndpointB.QueryBatchProof(clientStateKey, connectionKey)
+
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientStateBz, err := suite.chainB.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			connectionBz, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMembershipBatch(suite.chainA.GetContext(), path.EndpointA.ClientID, proofHeight, 0, 0, proof, merklePaths, [][]byte{clientStateBz, connectionBz})
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyNonMembershipBatch() {
+	var (
+		path        *ibctesting.Path
+		merklePaths []exported.Path
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid client id",
+			func() {
+				path.EndpointA.ClientID = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotActive,
+		},
+		{
+			"failure: empty paths",
+			func() {
+				merklePaths = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// create default proof and merklePaths which pass
+			keys := [][]byte{host.FullClientStateKey("invalid-client-id"), host.ConnectionKey("invalid-connection-id")}
+
+			merklePaths = nil
+			for _, key := range keys {
+				merklePrefixPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				suite.Require().NoError(err)
+
+				merklePaths = append(merklePaths, merklePrefixPath)
+			}
+
+			proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyNonMembershipBatch(suite.chainA.GetContext(), path.EndpointA.ClientID, proofHeight, 0, 0, proof, merklePaths)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestDefaultSetParams tests the default params set are what is expected
 func (suite *KeeperTestSuite) TestDefaultSetParams() {
 	expParams := types.DefaultParams()
@@ -250,6 +250,11 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// max_pruned_consensus_states_per_block defines the maximum number of expired consensus states which are
+	// pruned across all clients at the beginning of each block, which also bounds the number of clients visited per block.
+	// Pruning resumes from the next client to be visited in the following block. Pruning is disabled if set to 0, which
+	// is the default, and the maximum is 1000.
+	MaxPrunedConsensusStatesPerBlock uint64 `protobuf:"varint,2,opt,name=max_pruned_consensus_states_per_block,json=maxPrunedConsensusStatesPerBlock,proto3" json:"max_pruned_consensus_states_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -292,6 +297,13 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetMaxPrunedConsensusStatesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedConsensusStatesPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
@@ -303,36 +315,38 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xa5, 0x55, 0xd4, 0x5c, 0x50, 0x82, 0x4c, 0x2b, 0x99, 0x20, 0xd9, 0x51, 0x16, 0x32,
-	0xd0, 0x3b, 0x12, 0x06, 0x0a, 0x82, 0x81, 0x74, 0xa1, 0x0b, 0x42, 0x66, 0x40, 0x42, 0x42, 0x91,
-	0x7d, 0xbe, 0xda, 0x27, 0xd9, 0x77, 0x95, 0xef, 0x6c, 0x94, 0x7f, 0xc0, 0x84, 0x90, 0x58, 0x18,
-	0xfb, 0x73, 0x3a, 0x76, 0x64, 0xaa, 0x50, 0xb2, 0xf1, 0x2b, 0x90, 0xef, 0x2e, 0xaa, 0x5c, 0x0a,
-	0x62, 0x7b, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0xfb, 0x5e, 0x62, 0x18, 0xb0, 0x98, 0x60, 0x22, 0x4a,
-	0x8a, 0x49, 0xce, 0x28, 0x57, 0xb8, 0x9e, 0xd9, 0x0a, 0x9d, 0x95, 0x42, 0x09, 0xd7, 0x65, 0x31,
-	0x41, 0x0d, 0x01, 0xd9, 0x76, 0x3d, 0x1b, 0xed, 0xa7, 0x22, 0x15, 0x1a, 0xc6, 0x4d, 0x65, 0x98,
-	0xa3, 0xfb, 0xa9, 0x10, 0x69, 0x4e, 0xb1, 0x7e, 0xc5, 0xd5, 0x29, 0x8e, 0xf8, 0xca, 0x40, 0x93,
-	0x02, 0x1e, 0x9c, 0x24, 0x94, 0x2b, 0x76, 0xca, 0x68, 0x72, 0xac, 0xf7, 0xbc, 0x53, 0x91, 0xa2,
-	0xee, 0x03, 0xd8, 0x33, 0x6b, 0x97, 0x2c, 0xf1, 0xc0, 0x18, 0x4c, 0x7b, 0xe1, 0x9e, 0x69, 0x9c,
-	0x24, 0xee, 0x53, 0x78, 0xc7, 0x82, 0xb2, 0x21, 0x7b, 0x9d, 0x31, 0x98, 0xf6, 0xe7, 0xfb, 0xc8,
-	0xe8, 0xa0, 0xad, 0x0e, 0x7a, 0xc5, 0x57, 0x61, 0x9f, 0x5c, 0x6f, 0x9d, 0x7c, 0x03, 0xd0, 0x3b,
-	0x16, 0x5c, 0x52, 0x2e, 0x2b, 0xa9, 0x5b, 0xef, 0x99, 0xca, 0x5e, 0x53, 0x96, 0x66, 0xca, 0x3d,
-	0x82, 0xdd, 0x4c, 0x57, 0x5a, 0xaf, 0x3f, 0x1f, 0xa1, 0x3f, 0x13, 0x22, 0xc3, 0x5d, 0xec, 0x5e,
-	0x5c, 0x05, 0x4e, 0x68, 0xf9, 0xee, 0x4b, 0x38, 0x24, 0xdb, 0xad, 0xff, 0x61, 0x69, 0x40, 0x5a,
-	0x16, 0x1a, 0x57, 0x07, 0x26, 0x7b, 0xdb, 0x9b, 0xfc, 0xf7, 0x15, 0x3e, 0xc2, 0xbb, 0x37, 0x54,
-	0xa5, 0xd7, 0x19, 0xef, 0x4c, 0xfb, 0xf3, 0x47, 0xb7, 0x39, 0xff, 0x5b, 0x6e, 0x9b, 0x65, 0xd8,
-	0x36, 0x25, 0x27, 0x5f, 0x00, 0xec, 0xda, 0xcb, 0xbc, 0x80, 0xc3, 0x92, 0xd6, 0x4c, 0x32, 0xc1,
-	0x97, 0xbc, 0x2a, 0x62, 0x5a, 0x6a, 0x33, 0xbb, 0x8b, 0x7b, 0xbf, 0xae, 0x82, 0x9b, 0x50, 0x38,
-	0xd8, 0x36, 0xde, 0xe8, 0x77, 0x6b, 0xda, 0x1e, 0xb8, 0x73, 0xcb, 0xb4, 0x81, 0xae, 0xa7, 0x8d,
-	0xf6, 0xf3, 0xbd, 0xcf, 0xe7, 0x81, 0xf3, 0xfd, 0x3c, 0x70, 0x26, 0x33, 0xd8, 0x7d, 0x1b, 0x95,
-	0x51, 0x21, 0xdd, 0x87, 0x70, 0x18, 0xe5, 0xb9, 0xf8, 0x44, 0x93, 0xa5, 0xc9, 0x27, 0x3d, 0x30,
-	0xde, 0x99, 0xf6, 0xc2, 0x81, 0x6d, 0x9b, 0x6b, 0xca, 0x45, 0x78, 0xb1, 0xf6, 0xc1, 0xe5, 0xda,
-	0x07, 0x3f, 0xd7, 0x3e, 0xf8, 0xba, 0xf1, 0x9d, 0xcb, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce, 0x87,
-	0xa3, 0x94, 0xa9, 0xac, 0x8a, 0x11, 0x11, 0x05, 0x26, 0x42, 0x16, 0x42, 0x62, 0x16, 0x93, 0xc3,
-	0x54, 0xe0, 0xfa, 0x19, 0x2e, 0x44, 0x52, 0xe5, 0x54, 0x9a, 0xbf, 0xff, 0xe3, 0xf9, 0xa1, 0xfd,
-	0x02, 0xd4, 0xea, 0x8c, 0xca, 0xb8, 0xab, 0x7f, 0xcb, 0x27, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x94, 0x27, 0x25, 0x2c, 0x21, 0x03, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xf7, 0xa5, 0x55, 0xd4, 0x5c, 0x50, 0x82, 0x4c, 0x2b, 0x99, 0x20, 0xd9, 0x56, 0x24, 0x44,
+	0x06, 0xea, 0xa3, 0x61, 0xa0, 0x20, 0x18, 0x48, 0x17, 0xba, 0x40, 0x64, 0x06, 0x24, 0x24, 0x64,
+	0xd9, 0xe7, 0xab, 0x73, 0xc2, 0xbe, 0x8b, 0x7c, 0xe7, 0xd0, 0xac, 0x4c, 0x4c, 0x08, 0x89, 0x85,
+	0xb1, 0x1f, 0xa7, 0x63, 0x47, 0xa6, 0x0a, 0x25, 0x1b, 0x9f, 0x02, 0xf9, 0xee, 0xa2, 0x2a, 0xa1,
+	0xa0, 0x6e, 0x77, 0xef, 0xfd, 0xde, 0xfb, 0xfd, 0xb1, 0x0f, 0x7a, 0x34, 0xc1, 0x08, 0xf3, 0x92,
+	0x20, 0x9c, 0x53, 0xc2, 0x24, 0x9a, 0x1d, 0x98, 0x53, 0x30, 0x2d, 0xb9, 0xe4, 0xb6, 0x4d, 0x13,
+	0x1c, 0xd4, 0x80, 0xc0, 0x94, 0x67, 0x07, 0xbd, 0xdd, 0x8c, 0x67, 0x5c, 0xb5, 0x51, 0x7d, 0xd2,
+	0xc8, 0xde, 0xdd, 0x8c, 0xf3, 0x2c, 0x27, 0x48, 0xdd, 0x92, 0xea, 0x04, 0xc5, 0x6c, 0xae, 0x5b,
+	0xfd, 0x02, 0xee, 0x1d, 0xa7, 0x84, 0x49, 0x7a, 0x42, 0x49, 0x7a, 0xa4, 0xf6, 0xbc, 0x95, 0xb1,
+	0x24, 0xf6, 0x3d, 0xd8, 0xd2, 0x6b, 0x23, 0x9a, 0x3a, 0xc0, 0x07, 0x83, 0x56, 0xb8, 0xa3, 0x0b,
+	0xc7, 0xa9, 0xfd, 0x04, 0xde, 0x32, 0x4d, 0x51, 0x83, 0x9d, 0x86, 0x0f, 0x06, 0xed, 0xe1, 0x6e,
+	0xa0, 0x79, 0x82, 0x15, 0x4f, 0xf0, 0x92, 0xcd, 0xc3, 0x36, 0xbe, 0xda, 0xda, 0xff, 0x0e, 0xa0,
+	0x73, 0xc4, 0x99, 0x20, 0x4c, 0x54, 0x42, 0x95, 0xde, 0x51, 0x39, 0x79, 0x45, 0x68, 0x36, 0x91,
+	0xf6, 0x21, 0x6c, 0x4e, 0xd4, 0x49, 0xf1, 0xb5, 0x87, 0xbd, 0xe0, 0x6f, 0x87, 0x81, 0xc6, 0x8e,
+	0xb6, 0xcf, 0x2f, 0x3d, 0x2b, 0x34, 0x78, 0xfb, 0x05, 0xec, 0xe2, 0xd5, 0xd6, 0x1b, 0x48, 0xea,
+	0xe0, 0x35, 0x09, 0xb5, 0xaa, 0x3d, 0xed, 0x7d, 0x5d, 0x9b, 0xf8, 0x7f, 0x0a, 0x1f, 0xe0, 0xed,
+	0x0d, 0x56, 0xe1, 0x34, 0xfc, 0xad, 0x41, 0x7b, 0xf8, 0xf0, 0x3a, 0xe5, 0xff, 0xf2, 0x6d, 0xbc,
+	0x74, 0xd7, 0x45, 0x89, 0xfe, 0x57, 0x00, 0x9b, 0x26, 0x99, 0xe7, 0xb0, 0x5b, 0x92, 0x19, 0x15,
+	0x94, 0xb3, 0x88, 0x55, 0x45, 0x42, 0x4a, 0x25, 0x66, 0x7b, 0x74, 0xe7, 0xf7, 0xa5, 0xb7, 0xd9,
+	0x0a, 0x3b, 0xab, 0xc2, 0x6b, 0x75, 0x5f, 0x9b, 0x36, 0x01, 0x37, 0xae, 0x99, 0xd6, 0xad, 0xab,
+	0x69, 0xcd, 0xfd, 0x6c, 0xe7, 0xcb, 0x99, 0x67, 0xfd, 0x38, 0xf3, 0xac, 0xfe, 0x67, 0x00, 0x9b,
+	0xe3, 0xb8, 0x8c, 0x0b, 0x61, 0x3f, 0x80, 0xdd, 0x38, 0xcf, 0xf9, 0x27, 0x92, 0x46, 0xda, 0xa0,
+	0x70, 0x80, 0xbf, 0x35, 0x68, 0x85, 0x1d, 0x53, 0xd6, 0x71, 0x0a, 0xfb, 0x0d, 0xbc, 0x5f, 0xc4,
+	0xa7, 0xd1, 0xb4, 0xac, 0x58, 0x8d, 0xdd, 0x88, 0x2b, 0x9a, 0x92, 0x32, 0x4a, 0x72, 0x8e, 0x3f,
+	0x6a, 0x45, 0xa1, 0x5f, 0xc4, 0xa7, 0x63, 0x85, 0xdd, 0xf8, 0x12, 0x63, 0x52, 0x8e, 0x6a, 0xdc,
+	0x28, 0x3c, 0x5f, 0xb8, 0xe0, 0x62, 0xe1, 0x82, 0x5f, 0x0b, 0x17, 0x7c, 0x5b, 0xba, 0xd6, 0xc5,
+	0xd2, 0xb5, 0x7e, 0x2e, 0x5d, 0xeb, 0xfd, 0x61, 0x46, 0xe5, 0xa4, 0x4a, 0x02, 0xcc, 0x0b, 0x84,
+	0xb9, 0x28, 0xb8, 0x40, 0x34, 0xc1, 0xfb, 0x19, 0x47, 0xb3, 0xa7, 0xa8, 0xe0, 0x69, 0x95, 0x13,
+	0xa1, 0x1f, 0xd4, 0xa3, 0xe1, 0xbe, 0x79, 0x53, 0x72, 0x3e, 0x25, 0x22, 0x69, 0xaa, 0xbf, 0xe3,
+	0xf1, 0x9f, 0x01, 0x00, 0x75, 0xbb, 0x9b, 0x25, 0x73, 0x03, 0x00, 0x00,
 }
 
 func (m *IdentifiedClientState) Marshal() (dAtA []byte, err error) {
@@ -519,6 +533,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPrunedConsensusStatesPerBlock != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxPrunedConsensusStatesPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -620,6 +639,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if m.MaxPrunedConsensusStatesPerBlock != 0 {
+		n += 1 + sovClient(uint64(m.MaxPrunedConsensusStatesPerBlock))
+	}
 	return n
 }
 
@@ -1131,6 +1153,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedConsensusStatesPerBlock", wireType)
+			}
+			m.MaxPrunedConsensusStatesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedConsensusStatesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -46,6 +46,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgIBCSoftwareUpgrade{},
 		&MsgUpdateParams{},
 		&MsgRegisterCounterparty{},
+		&MsgUpdateCounterparty{},
+		&MsgConfirmCounterparty{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,5 +1,11 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
 // NewCounterpartyInfo creates a new counterparty info instance from merlePrefix and clientID
 func NewCounterpartyInfo(merklePrefix [][]byte, clientID string) CounterpartyInfo {
 	return CounterpartyInfo{
@@ -7,3 +13,20 @@ func NewCounterpartyInfo(merklePrefix [][]byte, clientID string) CounterpartyInf
 		ClientId:     clientID,
 	}
 }
+
+// Validate performs basic validation of the counterparty info returning an error upon any failure.
+func (ci CounterpartyInfo) Validate() error {
+	if len(ci.MerklePrefix) == 0 {
+		return errorsmod.Wrap(ErrInvalidCounterparty, "counterparty messaging key cannot be empty")
+	}
+
+	return host.ClientIdentifierValidator(ci.ClientId)
+}
+
+// NewIdentifiedCounterpartyInfo creates a new IdentifiedCounterpartyInfo instance.
+func NewIdentifiedCounterpartyInfo(clientID string, counterpartyInfo CounterpartyInfo) IdentifiedCounterpartyInfo {
+	return IdentifiedCounterpartyInfo{
+		ClientId:         clientID,
+		CounterpartyInfo: counterpartyInfo,
+	}
+}
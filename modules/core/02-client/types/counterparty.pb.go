@@ -94,6 +94,17 @@ type MsgRegisterCounterparty struct {
 	CounterpartyClientId string `protobuf:"bytes,3,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
 	// signer address
 	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// optional proof that the counterparty chain has registered this client as the counterparty of the
+	// counterparty client. If provided, the registration fails unless the reverse mapping is proven.
+	ProofCounterparty []byte `protobuf:"bytes,5,opt,name=proof_counterparty,json=proofCounterparty,proto3" json:"proof_counterparty,omitempty"`
+	// height at which the proof of the counterparty registration was retrieved
+	ProofHeight Height `protobuf:"bytes,6,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// if true and no counterparty proof is provided, the counterparty registration remains pending and no packets
+	// may be sent or received over the client until the registration is confirmed with MsgConfirmCounterparty.
+	RequireConfirmation bool `protobuf:"varint,7,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
+	// the merkle prefix under which the counterparty chain has registered this chain, used to verify the counterparty
+	// proof. Defaults to the commitment prefix of this chain followed by an empty element if not provided.
+	MerklePrefix [][]byte `protobuf:"bytes,8,rep,name=merkle_prefix,json=merklePrefix,proto3" json:"merkle_prefix,omitempty"`
 }
 
 func (m *MsgRegisterCounterparty) Reset()         { *m = MsgRegisterCounterparty{} }
@@ -166,10 +177,183 @@ func (m *MsgRegisterCounterpartyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterCounterpartyResponse proto.InternalMessageInfo
 
+// MsgUpdateCounterparty defines a message to update the counterparty registered on a client.
+// It may only be submitted by the authority.
+type MsgUpdateCounterparty struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// counterparty merkle prefix
+	CounterpartyMerklePrefix [][]byte `protobuf:"bytes,2,rep,name=counterparty_merkle_prefix,json=counterpartyMerklePrefix,proto3" json:"counterparty_merkle_prefix,omitempty"`
+	// counterparty client identifier
+	CounterpartyClientId string `protobuf:"bytes,3,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUpdateCounterparty) Reset()         { *m = MsgUpdateCounterparty{} }
+func (m *MsgUpdateCounterparty) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCounterparty) ProtoMessage()    {}
+func (*MsgUpdateCounterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4a81c3d2196cf1, []int{3}
+}
+func (m *MsgUpdateCounterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCounterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCounterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCounterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCounterparty.Merge(m, src)
+}
+func (m *MsgUpdateCounterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCounterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCounterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCounterparty proto.InternalMessageInfo
+
+// MsgUpdateCounterpartyResponse defines the Msg/UpdateCounterparty response type.
+type MsgUpdateCounterpartyResponse struct {
+}
+
+func (m *MsgUpdateCounterpartyResponse) Reset()         { *m = MsgUpdateCounterpartyResponse{} }
+func (m *MsgUpdateCounterpartyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCounterpartyResponse) ProtoMessage()    {}
+func (*MsgUpdateCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4a81c3d2196cf1, []int{4}
+}
+func (m *MsgUpdateCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCounterpartyResponse.Merge(m, src)
+}
+func (m *MsgUpdateCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCounterpartyResponse proto.InternalMessageInfo
+
+// MsgConfirmCounterparty defines a message to confirm a pending counterparty registration by proving that the
+// counterparty chain has registered the client as the counterparty of the counterparty client.
+type MsgConfirmCounterparty struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// proof that the counterparty chain has registered this client as the counterparty of the counterparty client
+	ProofCounterparty []byte `protobuf:"bytes,2,opt,name=proof_counterparty,json=proofCounterparty,proto3" json:"proof_counterparty,omitempty"`
+	// height at which the proof of the counterparty registration was retrieved
+	ProofHeight Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the merkle prefix under which the counterparty chain has registered this chain. Defaults to the commitment
+	// prefix of this chain followed by an empty element if not provided.
+	MerklePrefix [][]byte `protobuf:"bytes,4,rep,name=merkle_prefix,json=merklePrefix,proto3" json:"merkle_prefix,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgConfirmCounterparty) Reset()         { *m = MsgConfirmCounterparty{} }
+func (m *MsgConfirmCounterparty) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmCounterparty) ProtoMessage()    {}
+func (*MsgConfirmCounterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4a81c3d2196cf1, []int{5}
+}
+func (m *MsgConfirmCounterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmCounterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmCounterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmCounterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmCounterparty.Merge(m, src)
+}
+func (m *MsgConfirmCounterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmCounterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmCounterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmCounterparty proto.InternalMessageInfo
+
+// MsgConfirmCounterpartyResponse defines the Msg/ConfirmCounterparty response type.
+type MsgConfirmCounterpartyResponse struct {
+}
+
+func (m *MsgConfirmCounterpartyResponse) Reset()         { *m = MsgConfirmCounterpartyResponse{} }
+func (m *MsgConfirmCounterpartyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmCounterpartyResponse) ProtoMessage()    {}
+func (*MsgConfirmCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4a81c3d2196cf1, []int{6}
+}
+func (m *MsgConfirmCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmCounterpartyResponse.Merge(m, src)
+}
+func (m *MsgConfirmCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmCounterpartyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CounterpartyInfo)(nil), "ibc.core.client.v2.CounterpartyInfo")
 	proto.RegisterType((*MsgRegisterCounterparty)(nil), "ibc.core.client.v2.MsgRegisterCounterparty")
 	proto.RegisterType((*MsgRegisterCounterpartyResponse)(nil), "ibc.core.client.v2.MsgRegisterCounterpartyResponse")
+	proto.RegisterType((*MsgUpdateCounterparty)(nil), "ibc.core.client.v2.MsgUpdateCounterparty")
+	proto.RegisterType((*MsgUpdateCounterpartyResponse)(nil), "ibc.core.client.v2.MsgUpdateCounterpartyResponse")
+	proto.RegisterType((*MsgConfirmCounterparty)(nil), "ibc.core.client.v2.MsgConfirmCounterparty")
+	proto.RegisterType((*MsgConfirmCounterpartyResponse)(nil), "ibc.core.client.v2.MsgConfirmCounterpartyResponse")
 }
 
 func init() {
@@ -177,32 +361,44 @@ func init() {
 }
 
 var fileDescriptor_bc4a81c3d2196cf1 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x2f, 0x33, 0xd2, 0x4f,
-	0xce, 0x2f, 0xcd, 0x2b, 0x49, 0x2d, 0x2a, 0x48, 0x2c, 0x2a, 0xa9, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0xca, 0x4c, 0x4a, 0xd6, 0x03, 0x29, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0x92,
-	0x12, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51,
-	0x10, 0xc5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x0a,
-	0xe1, 0x12, 0x70, 0x46, 0x32, 0xd8, 0x33, 0x2f, 0x2d, 0x5f, 0x48, 0x99, 0x8b, 0x37, 0x37, 0xb5,
-	0x28, 0x3b, 0x27, 0x35, 0xbe, 0xa0, 0x28, 0x35, 0x2d, 0xb3, 0x42, 0x82, 0x51, 0x81, 0x59, 0x83,
-	0x27, 0x88, 0x07, 0x22, 0x18, 0x00, 0x16, 0x13, 0x92, 0xe6, 0xe2, 0x84, 0x58, 0x1a, 0x9f, 0x99,
-	0x22, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x01, 0x11, 0xf0, 0x4c, 0x51, 0xba, 0xcc, 0xc8,
-	0x25, 0xee, 0x5b, 0x9c, 0x1e, 0x94, 0x9a, 0x9e, 0x59, 0x5c, 0x92, 0x5a, 0x84, 0x6c, 0x03, 0xaa,
-	0x46, 0x46, 0x54, 0x8d, 0x42, 0x36, 0x5c, 0x52, 0xc8, 0xfe, 0x8c, 0x47, 0x75, 0x07, 0x13, 0xd8,
-	0x1d, 0x12, 0xc8, 0x2a, 0x7c, 0x91, 0xdd, 0x64, 0xc2, 0x25, 0x86, 0xa2, 0x1b, 0x61, 0x0f, 0x33,
-	0xd8, 0x1e, 0x11, 0x64, 0x59, 0x67, 0x98, 0x9d, 0x62, 0x5c, 0x6c, 0xc5, 0x99, 0xe9, 0x79, 0xa9,
-	0x45, 0x12, 0x2c, 0x60, 0x55, 0x50, 0x9e, 0x15, 0x7f, 0xc7, 0x02, 0x79, 0x86, 0xa6, 0xe7, 0x1b,
-	0xb4, 0xa0, 0x02, 0x4a, 0x8a, 0x5c, 0xf2, 0x38, 0x3c, 0x15, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0x6a, 0x34, 0x89, 0x91, 0x8b, 0x1f, 0x59, 0xc2, 0xb7, 0x38, 0x5d, 0xa8, 0x82, 0x4b, 0x04,
-	0x6b, 0x40, 0x68, 0xeb, 0x61, 0x46, 0x9f, 0x1e, 0x0e, 0x0b, 0xa4, 0x8c, 0x49, 0x50, 0x0c, 0x73,
-	0x8d, 0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x0f, 0x4d, 0x37, 0x99, 0x49, 0xc9, 0xba, 0xe9, 0xf9, 0xfa, 0x65, 0x96, 0xfa, 0xb9, 0xf9, 0x29,
-	0xa5, 0x39, 0xa9, 0xc5, 0x90, 0x74, 0x68, 0x60, 0xa4, 0x0b, 0x4d, 0x8a, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0xe0, 0xe4, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xda, 0x00, 0x13, 0xe2,
-	0xaa, 0x02, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0x66, 0x60, 0x41, 0x76, 0x16, 0xb3, 0x3a, 0x8b, 0x6c, 0x53, 0x63, 0xa9, 0x18, 0x13, 0xc4,
+	0xd0, 0x4a, 0xd7, 0x83, 0x1a, 0x4f, 0xcb, 0xc5, 0x3d, 0x90, 0x98, 0x46, 0x2f, 0x5e, 0x08, 0x94,
+	0x61, 0x98, 0x48, 0x3b, 0x75, 0x66, 0x20, 0xbb, 0x37, 0xe3, 0xc9, 0xa3, 0xff, 0x40, 0x7f, 0xc2,
+	0xfe, 0x8c, 0x3d, 0xae, 0x37, 0x4f, 0xc6, 0xc0, 0x61, 0x7f, 0x82, 0x27, 0x13, 0x43, 0x5b, 0x76,
+	0x4b, 0x5a, 0x0c, 0x7a, 0xf3, 0xd4, 0xe9, 0x7b, 0xdf, 0x9b, 0xef, 0x7d, 0xef, 0xbd, 0xc9, 0x83,
+	0xf7, 0x69, 0xdf, 0x31, 0x1d, 0xc6, 0xb1, 0xe9, 0x8c, 0x29, 0xf6, 0xa4, 0x39, 0xb5, 0x4c, 0x87,
+	0x4d, 0x3c, 0x89, 0xb9, 0xdf, 0xe3, 0xf2, 0xc4, 0xf0, 0x39, 0x93, 0x0c, 0x21, 0xda, 0x77, 0x8c,
+	0x05, 0xcc, 0x08, 0x61, 0xc6, 0xd4, 0x52, 0xf7, 0x1d, 0x26, 0x5c, 0x26, 0x4c, 0x57, 0x10, 0x73,
+	0xda, 0x5a, 0x7c, 0x42, 0xb0, 0x5a, 0x26, 0x8c, 0xb0, 0xe0, 0x68, 0x2e, 0x4e, 0x91, 0xb5, 0x9a,
+	0x60, 0x6a, 0x45, 0xa7, 0x10, 0x50, 0x7b, 0x05, 0x6f, 0xb4, 0x63, 0xcc, 0x47, 0xde, 0x90, 0xa1,
+	0x7b, 0xf0, 0xba, 0x8b, 0xf9, 0xdb, 0x31, 0xee, 0xfa, 0x1c, 0x0f, 0xe9, 0xb1, 0x02, 0xf4, 0x5c,
+	0xbd, 0x64, 0x97, 0x42, 0xe3, 0xcb, 0xc0, 0x86, 0x6e, 0xc3, 0xed, 0xf0, 0xa2, 0x2e, 0x1d, 0x28,
+	0x59, 0x1d, 0xd4, 0xb7, 0xed, 0x62, 0x68, 0x38, 0x1a, 0xd4, 0x3e, 0xe7, 0xe0, 0x7e, 0x47, 0x10,
+	0x1b, 0x13, 0x2a, 0x24, 0xe6, 0x71, 0x86, 0xd5, 0x40, 0xb0, 0x1a, 0x88, 0x9e, 0x43, 0x35, 0x5e,
+	0x88, 0xee, 0x6a, 0x1e, 0xd9, 0x20, 0x0f, 0x25, 0x8e, 0xe8, 0xc4, 0x73, 0x7a, 0x0c, 0x2b, 0x2b,
+	0xd1, 0x57, 0x3c, 0xb9, 0x80, 0xa7, 0x1c, 0xf7, 0xb6, 0x97, 0x9c, 0x15, 0x58, 0x10, 0x94, 0x78,
+	0x98, 0x2b, 0x5b, 0x01, 0x2a, 0xfa, 0x43, 0x4d, 0x88, 0x7c, 0xce, 0xd8, 0xb0, 0x1b, 0x8f, 0x52,
+	0xf2, 0x3a, 0xa8, 0x97, 0xec, 0x9b, 0x81, 0x67, 0x45, 0x57, 0x1b, 0x96, 0x42, 0xf8, 0x08, 0x53,
+	0x32, 0x92, 0x4a, 0x41, 0x07, 0xf5, 0x1d, 0x4b, 0x35, 0x12, 0x4d, 0x6c, 0x19, 0x2f, 0x02, 0xc4,
+	0xe1, 0xd6, 0xd9, 0xf7, 0x6a, 0xc6, 0xde, 0x09, 0xa2, 0x42, 0x13, 0x6a, 0xc1, 0x32, 0xc7, 0xef,
+	0x26, 0x94, 0xe3, 0xae, 0xc3, 0xbc, 0x21, 0xe5, 0x6e, 0x4f, 0x52, 0xe6, 0x29, 0xd7, 0x74, 0x50,
+	0x2f, 0xda, 0x7b, 0x91, 0xaf, 0x1d, 0x73, 0x25, 0xbb, 0x55, 0x4c, 0x76, 0xeb, 0xd9, 0xee, 0xc7,
+	0x2f, 0xd5, 0xcc, 0x87, 0x8b, 0xd3, 0x46, 0x24, 0xae, 0x76, 0x17, 0x56, 0xd7, 0x34, 0xc8, 0xc6,
+	0xc2, 0x67, 0x9e, 0xc0, 0xb5, 0xaf, 0x00, 0xde, 0xea, 0x08, 0xf2, 0xda, 0x1f, 0xf4, 0x24, 0xfe,
+	0x2f, 0x5b, 0x98, 0x94, 0x5d, 0x85, 0x77, 0x52, 0x25, 0x5d, 0x8a, 0xfe, 0x09, 0x60, 0xa5, 0x23,
+	0x48, 0x54, 0xe1, 0xcd, 0x55, 0xa7, 0x0f, 0x4b, 0x76, 0xd3, 0x61, 0xc9, 0xfd, 0xcb, 0xb0, 0x24,
+	0x3a, 0xbf, 0x95, 0xf2, 0x4e, 0xaf, 0x4a, 0x93, 0xff, 0x73, 0x69, 0x74, 0xa8, 0xa5, 0x0b, 0x5f,
+	0xd6, 0xc6, 0xfa, 0x95, 0x85, 0xbb, 0x71, 0x47, 0x47, 0x10, 0x74, 0x0c, 0xcb, 0xa9, 0xaf, 0xfc,
+	0x61, 0x52, 0x8a, 0x65, 0xac, 0x99, 0x38, 0xf5, 0xe0, 0x2f, 0xc0, 0xcb, 0x6c, 0xd0, 0x04, 0xee,
+	0xa5, 0x75, 0xa9, 0xb1, 0xe6, 0xae, 0x14, 0xac, 0x6a, 0x6d, 0x8e, 0xbd, 0xa4, 0xe5, 0x10, 0xa5,
+	0xbc, 0x88, 0x07, 0x6b, 0x6e, 0x4a, 0x42, 0xd5, 0xd6, 0xc6, 0xd0, 0x25, 0xa7, 0x9a, 0x7f, 0x7f,
+	0x71, 0xda, 0x00, 0x87, 0xf6, 0xd9, 0x4c, 0x03, 0xe7, 0x33, 0x0d, 0xfc, 0x98, 0x69, 0xe0, 0xd3,
+	0x5c, 0xcb, 0x9c, 0xcf, 0xb5, 0xcc, 0xb7, 0xb9, 0x96, 0x79, 0xf3, 0x84, 0x50, 0x39, 0x9a, 0xf4,
+	0x0d, 0x87, 0xb9, 0x66, 0xb4, 0x20, 0x68, 0xdf, 0x69, 0x12, 0x66, 0x4e, 0x9f, 0x9a, 0x2e, 0x1b,
+	0x4c, 0xc6, 0x58, 0x84, 0x6b, 0xe0, 0x91, 0xd5, 0x8c, 0x36, 0x81, 0x3c, 0xf1, 0xb1, 0xe8, 0x17,
+	0x82, 0x35, 0x70, 0xf0, 0x7b, 0x00, 0xb3, 0xf4, 0x38, 0x08, 0x93, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -219,6 +415,10 @@ const _ = grpc.SupportPackageIsVersion4
 type CounterpartyMsgClient interface {
 	// RegisterCounterparty defines a rpc handler method for MsgRegisterCounterparty.
 	RegisterCounterparty(ctx context.Context, in *MsgRegisterCounterparty, opts ...grpc.CallOption) (*MsgRegisterCounterpartyResponse, error)
+	// ConfirmCounterparty defines a rpc handler method for MsgConfirmCounterparty.
+	ConfirmCounterparty(ctx context.Context, in *MsgConfirmCounterparty, opts ...grpc.CallOption) (*MsgConfirmCounterpartyResponse, error)
+	// UpdateCounterparty defines a rpc handler method for MsgUpdateCounterparty.
+	UpdateCounterparty(ctx context.Context, in *MsgUpdateCounterparty, opts ...grpc.CallOption) (*MsgUpdateCounterpartyResponse, error)
 }
 
 type counterpartyMsgClient struct {
@@ -238,10 +438,32 @@ func (c *counterpartyMsgClient) RegisterCounterparty(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *counterpartyMsgClient) ConfirmCounterparty(ctx context.Context, in *MsgConfirmCounterparty, opts ...grpc.CallOption) (*MsgConfirmCounterpartyResponse, error) {
+	out := new(MsgConfirmCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v2.CounterpartyMsg/ConfirmCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *counterpartyMsgClient) UpdateCounterparty(ctx context.Context, in *MsgUpdateCounterparty, opts ...grpc.CallOption) (*MsgUpdateCounterpartyResponse, error) {
+	out := new(MsgUpdateCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v2.CounterpartyMsg/UpdateCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CounterpartyMsgServer is the server API for CounterpartyMsg service.
 type CounterpartyMsgServer interface {
 	// RegisterCounterparty defines a rpc handler method for MsgRegisterCounterparty.
 	RegisterCounterparty(context.Context, *MsgRegisterCounterparty) (*MsgRegisterCounterpartyResponse, error)
+	// ConfirmCounterparty defines a rpc handler method for MsgConfirmCounterparty.
+	ConfirmCounterparty(context.Context, *MsgConfirmCounterparty) (*MsgConfirmCounterpartyResponse, error)
+	// UpdateCounterparty defines a rpc handler method for MsgUpdateCounterparty.
+	UpdateCounterparty(context.Context, *MsgUpdateCounterparty) (*MsgUpdateCounterpartyResponse, error)
 }
 
 // UnimplementedCounterpartyMsgServer can be embedded to have forward compatible implementations.
@@ -251,6 +473,12 @@ type UnimplementedCounterpartyMsgServer struct {
 func (*UnimplementedCounterpartyMsgServer) RegisterCounterparty(ctx context.Context, req *MsgRegisterCounterparty) (*MsgRegisterCounterpartyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterCounterparty not implemented")
 }
+func (*UnimplementedCounterpartyMsgServer) ConfirmCounterparty(ctx context.Context, req *MsgConfirmCounterparty) (*MsgConfirmCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmCounterparty not implemented")
+}
+func (*UnimplementedCounterpartyMsgServer) UpdateCounterparty(ctx context.Context, req *MsgUpdateCounterparty) (*MsgUpdateCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCounterparty not implemented")
+}
 
 func RegisterCounterpartyMsgServer(s grpc1.Server, srv CounterpartyMsgServer) {
 	s.RegisterService(&_CounterpartyMsg_serviceDesc, srv)
@@ -274,6 +502,42 @@ func _CounterpartyMsg_RegisterCounterparty_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CounterpartyMsg_ConfirmCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmCounterparty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterpartyMsgServer).ConfirmCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v2.CounterpartyMsg/ConfirmCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterpartyMsgServer).ConfirmCounterparty(ctx, req.(*MsgConfirmCounterparty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CounterpartyMsg_UpdateCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCounterparty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterpartyMsgServer).UpdateCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v2.CounterpartyMsg/UpdateCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterpartyMsgServer).UpdateCounterparty(ctx, req.(*MsgUpdateCounterparty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CounterpartyMsg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v2.CounterpartyMsg",
 	HandlerType: (*CounterpartyMsgServer)(nil),
@@ -282,6 +546,14 @@ var _CounterpartyMsg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterCounterparty",
 			Handler:    _CounterpartyMsg_RegisterCounterparty_Handler,
 		},
+		{
+			MethodName: "ConfirmCounterparty",
+			Handler:    _CounterpartyMsg_ConfirmCounterparty_Handler,
+		},
+		{
+			MethodName: "UpdateCounterparty",
+			Handler:    _CounterpartyMsg_UpdateCounterparty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v2/counterparty.proto",
@@ -346,6 +618,42 @@ func (m *MsgRegisterCounterparty) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MerklePrefix) > 0 {
+		for iNdEx := len(m.MerklePrefix) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MerklePrefix[iNdEx])
+			copy(dAtA[i:], m.MerklePrefix[iNdEx])
+			i = encodeVarintCounterparty(dAtA, i, uint64(len(m.MerklePrefix[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RequireConfirmation {
+		i--
+		if m.RequireConfirmation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCounterparty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofCounterparty) > 0 {
+		i -= len(m.ProofCounterparty)
+		copy(dAtA[i:], m.ProofCounterparty)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.ProofCounterparty)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -402,79 +710,791 @@ func (m *MsgRegisterCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCounterparty(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCounterparty(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateCounterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CounterpartyInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateCounterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCounterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MerklePrefix) > 0 {
-		for _, b := range m.MerklePrefix {
-			l = len(b)
-			n += 1 + l + sovCounterparty(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyClientId) > 0 {
+		i -= len(m.CounterpartyClientId)
+		copy(dAtA[i:], m.CounterpartyClientId)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.CounterpartyClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyMerklePrefix) > 0 {
+		for iNdEx := len(m.CounterpartyMerklePrefix) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CounterpartyMerklePrefix[iNdEx])
+			copy(dAtA[i:], m.CounterpartyMerklePrefix[iNdEx])
+			i = encodeVarintCounterparty(dAtA, i, uint64(len(m.CounterpartyMerklePrefix[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovCounterparty(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterCounterparty) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovCounterparty(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmCounterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CounterpartyMerklePrefix) > 0 {
-		for _, b := range m.CounterpartyMerklePrefix {
-			l = len(b)
-			n += 1 + l + sovCounterparty(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmCounterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmCounterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MerklePrefix) > 0 {
+		for iNdEx := len(m.MerklePrefix) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MerklePrefix[iNdEx])
+			copy(dAtA[i:], m.MerklePrefix[iNdEx])
+			i = encodeVarintCounterparty(dAtA, i, uint64(len(m.MerklePrefix[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCounterparty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofCounterparty) > 0 {
+		i -= len(m.ProofCounterparty)
+		copy(dAtA[i:], m.ProofCounterparty)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.ProofCounterparty)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintCounterparty(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCounterparty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCounterparty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CounterpartyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MerklePrefix) > 0 {
+		for _, b := range m.MerklePrefix {
+			l = len(b)
+			n += 1 + l + sovCounterparty(uint64(l))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	if len(m.CounterpartyMerklePrefix) > 0 {
+		for _, b := range m.CounterpartyMerklePrefix {
+			l = len(b)
+			n += 1 + l + sovCounterparty(uint64(l))
+		}
+	}
+	l = len(m.CounterpartyClientId)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	l = len(m.ProofCounterparty)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovCounterparty(uint64(l))
+	if m.RequireConfirmation {
+		n += 2
+	}
+	if len(m.MerklePrefix) > 0 {
+		for _, b := range m.MerklePrefix {
+			l = len(b)
+			n += 1 + l + sovCounterparty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCounterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	if len(m.CounterpartyMerklePrefix) > 0 {
+		for _, b := range m.CounterpartyMerklePrefix {
+			l = len(b)
+			n += 1 + l + sovCounterparty(uint64(l))
+		}
+	}
+	l = len(m.CounterpartyClientId)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmCounterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	l = len(m.ProofCounterparty)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovCounterparty(uint64(l))
+	if len(m.MerklePrefix) > 0 {
+		for _, b := range m.MerklePrefix {
+			l = len(b)
+			n += 1 + l + sovCounterparty(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCounterparty(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovCounterparty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCounterparty(x uint64) (n int) {
+	return sovCounterparty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CounterpartyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounterparty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerklePrefix = append(m.MerklePrefix, make([]byte, postIndex-iNdEx))
+			copy(m.MerklePrefix[len(m.MerklePrefix)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounterparty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounterparty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyMerklePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyMerklePrefix = append(m.CounterpartyMerklePrefix, make([]byte, postIndex-iNdEx))
+			copy(m.CounterpartyMerklePrefix[len(m.CounterpartyMerklePrefix)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCounterparty", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCounterparty = append(m.ProofCounterparty[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCounterparty == nil {
+				m.ProofCounterparty = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireConfirmation = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerklePrefix = append(m.MerklePrefix, make([]byte, postIndex-iNdEx))
+			copy(m.MerklePrefix[len(m.MerklePrefix)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounterparty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.CounterpartyClientId)
-	if l > 0 {
-		n += 1 + l + sovCounterparty(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovCounterparty(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgRegisterCounterpartyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRegisterCounterpartyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounterparty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounterparty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCounterparty(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCounterparty(x uint64) (n int) {
-	return sovCounterparty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CounterpartyInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCounterparty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -497,15 +1517,47 @@ func (m *CounterpartyInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CounterpartyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCounterparty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CounterpartyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCounterparty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerklePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyMerklePrefix", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -532,12 +1584,12 @@ func (m *CounterpartyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerklePrefix = append(m.MerklePrefix, make([]byte, postIndex-iNdEx))
-			copy(m.MerklePrefix[len(m.MerklePrefix)-1], dAtA[iNdEx:postIndex])
+			m.CounterpartyMerklePrefix = append(m.CounterpartyMerklePrefix, make([]byte, postIndex-iNdEx))
+			copy(m.CounterpartyMerklePrefix[len(m.CounterpartyMerklePrefix)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -565,7 +1617,39 @@ func (m *CounterpartyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -588,7 +1672,7 @@ func (m *CounterpartyInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCounterpartyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -611,10 +1695,60 @@ func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterparty: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCounterpartyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounterparty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmCounterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounterparty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmCounterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmCounterparty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -651,7 +1785,7 @@ func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyMerklePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCounterparty", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -678,14 +1812,16 @@ func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyMerklePrefix = append(m.CounterpartyMerklePrefix, make([]byte, postIndex-iNdEx))
-			copy(m.CounterpartyMerklePrefix[len(m.CounterpartyMerklePrefix)-1], dAtA[iNdEx:postIndex])
+			m.ProofCounterparty = append(m.ProofCounterparty[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCounterparty == nil {
+				m.ProofCounterparty = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCounterparty
@@ -695,25 +1831,58 @@ func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCounterparty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCounterparty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounterparty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounterparty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerklePrefix = append(m.MerklePrefix, make([]byte, postIndex-iNdEx))
+			copy(m.MerklePrefix[len(m.MerklePrefix)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -766,7 +1935,7 @@ func (m *MsgRegisterCounterparty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmCounterpartyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,10 +1958,10 @@ func (m *MsgRegisterCounterpartyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmCounterpartyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8,14 +8,17 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID          = "client_id"
-	AttributeKeySubjectClientID   = "subject_client_id"
-	AttributeKeyClientType        = "client_type"
-	AttributeKeyConsensusHeight   = "consensus_height"
-	AttributeKeyConsensusHeights  = "consensus_heights"
-	AttributeKeyUpgradeStore      = "upgrade_store"
-	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
-	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyClientID                 = "client_id"
+	AttributeKeySubjectClientID          = "subject_client_id"
+	AttributeKeyClientType               = "client_type"
+	AttributeKeyConsensusHeight          = "consensus_height"
+	AttributeKeyConsensusHeights         = "consensus_heights"
+	AttributeKeyUpgradeStore             = "upgrade_store"
+	AttributeKeyUpgradePlanHeight        = "upgrade_plan_height"
+	AttributeKeyUpgradePlanTitle         = "title"
+	AttributeKeyCounterpartyClientID     = "counterparty_client_id"
+	AttributeKeyCounterpartyMerklePrefix = "counterparty_merkle_prefix"
+	AttributeKeyCounterpartyPending      = "counterparty_pending"
 )
 
 // IBC client events vars
@@ -27,6 +30,9 @@ var (
 	EventTypeRecoverClient              = "recover_client"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypeRegisterCounterparty       = "register_counterparty"
+	EventTypeUpdateCounterparty         = "update_counterparty"
+	EventTypeConfirmCounterparty        = "confirm_counterparty"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
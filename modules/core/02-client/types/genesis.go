@@ -70,12 +70,13 @@ func (ccs ClientConsensusStates) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	clients []IdentifiedClientState, clientsConsensus ClientsConsensusStates, clientsMetadata []IdentifiedGenesisMetadata,
-	params Params, createLocalhost bool, nextClientSequence uint64,
+	counterpartyInfos []IdentifiedCounterpartyInfo, params Params, createLocalhost bool, nextClientSequence uint64,
 ) GenesisState {
 	return GenesisState{
 		Clients:            clients,
 		ClientsConsensus:   clientsConsensus,
 		ClientsMetadata:    clientsMetadata,
+		CounterpartyInfos:  counterpartyInfos,
 		Params:             params,
 		CreateLocalhost:    createLocalhost,
 		NextClientSequence: nextClientSequence,
@@ -87,6 +88,7 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Clients:            []IdentifiedClientState{},
 		ClientsConsensus:   ClientsConsensusStates{},
+		CounterpartyInfos:  []IdentifiedCounterpartyInfo{},
 		Params:             DefaultParams(),
 		CreateLocalhost:    false,
 		NextClientSequence: 0,
@@ -199,6 +201,24 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	seenCounterparties := make(map[string]bool)
+	for i, ci := range gs.CounterpartyInfos {
+		// check that counterparty info is for a client in the genesis clients list
+		if _, ok := validClients[ci.ClientId]; !ok {
+			return fmt.Errorf("counterparty info in genesis has a client id %s that does not map to a genesis client", ci.ClientId)
+		}
+
+		if seenCounterparties[ci.ClientId] {
+			return fmt.Errorf("duplicate counterparty info for client id %s", ci.ClientId)
+		}
+
+		if err := ci.CounterpartyInfo.Validate(); err != nil {
+			return fmt.Errorf("invalid counterparty info %v clientID %s index %d: %w", ci.CounterpartyInfo, ci.ClientId, i, err)
+		}
+
+		seenCounterparties[ci.ClientId] = true
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
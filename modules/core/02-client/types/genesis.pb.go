@@ -37,6 +37,8 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty"` // Deprecated: Do not use.
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	// counterparty info registered for each client
+	CounterpartyInfos []IdentifiedCounterpartyInfo `protobuf:"bytes,7,rep,name=counterparty_infos,json=counterpartyInfos,proto3" json:"counterparty_infos"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +117,13 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetCounterpartyInfos() []IdentifiedCounterpartyInfo {
+	if m != nil {
+		return m.CounterpartyInfos
+	}
+	return nil
+}
+
 // GenesisMetadata defines the genesis type for metadata that will be used
 // to export all client store keys that are not client or consensus states.
 type GenesisMetadata struct {
@@ -211,46 +220,116 @@ func (m *IdentifiedGenesisMetadata) GetClientMetadata() []GenesisMetadata {
 	return nil
 }
 
+// IdentifiedCounterpartyInfo has the counterparty info registered for a client
+// with the corresponding client id.
+type IdentifiedCounterpartyInfo struct {
+	ClientId         string           `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CounterpartyInfo CounterpartyInfo `protobuf:"bytes,2,opt,name=counterparty_info,json=counterpartyInfo,proto3" json:"counterparty_info"`
+	// whether the counterparty registration is pending confirmation
+	Pending bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *IdentifiedCounterpartyInfo) Reset()         { *m = IdentifiedCounterpartyInfo{} }
+func (m *IdentifiedCounterpartyInfo) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedCounterpartyInfo) ProtoMessage()    {}
+func (*IdentifiedCounterpartyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{3}
+}
+func (m *IdentifiedCounterpartyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedCounterpartyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedCounterpartyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedCounterpartyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedCounterpartyInfo.Merge(m, src)
+}
+func (m *IdentifiedCounterpartyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedCounterpartyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedCounterpartyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedCounterpartyInfo proto.InternalMessageInfo
+
+func (m *IdentifiedCounterpartyInfo) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedCounterpartyInfo) GetCounterpartyInfo() CounterpartyInfo {
+	if m != nil {
+		return m.CounterpartyInfo
+	}
+	return CounterpartyInfo{}
+}
+
+func (m *IdentifiedCounterpartyInfo) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.client.v1.GenesisState")
 	proto.RegisterType((*GenesisMetadata)(nil), "ibc.core.client.v1.GenesisMetadata")
 	proto.RegisterType((*IdentifiedGenesisMetadata)(nil), "ibc.core.client.v1.IdentifiedGenesisMetadata")
+	proto.RegisterType((*IdentifiedCounterpartyInfo)(nil), "ibc.core.client.v1.IdentifiedCounterpartyInfo")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x69, 0x68, 0xaf, 0x15, 0x09, 0xa7, 0x08, 0x99, 0x20, 0x39, 0x56, 0x58, 0xcc,
-	0x10, 0xbb, 0x0d, 0x4b, 0x61, 0x41, 0x4a, 0x07, 0x54, 0x09, 0x24, 0x64, 0x36, 0x06, 0x2c, 0xfb,
-	0xfc, 0xe1, 0x5a, 0xd8, 0xbe, 0x90, 0x3b, 0x5b, 0xf4, 0x1f, 0x30, 0x30, 0xf0, 0x13, 0x98, 0xf9,
-	0x25, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x24, 0x7e, 0x07, 0xf2, 0xdd, 0x99, 0x4a, 0xc1, 0xe9, 0xf6,
-	0xe5, 0xbd, 0xf7, 0xbd, 0x97, 0x7b, 0xd6, 0x87, 0xed, 0x34, 0xa2, 0x1e, 0x65, 0x2b, 0xf0, 0x68,
-	0x96, 0x42, 0x21, 0xbc, 0xea, 0xc4, 0x4b, 0xa0, 0x00, 0x9e, 0x72, 0x77, 0xb9, 0x62, 0x82, 0x11,
-	0x92, 0x46, 0xd4, 0xad, 0x15, 0xae, 0x52, 0xb8, 0xd5, 0xc9, 0x78, 0xd2, 0xb2, 0xa5, 0x59, 0xb9,
-	0x34, 0x1e, 0x25, 0x2c, 0x61, 0x72, 0xf4, 0xea, 0x49, 0xa1, 0xd3, 0x3f, 0x5d, 0x7c, 0xf4, 0x42,
-	0x99, 0xbf, 0x11, 0xa1, 0x00, 0x42, 0xf1, 0x1d, 0xb5, 0xc6, 0x4d, 0x64, 0x77, 0x9d, 0xc3, 0xf9,
-	0x63, 0xf7, 0xff, 0x34, 0xf7, 0x3c, 0x86, 0x42, 0xa4, 0xef, 0x53, 0x88, 0xcf, 0x24, 0x26, 0x77,
-	0x17, 0xd6, 0xd5, 0xcf, 0x89, 0xf1, 0xfd, 0xd7, 0xe4, 0x7e, 0x2b, 0xcd, 0xfd, 0xc6, 0x99, 0x54,
-	0xf8, 0x9e, 0x1e, 0x03, 0xca, 0x0a, 0x0e, 0x05, 0x2f, 0xb9, 0xd9, 0xd9, 0x1d, 0xa7, 0x5c, 0xce,
-	0x1a, 0xa9, 0xb2, 0xbb, 0x89, 0x53, 0x34, 0xdf, 0xe2, 0xfd, 0x21, 0xdd, 0xc2, 0xc9, 0x3b, 0xdc,
-	0x60, 0x41, 0x0e, 0x22, 0x8c, 0x43, 0x11, 0x9a, 0x5d, 0x19, 0x3b, 0xbb, 0xfd, 0x95, 0xba, 0xa2,
-	0x57, 0x7a, 0x69, 0xd1, 0xab, 0xa3, 0xfd, 0x81, 0x36, 0x6b, 0x60, 0x72, 0x8a, 0xfb, 0xcb, 0x70,
-	0x15, 0xe6, 0xdc, 0xec, 0xd9, 0xc8, 0x39, 0x9c, 0x8f, 0xdb, 0x5c, 0x5f, 0x4b, 0x85, 0xb6, 0xd0,
-	0x7a, 0x32, 0xc3, 0x43, 0xba, 0x82, 0x50, 0x40, 0x90, 0x31, 0x1a, 0x66, 0x17, 0x8c, 0x0b, 0x73,
-	0xcf, 0x46, 0xce, 0xfe, 0xa2, 0x63, 0x22, 0x7f, 0xa0, 0xb8, 0x97, 0x0d, 0x45, 0x8e, 0xf1, 0xa8,
-	0x80, 0x4f, 0x22, 0x50, 0xae, 0x01, 0x87, 0x8f, 0x25, 0x14, 0x14, 0xcc, 0xbe, 0x8d, 0x9c, 0x9e,
-	0x4f, 0x6a, 0x4e, 0x37, 0xaf, 0x99, 0xe9, 0x73, 0x3c, 0xd8, 0x7a, 0x04, 0x19, 0xe2, 0xee, 0x07,
-	0xb8, 0x34, 0x91, 0x8d, 0x9c, 0x23, 0xbf, 0x1e, 0xc9, 0x08, 0xef, 0x55, 0x61, 0x56, 0x82, 0xd9,
-	0x91, 0x98, 0xfa, 0xf1, 0xac, 0xf7, 0xf9, 0xdb, 0xc4, 0x98, 0x7e, 0x41, 0xf8, 0xc1, 0xce, 0x42,
-	0xc8, 0x43, 0x7c, 0xa0, 0xff, 0x4b, 0x1a, 0x4b, 0xc7, 0x03, 0x7f, 0x5f, 0x01, 0xe7, 0x31, 0xf1,
-	0xb1, 0x6e, 0xea, 0xa6, 0x75, 0xf5, 0xb1, 0x1f, 0xb5, 0xf5, 0xd3, 0xde, 0xf5, 0x5d, 0x25, 0xf8,
-	0x87, 0xfa, 0x57, 0x6b, 0x0b, 0x5d, 0xaf, 0x2d, 0xf4, 0x7b, 0x6d, 0xa1, 0xaf, 0x1b, 0xcb, 0xb8,
-	0xde, 0x58, 0xc6, 0x8f, 0x8d, 0x65, 0xbc, 0x3d, 0x4d, 0x52, 0x71, 0x51, 0x46, 0x2e, 0x65, 0xb9,
-	0x47, 0x19, 0xcf, 0x19, 0xf7, 0xd2, 0x88, 0xce, 0x12, 0xe6, 0x55, 0x4f, 0xbd, 0x9c, 0xc5, 0x65,
-	0x06, 0x5c, 0x5d, 0xca, 0xf1, 0x7c, 0xa6, 0x8f, 0x45, 0x5c, 0x2e, 0x81, 0x47, 0x7d, 0x79, 0x13,
-	0x4f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x27, 0x7e, 0x06, 0xb7, 0x82, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xad, 0xd7, 0x6e, 0xdd, 0xdc, 0x89, 0xb6, 0x56, 0x85, 0x4c, 0x91, 0xd2, 0xa8, 0x80, 0x54,
+	0x0e, 0x4d, 0xb6, 0x70, 0x19, 0x5c, 0x90, 0xba, 0x03, 0xaa, 0x04, 0x12, 0x0a, 0x07, 0x24, 0x0e,
+	0x44, 0xa9, 0xe3, 0x66, 0x11, 0xad, 0x5d, 0x62, 0xa7, 0xa2, 0xdf, 0x80, 0x03, 0x07, 0x3e, 0x02,
+	0x67, 0xc4, 0x07, 0x99, 0xc4, 0x65, 0x47, 0x4e, 0x80, 0xda, 0x2f, 0x82, 0x62, 0x3b, 0xda, 0xd4,
+	0x3f, 0xe3, 0x66, 0xbf, 0xf7, 0x7e, 0xcf, 0xbf, 0xdf, 0xb3, 0x65, 0x68, 0x27, 0x23, 0xe2, 0x12,
+	0x9e, 0x52, 0x97, 0x4c, 0x12, 0xca, 0xa4, 0x3b, 0x3f, 0x75, 0x63, 0xca, 0xa8, 0x48, 0x84, 0x33,
+	0x4b, 0xb9, 0xe4, 0x08, 0x25, 0x23, 0xe2, 0xe4, 0x0a, 0x47, 0x2b, 0x9c, 0xf9, 0x69, 0xbb, 0xb3,
+	0xa5, 0xca, 0xb0, 0xaa, 0xa8, 0xfd, 0x68, 0x43, 0xe0, 0xb9, 0x84, 0x67, 0x4c, 0xd2, 0x74, 0x16,
+	0xa6, 0x72, 0x61, 0x64, 0xad, 0x98, 0xc7, 0x5c, 0x2d, 0xdd, 0x7c, 0xa5, 0xd1, 0xee, 0xcf, 0x0a,
+	0x3c, 0x7e, 0xa1, 0x7b, 0x78, 0x23, 0x43, 0x49, 0x11, 0x81, 0x55, 0x6d, 0x23, 0x30, 0xb0, 0xcb,
+	0xbd, 0x9a, 0xf7, 0xd8, 0xd9, 0x6c, 0xca, 0x19, 0x46, 0x94, 0xc9, 0x64, 0x9c, 0xd0, 0xe8, 0x5c,
+	0x61, 0xaa, 0x76, 0x60, 0x5d, 0xfe, 0xee, 0x94, 0xbe, 0xff, 0xe9, 0xdc, 0xdd, 0x4a, 0x0b, 0xbf,
+	0x70, 0x46, 0x73, 0xd8, 0x34, 0xcb, 0x80, 0x70, 0x26, 0x28, 0x13, 0x99, 0xc0, 0x7b, 0xbb, 0x8f,
+	0xd3, 0x2e, 0xe7, 0x85, 0x54, 0xdb, 0x5d, 0x1f, 0xa7, 0x69, 0xb1, 0xc6, 0xfb, 0x0d, 0xb2, 0x86,
+	0xa3, 0xf7, 0xb0, 0xc0, 0x82, 0x29, 0x95, 0x61, 0x14, 0xca, 0x10, 0x97, 0xd5, 0xb1, 0xfd, 0xdb,
+	0xa7, 0x34, 0x11, 0xbd, 0x32, 0x45, 0x83, 0x4a, 0x7e, 0xb4, 0x5f, 0x37, 0x66, 0x05, 0x8c, 0xce,
+	0xe0, 0xc1, 0x2c, 0x4c, 0xc3, 0xa9, 0xc0, 0x15, 0x1b, 0xf4, 0x6a, 0x5e, 0x7b, 0x9b, 0xeb, 0x6b,
+	0xa5, 0x30, 0x16, 0x46, 0x8f, 0xfa, 0xb0, 0x41, 0x52, 0x1a, 0x4a, 0x1a, 0x4c, 0x38, 0x09, 0x27,
+	0x17, 0x5c, 0x48, 0xbc, 0x6f, 0x83, 0xde, 0xe1, 0x60, 0x0f, 0x03, 0xbf, 0xae, 0xb9, 0x97, 0x05,
+	0x85, 0x4e, 0x60, 0x8b, 0xd1, 0x4f, 0x32, 0xd0, 0xae, 0x81, 0xa0, 0x1f, 0x33, 0xca, 0x08, 0xc5,
+	0x07, 0x36, 0xe8, 0x55, 0x7c, 0x94, 0x73, 0x26, 0x79, 0xc3, 0x20, 0x02, 0xd1, 0xcd, 0x47, 0x11,
+	0x24, 0x6c, 0xcc, 0x05, 0xae, 0xaa, 0xe1, 0x9d, 0xff, 0x5c, 0xf1, 0x8d, 0xba, 0x21, 0x1b, 0x73,
+	0xd3, 0x7a, 0x93, 0xac, 0xe1, 0xa2, 0xfb, 0x1c, 0xd6, 0xd7, 0x92, 0x42, 0x0d, 0x58, 0xfe, 0x40,
+	0x17, 0x18, 0xd8, 0xa0, 0x77, 0xec, 0xe7, 0x4b, 0xd4, 0x82, 0xfb, 0xf3, 0x70, 0x92, 0x51, 0xbc,
+	0xa7, 0x30, 0xbd, 0x79, 0x56, 0xf9, 0xfc, 0xad, 0x53, 0xea, 0x7e, 0x01, 0xf0, 0xde, 0xce, 0xd4,
+	0xd1, 0x7d, 0x78, 0x64, 0x06, 0x4e, 0x22, 0xe5, 0x78, 0xe4, 0x1f, 0x6a, 0x60, 0x18, 0x21, 0x1f,
+	0x9a, 0xeb, 0xb8, 0xbe, 0x5a, 0xfd, 0xa2, 0x1e, 0x6c, 0x9b, 0x6e, 0xfb, 0x85, 0xde, 0xd1, 0x82,
+	0x02, 0xed, 0xfe, 0x00, 0xb0, 0xbd, 0x3b, 0x87, 0xdb, 0xfb, 0x79, 0x0b, 0x9b, 0x1b, 0x81, 0xab,
+	0x91, 0x6b, 0xde, 0xc3, 0xcd, 0x8e, 0x3c, 0x67, 0x47, 0xca, 0x8d, 0xf5, 0x94, 0x11, 0x86, 0xd5,
+	0x19, 0x65, 0x51, 0xc2, 0x62, 0x5c, 0xce, 0x5f, 0x88, 0x5f, 0x6c, 0x07, 0xfe, 0xe5, 0xd2, 0x02,
+	0x57, 0x4b, 0x0b, 0xfc, 0x5d, 0x5a, 0xe0, 0xeb, 0xca, 0x2a, 0x5d, 0xad, 0xac, 0xd2, 0xaf, 0x95,
+	0x55, 0x7a, 0x77, 0x16, 0x27, 0xf2, 0x22, 0x1b, 0x39, 0x84, 0x4f, 0x5d, 0xc2, 0xc5, 0x94, 0x0b,
+	0x37, 0x19, 0x91, 0x7e, 0xcc, 0xdd, 0xf9, 0x53, 0x77, 0xca, 0xa3, 0x6c, 0x42, 0x85, 0xfe, 0x43,
+	0x4e, 0xbc, 0xbe, 0xf9, 0x46, 0xe4, 0x62, 0x46, 0xc5, 0xe8, 0x40, 0xfd, 0x13, 0x4f, 0xfe, 0x0d,
+	0x00, 0xb7, 0x0c, 0x8f, 0x3a, 0xbd, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -273,6 +352,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CounterpartyInfos) > 0 {
+		for iNdEx := len(m.CounterpartyInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -424,6 +517,56 @@ func (m *IdentifiedGenesisMetadata) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedCounterpartyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedCounterpartyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedCounterpartyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.CounterpartyInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -467,6 +610,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.CounterpartyInfos) > 0 {
+		for _, e := range m.CounterpartyInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -506,6 +655,24 @@ func (m *IdentifiedGenesisMetadata) Size() (n int) {
 	return n
 }
 
+func (m *IdentifiedCounterpartyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.CounterpartyInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -715,6 +882,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyInfos = append(m.CounterpartyInfos, IdentifiedCounterpartyInfo{})
+			if err := m.CounterpartyInfos[len(m.CounterpartyInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -970,6 +1171,141 @@ func (m *IdentifiedGenesisMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IdentifiedCounterpartyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedCounterpartyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedCounterpartyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
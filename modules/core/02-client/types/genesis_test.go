@@ -100,6 +100,9 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 						},
 					),
 				},
+				[]types.IdentifiedCounterpartyInfo{
+					types.NewIdentifiedCounterpartyInfo(tmClientID0, types.NewCounterpartyInfo([][]byte{[]byte("ibc"), []byte("")}, tmClientID1)),
+				},
 				types.NewParams(exported.Tendermint),
 				false,
 				2,
@@ -117,6 +120,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 				},
 				nil,
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -145,6 +149,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -173,6 +178,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -201,6 +207,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -229,6 +236,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -257,6 +265,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Solomachine),
 				false,
 				0,
@@ -293,6 +302,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 						},
 					),
 				},
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -329,12 +339,71 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 						},
 					),
 				},
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
 			),
 			expError: errors.New("invalid client metadata"),
 		},
+		{
+			name: "counterparty info client-id does not match a genesis client",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						clientID, ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+					),
+				},
+				nil,
+				nil,
+				[]types.IdentifiedCounterpartyInfo{
+					types.NewIdentifiedCounterpartyInfo("wrongclientid", types.NewCounterpartyInfo([][]byte{[]byte("ibc")}, tmClientID1)),
+				},
+				types.NewParams(exported.Tendermint),
+				false,
+				0,
+			),
+			expError: errors.New("counterparty info in genesis has a client id wrongclientid that does not map to a genesis client"),
+		},
+		{
+			name: "duplicate counterparty info",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						clientID, ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+					),
+				},
+				nil,
+				nil,
+				[]types.IdentifiedCounterpartyInfo{
+					types.NewIdentifiedCounterpartyInfo(clientID, types.NewCounterpartyInfo([][]byte{[]byte("ibc")}, tmClientID1)),
+					types.NewIdentifiedCounterpartyInfo(clientID, types.NewCounterpartyInfo([][]byte{[]byte("ibc")}, tmClientID1)),
+				},
+				types.NewParams(exported.Tendermint),
+				false,
+				0,
+			),
+			expError: errors.New("duplicate counterparty info for client id"),
+		},
+		{
+			name: "invalid counterparty info",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						clientID, ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+					),
+				},
+				nil,
+				nil,
+				[]types.IdentifiedCounterpartyInfo{
+					types.NewIdentifiedCounterpartyInfo(clientID, types.NewCounterpartyInfo(nil, tmClientID1)),
+				},
+				types.NewParams(exported.Tendermint),
+				false,
+				0,
+			),
+			expError: errors.New("invalid counterparty info"),
+		},
 		{
 			name: "invalid params",
 			genState: types.NewGenesisState(
@@ -357,6 +426,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(" "),
 				false,
 				0,
@@ -385,6 +455,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(" "),
 				false,
 				0,
@@ -416,6 +487,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				0,
@@ -444,6 +516,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				5,
@@ -468,6 +541,7 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.NewParams(exported.Tendermint),
 				false,
 				5,
@@ -26,6 +26,10 @@ const (
 	// the keeper.
 	KeyNextClientSequence = "nextClientSequence"
 
+	// KeyPruneConsensusStatesCursor is the key used to store the identifier of the client from which the
+	// pruning of expired consensus states resumes in the next block.
+	KeyPruneConsensusStatesCursor = "pruneConsensusStatesCursor"
+
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
 
@@ -35,6 +39,9 @@ const (
 	// KeyCounterparty is the key for the counterpartyInfo in the client-specific store
 	KeyCounterparty = "counterparty"
 
+	// KeyCounterpartyPending is the key for the pending confirmation marker of the counterparty in the client-specific store
+	KeyCounterpartyPending = "counterpartyPending"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
@@ -107,3 +114,9 @@ func CreatorKey() []byte {
 func CounterpartyKey() []byte {
 	return []byte(KeyCounterparty)
 }
+
+// CounterpartyPendingKey returns the key under which the pending confirmation marker of the counterparty is
+// stored in the client store
+func CounterpartyPendingKey() []byte {
+	return []byte(KeyCounterpartyPending)
+}
@@ -7,6 +7,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
@@ -22,6 +23,8 @@ var (
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
 
 	_ sdk.Msg = (*MsgRegisterCounterparty)(nil)
+	_ sdk.Msg = (*MsgUpdateCounterparty)(nil)
+	_ sdk.Msg = (*MsgConfirmCounterparty)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
@@ -31,6 +34,8 @@ var (
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterparty)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCounterparty)(nil)
+	_ sdk.HasValidateBasic = (*MsgConfirmCounterparty)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
@@ -343,5 +348,56 @@ func (msg *MsgRegisterCounterparty) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return err
 	}
+	if len(msg.ProofCounterparty) != 0 && msg.ProofHeight.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidHeight, "proof height must be non-zero if a counterparty proof is provided")
+	}
 	return host.ClientIdentifierValidator(msg.CounterpartyClientId)
 }
+
+// NewMsgUpdateCounterparty creates a new instance of MsgUpdateCounterparty.
+func NewMsgUpdateCounterparty(clientID string, merklePrefix [][]byte, counterpartyClientID string, signer string) *MsgUpdateCounterparty {
+	return &MsgUpdateCounterparty{
+		ClientId:                 clientID,
+		CounterpartyMerklePrefix: merklePrefix,
+		CounterpartyClientId:     counterpartyClientID,
+		Signer:                   signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateCounterparty.
+func (msg *MsgUpdateCounterparty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.CounterpartyMerklePrefix) == 0 {
+		return errorsmod.Wrap(ErrInvalidCounterparty, "counterparty messaging key cannot be empty")
+	}
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+	return host.ClientIdentifierValidator(msg.CounterpartyClientId)
+}
+
+// NewMsgConfirmCounterparty creates a new instance of MsgConfirmCounterparty.
+func NewMsgConfirmCounterparty(clientID string, proofCounterparty []byte, proofHeight Height, signer string) *MsgConfirmCounterparty {
+	return &MsgConfirmCounterparty{
+		ClientId:          clientID,
+		ProofCounterparty: proofCounterparty,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgConfirmCounterparty.
+func (msg *MsgConfirmCounterparty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.ProofCounterparty) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "counterparty proof cannot be empty")
+	}
+	if msg.ProofHeight.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
@@ -1036,6 +1036,137 @@ func TestMsgRegisterCounterpartyValidateBasic(t *testing.T) {
 			),
 			ibcerrors.ErrInvalidAddress,
 		},
+		{
+			"failure: counterparty proof with zero proof height",
+			func() *types.MsgRegisterCounterparty {
+				msg := types.NewMsgRegisterCounterparty(
+					"testclientid",
+					[][]byte{[]byte("ibc"), []byte("channel-9")},
+					"testclientid3",
+					signer,
+				)
+				msg.ProofCounterparty = []byte("proof")
+				return msg
+			}(),
+			ibcerrors.ErrInvalidHeight,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCounterpartyValidateBasic(t *testing.T) {
+	signer := ibctesting.TestAccAddress
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateCounterparty
+		expError error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateCounterparty(
+				"testclientid",
+				[][]byte{[]byte("ibc"), []byte("channel-9")},
+				"testclientid3",
+				signer,
+			),
+			nil,
+		},
+		{
+			"failure: empty client id",
+			types.NewMsgUpdateCounterparty(
+				"",
+				[][]byte{[]byte("ibc"), []byte("channel-9")},
+				"testclientid3",
+				signer,
+			),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty counterparty client id",
+			types.NewMsgUpdateCounterparty(
+				"testclientid",
+				[][]byte{[]byte("ibc"), []byte("channel-9")},
+				"",
+				signer,
+			),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty counterparty messaging key",
+			types.NewMsgUpdateCounterparty(
+				"testclientid",
+				[][]byte{},
+				"testclientid3",
+				signer,
+			),
+			types.ErrInvalidCounterparty,
+		},
+		{
+			"failure: empty signer",
+			types.NewMsgUpdateCounterparty(
+				"testclientid",
+				[][]byte{[]byte("ibc"), []byte("channel-9")},
+				"testclientid3",
+				"badsigner",
+			),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgConfirmCounterpartyValidateBasic(t *testing.T) {
+	signer := ibctesting.TestAccAddress
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConfirmCounterparty
+		expError error
+	}{
+		{
+			"success",
+			types.NewMsgConfirmCounterparty("testclientid", []byte("proof"), types.NewHeight(1, 1), signer),
+			nil,
+		},
+		{
+			"failure: empty client id",
+			types.NewMsgConfirmCounterparty("", []byte("proof"), types.NewHeight(1, 1), signer),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty proof",
+			types.NewMsgConfirmCounterparty("testclientid", nil, types.NewHeight(1, 1), signer),
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: zero proof height",
+			types.NewMsgConfirmCounterparty("testclientid", []byte("proof"), types.ZeroHeight(), signer),
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: empty signer",
+			types.NewMsgConfirmCounterparty("testclientid", []byte("proof"), types.NewHeight(1, 1), "badsigner"),
+			ibcerrors.ErrInvalidAddress,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -9,6 +9,9 @@ import (
 // Maximum length of the allowed clients list
 const MaxAllowedClientsLength = 200
 
+// Maximum number of expired consensus states pruned per block
+const MaxPrunedConsensusStatesPerBlockLimit = 1000
+
 // DefaultAllowedClients are the default clients for the AllowedClients parameter.
 // By default it allows all client types.
 var DefaultAllowedClients = []string{AllowAllClients}
@@ -27,6 +30,10 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
+	if p.MaxPrunedConsensusStatesPerBlock > MaxPrunedConsensusStatesPerBlockLimit {
+		return fmt.Errorf("max pruned consensus states per block must not exceed %d, got %d", MaxPrunedConsensusStatesPerBlockLimit, p.MaxPrunedConsensusStatesPerBlock)
+	}
+
 	return validateClients(p.AllowedClients)
 }
 
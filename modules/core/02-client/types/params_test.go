@@ -42,6 +42,8 @@ func TestValidateParams(t *testing.T) {
 		{"duplicate clients", NewParams(exported.Tendermint, exported.Tendermint), errors.New("duplicate client type: 07-tendermint")},
 		{"allow all clients plus valid client", NewParams(AllowAllClients, exported.Tendermint), errors.New("allow list must have only one element because the allow all clients wildcard (*) is present")},
 		{"too many allowed clients", NewParams(make([]string, MaxAllowedClientsLength+1)...), errors.New("allowed clients length must not exceed 200 items")},
+		{"max pruned consensus states per block at limit", Params{AllowedClients: DefaultAllowedClients, MaxPrunedConsensusStatesPerBlock: MaxPrunedConsensusStatesPerBlockLimit}, nil},
+		{"max pruned consensus states per block exceeds limit", Params{AllowedClients: DefaultAllowedClients, MaxPrunedConsensusStatesPerBlock: MaxPrunedConsensusStatesPerBlockLimit + 1}, errors.New("max pruned consensus states per block must not exceed 1000, got 1001")},
 	}
 
 	for _, tc := range testCases {
@@ -1107,6 +1107,108 @@ func (m *QueryVerifyMembershipResponse) GetSuccess() bool {
 	return false
 }
 
+// QueryClientCounterpartyRequest is the request type for the Query/ClientCounterparty RPC method
+type QueryClientCounterpartyRequest struct {
+	// client state unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryClientCounterpartyRequest) Reset()         { *m = QueryClientCounterpartyRequest{} }
+func (m *QueryClientCounterpartyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientCounterpartyRequest) ProtoMessage()    {}
+func (*QueryClientCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{20}
+}
+func (m *QueryClientCounterpartyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientCounterpartyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientCounterpartyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientCounterpartyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientCounterpartyRequest.Merge(m, src)
+}
+func (m *QueryClientCounterpartyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientCounterpartyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientCounterpartyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientCounterpartyRequest proto.InternalMessageInfo
+
+func (m *QueryClientCounterpartyRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryClientCounterpartyResponse is the response type for the Query/ClientCounterparty RPC method
+type QueryClientCounterpartyResponse struct {
+	// counterparty information registered for the client
+	Counterparty CounterpartyInfo `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty"`
+	// whether the counterparty registration is pending confirmation, in which case no packets may be sent or
+	// received over the client
+	Pending bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *QueryClientCounterpartyResponse) Reset()         { *m = QueryClientCounterpartyResponse{} }
+func (m *QueryClientCounterpartyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientCounterpartyResponse) ProtoMessage()    {}
+func (*QueryClientCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{21}
+}
+func (m *QueryClientCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientCounterpartyResponse.Merge(m, src)
+}
+func (m *QueryClientCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientCounterpartyResponse proto.InternalMessageInfo
+
+func (m *QueryClientCounterpartyResponse) GetCounterparty() CounterpartyInfo {
+	if m != nil {
+		return m.Counterparty
+	}
+	return CounterpartyInfo{}
+}
+
+func (m *QueryClientCounterpartyResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.core.client.v1.QueryClientStateRequest")
 	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.core.client.v1.QueryClientStateResponse")
@@ -1128,91 +1230,99 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryVerifyMembershipRequest)(nil), "ibc.core.client.v1.QueryVerifyMembershipRequest")
 	proto.RegisterType((*QueryVerifyMembershipResponse)(nil), "ibc.core.client.v1.QueryVerifyMembershipResponse")
+	proto.RegisterType((*QueryClientCounterpartyRequest)(nil), "ibc.core.client.v1.QueryClientCounterpartyRequest")
+	proto.RegisterType((*QueryClientCounterpartyResponse)(nil), "ibc.core.client.v1.QueryClientCounterpartyResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
 
 var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x4f, 0x1b, 0x47,
-	0x1b, 0x67, 0x08, 0x10, 0x78, 0xec, 0x00, 0x9a, 0x00, 0x71, 0x16, 0x62, 0x60, 0x79, 0xdf, 0x42,
-	0x28, 0xec, 0x82, 0x69, 0x80, 0x44, 0xaa, 0xd4, 0x92, 0x2a, 0x0d, 0x95, 0x92, 0x52, 0x57, 0xfd,
-	0x50, 0xa5, 0xca, 0x5a, 0xaf, 0x07, 0x7b, 0x15, 0xef, 0xae, 0xe3, 0xd9, 0xb5, 0x84, 0x22, 0x2e,
-	0x9c, 0x72, 0x6b, 0xa5, 0x4a, 0xbd, 0x56, 0xea, 0xb1, 0x87, 0x28, 0x87, 0x4a, 0x3d, 0x55, 0xea,
-	0xa9, 0xe5, 0x18, 0xa9, 0x3d, 0xf4, 0xd4, 0x54, 0x50, 0xa9, 0xff, 0x46, 0xb5, 0x33, 0xb3, 0xf6,
-	0xae, 0x3d, 0x8e, 0xd7, 0x55, 0xd2, 0x9b, 0xf7, 0xf9, 0xfc, 0x3d, 0x1f, 0x33, 0xbf, 0x91, 0x21,
-	0x6b, 0x15, 0x4d, 0xdd, 0x74, 0xeb, 0x44, 0x37, 0xab, 0x16, 0x71, 0x3c, 0xbd, 0xb1, 0xa9, 0x3f,
-	0xf4, 0x49, 0xfd, 0x48, 0xab, 0xd5, 0x5d, 0xcf, 0xc5, 0xd8, 0x2a, 0x9a, 0x5a, 0xa0, 0xd7, 0xb8,
-	0x5e, 0x6b, 0x6c, 0x2a, 0xab, 0xa6, 0x4b, 0x6d, 0x97, 0xea, 0x45, 0x83, 0x12, 0x6e, 0xac, 0x37,
-	0x36, 0x8b, 0xc4, 0x33, 0x36, 0xf5, 0x9a, 0x51, 0xb6, 0x1c, 0xc3, 0xb3, 0x5c, 0x87, 0xfb, 0x2b,
-	0xb3, 0xc2, 0x36, 0x34, 0x8b, 0x06, 0x57, 0xe6, 0x25, 0xc9, 0x45, 0x1a, 0x6e, 0xb0, 0xdc, 0x32,
-	0x70, 0x6d, 0xdb, 0xf2, 0x6c, 0x66, 0x94, 0x8b, 0x7c, 0x09, 0xc3, 0xab, 0x65, 0xd7, 0x2d, 0x57,
-	0x89, 0xce, 0xbe, 0x8a, 0xfe, 0xa1, 0x6e, 0x38, 0x61, 0x92, 0x39, 0xa1, 0x32, 0x6a, 0x96, 0x6e,
-	0x38, 0x8e, 0xeb, 0x31, 0x78, 0x54, 0x68, 0xa7, 0xca, 0x6e, 0xd9, 0x65, 0x3f, 0xf5, 0xe0, 0x17,
-	0x97, 0xaa, 0xdb, 0x70, 0xe5, 0x83, 0x00, 0xe7, 0x6d, 0x06, 0xe6, 0x43, 0xcf, 0xf0, 0x48, 0x9e,
-	0x3c, 0xf4, 0x09, 0xf5, 0xf0, 0x2c, 0x8c, 0x71, 0x88, 0x05, 0xab, 0x94, 0x41, 0x0b, 0x68, 0x65,
-	0x2c, 0x3f, 0xca, 0x05, 0xfb, 0x25, 0xf5, 0x09, 0x82, 0x4c, 0xa7, 0x23, 0xad, 0xb9, 0x0e, 0x25,
-	0x78, 0x07, 0xd2, 0xc2, 0x93, 0x06, 0x72, 0xe6, 0x9c, 0xca, 0x4d, 0x69, 0x1c, 0x9f, 0x16, 0x42,
-	0xd7, 0xde, 0x76, 0x8e, 0xf2, 0x29, 0xb3, 0x15, 0x00, 0x4f, 0xc1, 0x70, 0xad, 0xee, 0xba, 0x87,
-	0x99, 0xc1, 0x05, 0xb4, 0x92, 0xce, 0xf3, 0x0f, 0x7c, 0x1b, 0xd2, 0xec, 0x47, 0xa1, 0x42, 0xac,
-	0x72, 0xc5, 0xcb, 0x5c, 0x60, 0xe1, 0x14, 0xad, 0x73, 0x60, 0xda, 0x5d, 0x66, 0xb1, 0x37, 0x74,
-	0xfa, 0xc7, 0xfc, 0x40, 0x3e, 0xc5, 0xbc, 0xb8, 0x48, 0x2d, 0x76, 0xe2, 0xa5, 0x61, 0xa5, 0x77,
-	0x00, 0x5a, 0xe3, 0x14, 0x68, 0x5f, 0xd3, 0xf8, 0x3c, 0xb5, 0x60, 0xf6, 0x1a, 0x9f, 0xa5, 0x98,
-	0xbd, 0x76, 0x60, 0x94, 0xc3, 0x2e, 0xe5, 0x23, 0x9e, 0xea, 0x6f, 0x08, 0xae, 0x4a, 0x92, 0x88,
-	0xae, 0x38, 0x70, 0x29, 0xda, 0x15, 0x9a, 0x41, 0x0b, 0x17, 0x56, 0x52, 0xb9, 0xeb, 0xb2, 0x3a,
-	0xf6, 0x4b, 0xc4, 0xf1, 0xac, 0x43, 0x8b, 0x94, 0x22, 0xa1, 0xf6, 0xb2, 0x41, 0x59, 0xdf, 0x3d,
-	0x9f, 0x9f, 0x91, 0xaa, 0x69, 0x3e, 0x1d, 0xe9, 0x25, 0xc5, 0xef, 0xc6, 0xaa, 0x1a, 0x64, 0x55,
-	0x2d, 0xf7, 0xac, 0x8a, 0x83, 0x8d, 0x95, 0xf5, 0x14, 0x81, 0xc2, 0xcb, 0x0a, 0x54, 0x0e, 0xf5,
-	0x69, 0xe2, 0x3d, 0xc1, 0xcb, 0x30, 0x51, 0x27, 0x0d, 0x8b, 0x5a, 0xae, 0x53, 0x70, 0x7c, 0xbb,
-	0x48, 0xea, 0x0c, 0xc9, 0x50, 0x7e, 0x3c, 0x14, 0xdf, 0x67, 0xd2, 0x98, 0x61, 0x64, 0xce, 0x11,
-	0x43, 0x3e, 0x48, 0xbc, 0x04, 0x97, 0xaa, 0x41, 0x7d, 0x5e, 0x68, 0x36, 0xb4, 0x80, 0x56, 0x46,
-	0xf3, 0x69, 0x2e, 0x14, 0xd3, 0xfe, 0x01, 0xc1, 0xac, 0x14, 0xb2, 0x98, 0xc5, 0x9b, 0x30, 0x61,
-	0x86, 0x9a, 0x04, 0x4b, 0x3a, 0x6e, 0xc6, 0xc2, 0xbc, 0xca, 0x3d, 0x3d, 0x91, 0x23, 0xa7, 0x89,
-	0xba, 0x7d, 0x47, 0x32, 0xf2, 0x7f, 0xb3, 0xc8, 0x3f, 0x23, 0x98, 0x93, 0x83, 0x10, 0xfd, 0xfb,
-	0x1c, 0x26, 0xdb, 0xfa, 0x17, 0xae, 0xf3, 0x9a, 0xac, 0xdc, 0x78, 0x98, 0x4f, 0x2c, 0xaf, 0x12,
-	0x6b, 0xc0, 0x44, 0xbc, 0xbd, 0x2f, 0x71, 0x75, 0x1f, 0x23, 0x58, 0x94, 0x14, 0xc2, 0xb3, 0xff,
-	0xb7, 0x3d, 0xfd, 0x05, 0x81, 0xfa, 0x22, 0x28, 0xa2, 0xb3, 0x9f, 0xc2, 0x95, 0xb6, 0xce, 0x8a,
-	0x75, 0x0a, 0x1b, 0xdc, 0x7b, 0x9f, 0xa6, 0x4d, 0x59, 0x86, 0x97, 0xd7, 0xd4, 0x9d, 0x8e, 0xab,
-	0xd4, 0x4f, 0xd4, 0x4a, 0x75, 0xab, 0xe3, 0x7a, 0xf4, 0x5b, 0x85, 0xcf, 0xc0, 0x08, 0x65, 0x12,
-	0xe1, 0x26, 0xbe, 0x54, 0x25, 0x96, 0xed, 0xc0, 0xa8, 0x1b, 0x76, 0x98, 0x4d, 0x7d, 0x3f, 0x16,
-	0x30, 0xd4, 0x89, 0x80, 0x39, 0x18, 0xa9, 0x31, 0x89, 0x38, 0xda, 0xd2, 0xc6, 0x09, 0x1f, 0x61,
-	0xa9, 0x2e, 0xc2, 0x3c, 0x0b, 0xf8, 0x51, 0xad, 0x5c, 0x37, 0x4a, 0xb1, 0xeb, 0x35, 0xcc, 0x59,
-	0x85, 0x85, 0xee, 0x26, 0x22, 0xf5, 0x5d, 0x98, 0xf6, 0x85, 0xba, 0x90, 0x98, 0x09, 0x2f, 0xfb,
-	0x9d, 0x11, 0xd5, 0xff, 0x89, 0xa5, 0x69, 0x66, 0x93, 0x5d, 0xc1, 0xaa, 0x0f, 0x4b, 0x2f, 0xb4,
-	0x12, 0xb0, 0xee, 0x43, 0xa6, 0x05, 0xab, 0x8f, 0xeb, 0x6f, 0xc6, 0x97, 0xc6, 0x55, 0x7f, 0x1c,
-	0x14, 0xd7, 0xc4, 0xc7, 0xa4, 0x6e, 0x1d, 0x1e, 0xdd, 0x23, 0xc1, 0x4d, 0x4e, 0x2b, 0x56, 0x2d,
-	0xd1, 0xc1, 0x7a, 0x75, 0x97, 0x68, 0x10, 0xba, 0x61, 0x54, 0x7d, 0x92, 0x19, 0xe6, 0xa1, 0xd9,
-	0x07, 0xbe, 0x06, 0xe0, 0x59, 0x36, 0x29, 0x94, 0x48, 0xd5, 0x38, 0xca, 0x8c, 0x30, 0x76, 0x19,
-	0x0b, 0x24, 0xef, 0x04, 0x02, 0x3c, 0x0f, 0xa9, 0x62, 0xd5, 0x35, 0x1f, 0x08, 0xfd, 0x45, 0xa6,
-	0x07, 0x26, 0xe2, 0x06, 0xfb, 0x90, 0xb2, 0x49, 0xfd, 0x41, 0x95, 0x14, 0x6a, 0x86, 0x57, 0xc9,
-	0x8c, 0x32, 0x64, 0x6a, 0x04, 0x59, 0xeb, 0xad, 0xd6, 0xc8, 0x69, 0xf7, 0x98, 0xe9, 0x81, 0xe1,
-	0x55, 0x04, 0x42, 0xb0, 0x9b, 0x92, 0xf7, 0x86, 0x46, 0x87, 0x26, 0x87, 0xd5, 0x9b, 0x70, 0xad,
-	0x4b, 0xfb, 0xc4, 0xc0, 0x32, 0x70, 0x91, 0xfa, 0xa6, 0x49, 0x28, 0xdf, 0xe1, 0xd1, 0x7c, 0xf8,
-	0x99, 0x3b, 0x19, 0x87, 0x61, 0xe6, 0x8b, 0xbf, 0x41, 0x90, 0x8a, 0x6c, 0x0c, 0x7e, 0x5d, 0xd6,
-	0xaa, 0x2e, 0x6f, 0x3c, 0x65, 0x2d, 0x99, 0x31, 0x87, 0xa3, 0xde, 0x38, 0xf9, 0xf5, 0xaf, 0xaf,
-	0x06, 0x75, 0xbc, 0xae, 0x77, 0x7d, 0xce, 0x0a, 0x32, 0xd0, 0x1f, 0x35, 0xe7, 0x7e, 0x8c, 0xbf,
-	0x46, 0x90, 0x8e, 0xbe, 0x53, 0x70, 0xa2, 0xac, 0xe1, 0x21, 0x57, 0xd6, 0x13, 0x5a, 0x0b, 0x90,
-	0xd7, 0x19, 0xc8, 0x25, 0xbc, 0xd8, 0x13, 0x24, 0x7e, 0x8e, 0x60, 0x3c, 0xbe, 0xd2, 0x58, 0xeb,
-	0x9e, 0x4c, 0x76, 0xf2, 0x14, 0x3d, 0xb1, 0xbd, 0x80, 0x57, 0x65, 0xf0, 0x0e, 0x71, 0x49, 0x0a,
-	0xaf, 0x8d, 0x53, 0xa3, 0x6d, 0xd4, 0xc3, 0x77, 0x90, 0xfe, 0xa8, 0xed, 0x45, 0x75, 0xac, 0xf3,
-	0xb3, 0x12, 0x51, 0x70, 0xc1, 0x31, 0x7e, 0x82, 0x60, 0xa2, 0x8d, 0xc3, 0x71, 0x52, 0xc8, 0xcd,
-	0x01, 0x6c, 0x24, 0x77, 0x10, 0x45, 0xee, 0xb2, 0x22, 0x73, 0x78, 0xa3, 0xdf, 0x22, 0xf1, 0x29,
-	0x82, 0x69, 0x29, 0x41, 0xe2, 0x1b, 0x09, 0x51, 0xc4, 0xb9, 0x5d, 0xd9, 0xee, 0xd7, 0x4d, 0x94,
-	0xf0, 0x16, 0x2b, 0xe1, 0x16, 0xde, 0xed, 0x7b, 0x4e, 0x82, 0xae, 0xf1, 0xb7, 0xb1, 0xb5, 0xf7,
-	0x93, 0xad, 0xbd, 0xdf, 0xd7, 0xda, 0xb7, 0xe8, 0x33, 0xf1, 0xd9, 0xf4, 0xe3, 0xfd, 0xfe, 0xa2,
-	0x09, 0x92, 0x33, 0x61, 0x4f, 0x90, 0x31, 0x02, 0xee, 0x09, 0x32, 0x4e, 0xc9, 0xaa, 0xca, 0x40,
-	0xce, 0x61, 0x45, 0x06, 0x92, 0x53, 0x30, 0xfe, 0x1e, 0xc1, 0x65, 0x09, 0xb7, 0xe2, 0xad, 0xae,
-	0xa9, 0xba, 0x93, 0xb5, 0xf2, 0x46, 0x7f, 0x4e, 0x02, 0x66, 0x8e, 0xc1, 0x5c, 0xc3, 0xab, 0x32,
-	0x98, 0x52, 0x62, 0xa7, 0xf8, 0x27, 0x04, 0x33, 0x72, 0xfa, 0xc5, 0xdb, 0xbd, 0x41, 0x48, 0xef,
-	0x96, 0x9d, 0xbe, 0xfd, 0x92, 0xec, 0x42, 0xb7, 0x17, 0x00, 0x0d, 0x2e, 0x8b, 0xc9, 0x76, 0x2a,
-	0xc2, 0xdd, 0x0f, 0x7f, 0x17, 0xd2, 0x57, 0x36, 0xfb, 0xf0, 0x08, 0x01, 0x3f, 0xfe, 0xfb, 0xe9,
-	0x2a, 0x62, 0xa8, 0x57, 0x6f, 0xa1, 0x55, 0xf5, 0xff, 0x32, 0xe0, 0x0d, 0xe6, 0x5d, 0xb0, 0x9b,
-	0xee, 0x7b, 0xf9, 0xd3, 0xb3, 0x2c, 0x7a, 0x76, 0x96, 0x45, 0x7f, 0x9e, 0x65, 0xd1, 0x97, 0xe7,
-	0xd9, 0x81, 0x67, 0xe7, 0xd9, 0x81, 0xdf, 0xcf, 0xb3, 0x03, 0x9f, 0xed, 0x96, 0x2d, 0xaf, 0xe2,
-	0x17, 0x03, 0x4a, 0xd6, 0xc5, 0xff, 0x32, 0x56, 0xd1, 0x5c, 0x2f, 0xbb, 0x7a, 0xe3, 0xa6, 0x6e,
-	0xbb, 0x25, 0xbf, 0x4a, 0x28, 0x8f, 0xbf, 0x91, 0x5b, 0x17, 0x29, 0xbc, 0xa3, 0x1a, 0xa1, 0xc5,
-	0x11, 0xf6, 0xf2, 0xd9, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x00, 0x51, 0xed, 0x2f, 0x12,
-	0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x8b, 0x13, 0x57,
+	0x1c, 0xdf, 0xb7, 0xee, 0xae, 0xf1, 0x9b, 0xe8, 0xca, 0x53, 0xd7, 0xec, 0xa8, 0xc9, 0x3a, 0x6a,
+	0x5d, 0xb7, 0xee, 0x8c, 0x9b, 0xad, 0x3f, 0x8b, 0xd0, 0xba, 0xc5, 0xba, 0x05, 0xad, 0x9d, 0xd2,
+	0x1f, 0x14, 0x4a, 0x98, 0x4c, 0x5e, 0x92, 0xc1, 0x64, 0x66, 0x9c, 0x37, 0x13, 0x58, 0xc4, 0x8b,
+	0x27, 0xa1, 0x87, 0x16, 0x0a, 0xbd, 0x16, 0x7a, 0xec, 0x41, 0x3c, 0x14, 0x0a, 0x85, 0xd2, 0x9e,
+	0x5a, 0x8f, 0x42, 0x7b, 0xe8, 0xa9, 0x16, 0xb7, 0xd0, 0x7f, 0xa3, 0xcc, 0x7b, 0x6f, 0x92, 0x99,
+	0xe4, 0x65, 0x33, 0x29, 0xda, 0x5b, 0xe6, 0xfb, 0xf3, 0xf3, 0xfd, 0xf1, 0xde, 0xfb, 0x10, 0x28,
+	0xd9, 0x35, 0x4b, 0xb7, 0x5c, 0x9f, 0xe8, 0x56, 0xdb, 0x26, 0x4e, 0xa0, 0x77, 0xd7, 0xf4, 0xbb,
+	0x21, 0xf1, 0xb7, 0x34, 0xcf, 0x77, 0x03, 0x17, 0x63, 0xbb, 0x66, 0x69, 0x91, 0x5e, 0xe3, 0x7a,
+	0xad, 0xbb, 0xa6, 0xac, 0x58, 0x2e, 0xed, 0xb8, 0x54, 0xaf, 0x99, 0x94, 0x70, 0x63, 0xbd, 0xbb,
+	0x56, 0x23, 0x81, 0xb9, 0xa6, 0x7b, 0x66, 0xd3, 0x76, 0xcc, 0xc0, 0x76, 0x1d, 0xee, 0xaf, 0x1c,
+	0x11, 0xb6, 0xb1, 0x59, 0x32, 0xb8, 0x52, 0x96, 0x24, 0x17, 0x69, 0xb8, 0xc1, 0xa9, 0x21, 0x83,
+	0x8a, 0x6e, 0xb9, 0xa1, 0x13, 0x10, 0xdf, 0x33, 0xfd, 0x20, 0x8e, 0x73, 0xba, 0x6f, 0xe6, 0x76,
+	0x3a, 0x76, 0xd0, 0xe9, 0x99, 0xc6, 0x5f, 0xc2, 0x70, 0xb1, 0xe9, 0xba, 0xcd, 0x36, 0xd1, 0xd9,
+	0x57, 0x2d, 0x6c, 0xe8, 0xa6, 0x13, 0xc7, 0x38, 0x2a, 0x54, 0xa6, 0x67, 0xeb, 0xa6, 0xe3, 0xb8,
+	0x01, 0xab, 0x82, 0x0a, 0xed, 0xc1, 0xa6, 0xdb, 0x74, 0xd9, 0x4f, 0x3d, 0xfa, 0xc5, 0xa5, 0xea,
+	0x05, 0x38, 0xfc, 0x5e, 0x54, 0xce, 0x06, 0x03, 0xf7, 0x7e, 0x60, 0x06, 0xc4, 0x20, 0x77, 0x43,
+	0x42, 0x03, 0x7c, 0x04, 0xf6, 0x70, 0xc8, 0x55, 0xbb, 0x5e, 0x44, 0x4b, 0x68, 0x79, 0x8f, 0x91,
+	0xe3, 0x82, 0xcd, 0xba, 0xfa, 0x08, 0x41, 0x71, 0xd8, 0x91, 0x7a, 0xae, 0x43, 0x09, 0xbe, 0x08,
+	0x05, 0xe1, 0x49, 0x23, 0x39, 0x73, 0xce, 0x57, 0x0e, 0x6a, 0x1c, 0x9f, 0x16, 0x43, 0xd7, 0xde,
+	0x74, 0xb6, 0x8c, 0xbc, 0xd5, 0x0f, 0x80, 0x0f, 0xc2, 0xac, 0xe7, 0xbb, 0x6e, 0xa3, 0x38, 0xbd,
+	0x84, 0x96, 0x0b, 0x06, 0xff, 0xc0, 0x1b, 0x50, 0x60, 0x3f, 0xaa, 0x2d, 0x62, 0x37, 0x5b, 0x41,
+	0x71, 0x17, 0x0b, 0xa7, 0x68, 0xc3, 0x73, 0xd5, 0x6e, 0x30, 0x8b, 0x6b, 0x33, 0x4f, 0xfe, 0x2c,
+	0x4f, 0x19, 0x79, 0xe6, 0xc5, 0x45, 0x6a, 0x6d, 0x18, 0x2f, 0x8d, 0x2b, 0xbd, 0x0e, 0xd0, 0x9f,
+	0xba, 0x40, 0xfb, 0x8a, 0xc6, 0xc7, 0xae, 0x45, 0x2b, 0xa2, 0xf1, 0x91, 0x8b, 0x15, 0xd1, 0x6e,
+	0x9b, 0xcd, 0xb8, 0x4b, 0x46, 0xc2, 0x53, 0xfd, 0x1d, 0xc1, 0xa2, 0x24, 0x89, 0xe8, 0x8a, 0x03,
+	0x7b, 0x93, 0x5d, 0xa1, 0x45, 0xb4, 0xb4, 0x6b, 0x39, 0x5f, 0x39, 0x23, 0xab, 0x63, 0xb3, 0x4e,
+	0x9c, 0xc0, 0x6e, 0xd8, 0xa4, 0x9e, 0x08, 0x75, 0xad, 0x14, 0x95, 0xf5, 0xed, 0xb3, 0xf2, 0x82,
+	0x54, 0x4d, 0x8d, 0x42, 0xa2, 0x97, 0x14, 0xbf, 0x9d, 0xaa, 0x6a, 0x9a, 0x55, 0x75, 0x7a, 0x6c,
+	0x55, 0x1c, 0x6c, 0xaa, 0xac, 0xc7, 0x08, 0x14, 0x5e, 0x56, 0xa4, 0x72, 0x68, 0x48, 0x33, 0xef,
+	0x09, 0x3e, 0x0d, 0xf3, 0x3e, 0xe9, 0xda, 0xd4, 0x76, 0x9d, 0xaa, 0x13, 0x76, 0x6a, 0xc4, 0x67,
+	0x48, 0x66, 0x8c, 0x7d, 0xb1, 0xf8, 0x16, 0x93, 0xa6, 0x0c, 0x13, 0x73, 0x4e, 0x18, 0xf2, 0x41,
+	0xe2, 0x13, 0xb0, 0xb7, 0x1d, 0xd5, 0x17, 0xc4, 0x66, 0x33, 0x4b, 0x68, 0x39, 0x67, 0x14, 0xb8,
+	0x50, 0x4c, 0xfb, 0x7b, 0x04, 0x47, 0xa4, 0x90, 0xc5, 0x2c, 0xae, 0xc2, 0xbc, 0x15, 0x6b, 0x32,
+	0x2c, 0xe9, 0x3e, 0x2b, 0x15, 0xe6, 0x65, 0xee, 0xe9, 0x03, 0x39, 0x72, 0x9a, 0xa9, 0xdb, 0xd7,
+	0x25, 0x23, 0xff, 0x2f, 0x8b, 0xfc, 0x0b, 0x82, 0xa3, 0x72, 0x10, 0xa2, 0x7f, 0x9f, 0xc2, 0xfe,
+	0x81, 0xfe, 0xc5, 0xeb, 0x7c, 0x56, 0x56, 0x6e, 0x3a, 0xcc, 0x47, 0x76, 0xd0, 0x4a, 0x35, 0x60,
+	0x3e, 0xdd, 0xde, 0x17, 0xb8, 0xba, 0x0f, 0x11, 0x1c, 0x97, 0x14, 0xc2, 0xb3, 0xff, 0xbf, 0x3d,
+	0xfd, 0x15, 0x81, 0xba, 0x13, 0x14, 0xd1, 0xd9, 0x8f, 0xe1, 0xf0, 0x40, 0x67, 0xc5, 0x3a, 0xc5,
+	0x0d, 0x1e, 0xbf, 0x4f, 0x87, 0x2c, 0x59, 0x86, 0x17, 0xd7, 0xd4, 0x8b, 0x43, 0x57, 0x69, 0x98,
+	0xa9, 0x95, 0xea, 0x3a, 0x2c, 0x4a, 0x1c, 0x45, 0xe1, 0x0b, 0x30, 0x47, 0x99, 0x44, 0xb8, 0x89,
+	0x2f, 0x55, 0x49, 0x65, 0xbb, 0x6d, 0xfa, 0x66, 0x27, 0xce, 0xa6, 0xbe, 0x0b, 0x8b, 0x12, 0x9d,
+	0x08, 0x58, 0x81, 0x39, 0x8f, 0x49, 0xc4, 0xd1, 0x96, 0x36, 0x4e, 0xf8, 0x08, 0x4b, 0xf5, 0x38,
+	0x94, 0x59, 0xc0, 0x0f, 0xbc, 0xa6, 0x6f, 0xd6, 0x53, 0xd7, 0x6b, 0x9c, 0xb3, 0x0d, 0x4b, 0xa3,
+	0x4d, 0x44, 0xea, 0x1b, 0x70, 0x28, 0x14, 0xea, 0x6a, 0xe6, 0x97, 0xf0, 0x40, 0x38, 0x1c, 0x51,
+	0x3d, 0x09, 0x6a, 0x3a, 0x9b, 0xec, 0x0a, 0x56, 0x43, 0x38, 0xb1, 0xa3, 0x95, 0x80, 0x75, 0x0b,
+	0x8a, 0x7d, 0x58, 0x13, 0x5c, 0x7f, 0x0b, 0xa1, 0x34, 0xae, 0xfa, 0xe3, 0xb4, 0xb8, 0x26, 0x3e,
+	0x24, 0xbe, 0xdd, 0xd8, 0xba, 0x49, 0xa2, 0x9b, 0x9c, 0xb6, 0x6c, 0x2f, 0xd3, 0xc1, 0x7a, 0x79,
+	0x97, 0x68, 0x14, 0xba, 0x6b, 0xb6, 0x43, 0x52, 0x9c, 0xe5, 0xa1, 0xd9, 0x07, 0x3e, 0x06, 0x10,
+	0xd8, 0x1d, 0x52, 0xad, 0x93, 0xb6, 0xb9, 0x55, 0x9c, 0x63, 0xaf, 0xcb, 0x9e, 0x48, 0xf2, 0x56,
+	0x24, 0xc0, 0x65, 0xc8, 0xd7, 0xda, 0xae, 0x75, 0x47, 0xe8, 0x77, 0x33, 0x3d, 0x30, 0x11, 0x37,
+	0xd8, 0x84, 0x7c, 0x87, 0xf8, 0x77, 0xda, 0xa4, 0xea, 0x99, 0x41, 0xab, 0x98, 0x63, 0xc8, 0xd4,
+	0x04, 0xb2, 0x3e, 0x57, 0xeb, 0x56, 0xb4, 0x9b, 0xcc, 0xf4, 0xb6, 0x19, 0xb4, 0x04, 0x42, 0xe8,
+	0xf4, 0x24, 0xef, 0xcc, 0xe4, 0x66, 0xf6, 0xcf, 0xaa, 0x97, 0xe1, 0xd8, 0x88, 0xf6, 0x89, 0x81,
+	0x15, 0x61, 0x37, 0x0d, 0x2d, 0x8b, 0x50, 0xbe, 0xc3, 0x39, 0x23, 0xfe, 0x54, 0xaf, 0x42, 0x29,
+	0xb1, 0xf9, 0x1b, 0x09, 0x42, 0x99, 0xe9, 0x24, 0x7e, 0x86, 0xa0, 0x3c, 0xd2, 0xbf, 0xb7, 0x2d,
+	0x85, 0x24, 0x51, 0x15, 0x1b, 0x72, 0x72, 0x78, 0x12, 0x15, 0x2d, 0xe9, 0xbf, 0xe9, 0x34, 0x5c,
+	0x51, 0x71, 0xca, 0x3f, 0x2a, 0xc6, 0x23, 0x4e, 0xdd, 0x76, 0x9a, 0x6c, 0xe2, 0x39, 0x23, 0xfe,
+	0xac, 0xfc, 0x34, 0x0f, 0xb3, 0x0c, 0x0d, 0xfe, 0x1a, 0x41, 0x3e, 0xb1, 0xfe, 0xf8, 0x55, 0xd9,
+	0xdc, 0x47, 0x10, 0x56, 0xe5, 0x6c, 0x36, 0x63, 0x5e, 0x9e, 0x7a, 0xfe, 0xc1, 0x6f, 0x7f, 0x7f,
+	0x39, 0xad, 0xe3, 0x55, 0x7d, 0x24, 0x85, 0x17, 0x2f, 0x9b, 0x7e, 0xaf, 0xd7, 0xc8, 0xfb, 0xf8,
+	0x2b, 0x04, 0x85, 0x8d, 0x24, 0xcd, 0xca, 0x94, 0x35, 0xbe, 0xb1, 0x94, 0xd5, 0x8c, 0xd6, 0x02,
+	0xe4, 0x19, 0x06, 0xf2, 0x04, 0x3e, 0x3e, 0x16, 0x24, 0x7e, 0x86, 0x60, 0x5f, 0xfa, 0x7c, 0x62,
+	0x6d, 0x74, 0x32, 0xd9, 0x35, 0xa2, 0xe8, 0x99, 0xed, 0x05, 0xbc, 0x36, 0x83, 0xd7, 0xc0, 0x75,
+	0x29, 0xbc, 0x01, 0x82, 0x90, 0x6c, 0xa3, 0x1e, 0x93, 0x3a, 0xfd, 0xde, 0x00, 0x3d, 0xbc, 0xaf,
+	0xf3, 0x83, 0x9f, 0x50, 0x70, 0xc1, 0x7d, 0xfc, 0x08, 0xc1, 0xfc, 0xc6, 0x00, 0x53, 0xc8, 0x0a,
+	0xb9, 0x37, 0x80, 0x73, 0xd9, 0x1d, 0x44, 0x91, 0x97, 0x58, 0x91, 0x15, 0x7c, 0x6e, 0xd2, 0x22,
+	0xf1, 0x13, 0x04, 0x87, 0xa4, 0xaf, 0x3d, 0x3e, 0x9f, 0x11, 0x45, 0x9a, 0xa8, 0x28, 0x17, 0x26,
+	0x75, 0x13, 0x25, 0xbc, 0xc1, 0x4a, 0xb8, 0x82, 0x2f, 0x4d, 0x3c, 0x27, 0xc1, 0x3d, 0xf0, 0x37,
+	0xa9, 0xb5, 0x0f, 0xb3, 0xad, 0x7d, 0x38, 0xd1, 0xda, 0x87, 0x74, 0xe2, 0xb3, 0x19, 0xa6, 0xfb,
+	0xfd, 0x79, 0x0f, 0x24, 0x7f, 0xd6, 0xc7, 0x82, 0x4c, 0xb1, 0x09, 0x65, 0x35, 0xa3, 0xb5, 0x00,
+	0xa9, 0x32, 0x90, 0x47, 0xb1, 0x22, 0x03, 0xc9, 0xf9, 0x04, 0xfe, 0x0e, 0xc1, 0x01, 0x09, 0x51,
+	0xc0, 0xeb, 0x23, 0x53, 0x8d, 0x66, 0x1e, 0xca, 0x6b, 0x93, 0x39, 0x09, 0x98, 0x15, 0x06, 0xf3,
+	0x2c, 0x5e, 0x91, 0xc1, 0x94, 0xb2, 0x14, 0x8a, 0x7f, 0x46, 0xb0, 0x20, 0xe7, 0x12, 0xf8, 0xc2,
+	0x78, 0x10, 0xd2, 0xbb, 0xe5, 0xe2, 0xc4, 0x7e, 0x59, 0x76, 0x61, 0x14, 0x9d, 0xa1, 0xd1, 0x65,
+	0xb1, 0x7f, 0xf0, 0x5d, 0xc5, 0xa3, 0x0f, 0xff, 0x08, 0x06, 0xa3, 0xac, 0x4d, 0xe0, 0x11, 0x03,
+	0x7e, 0xf8, 0xcf, 0xe3, 0x15, 0xc4, 0x50, 0xaf, 0x5c, 0x41, 0x2b, 0xea, 0x29, 0x19, 0xf0, 0x2e,
+	0xf3, 0xae, 0x76, 0xfa, 0xd8, 0x7e, 0x40, 0x80, 0x87, 0x5f, 0x63, 0x5c, 0x19, 0xb3, 0x94, 0x92,
+	0xa7, 0x5f, 0x59, 0x9f, 0xc8, 0x47, 0xc0, 0x7e, 0x9d, 0x21, 0x3e, 0x8f, 0xd7, 0x77, 0x38, 0x73,
+	0xc9, 0xf7, 0x3c, 0x79, 0xf2, 0xae, 0x19, 0x4f, 0x9e, 0x97, 0xd0, 0xd3, 0xe7, 0x25, 0xf4, 0xd7,
+	0xf3, 0x12, 0xfa, 0x62, 0xbb, 0x34, 0xf5, 0x74, 0xbb, 0x34, 0xf5, 0xc7, 0x76, 0x69, 0xea, 0x93,
+	0x4b, 0x4d, 0x3b, 0x68, 0x85, 0xb5, 0x88, 0x1c, 0xe9, 0xe2, 0x8f, 0x34, 0xbb, 0x66, 0xad, 0x36,
+	0x5d, 0xbd, 0x7b, 0x59, 0xef, 0xb8, 0xf5, 0xb0, 0x4d, 0x28, 0xcf, 0x76, 0xae, 0xb2, 0x2a, 0x12,
+	0x06, 0x5b, 0x1e, 0xa1, 0xb5, 0x39, 0xc6, 0x41, 0xd7, 0xff, 0x1d, 0x00, 0x6f, 0x13, 0x7c, 0xeb,
+	0xe0, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1249,6 +1359,8 @@ type QueryClient interface {
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
 	// VerifyMembership queries an IBC light client for proof verification of a value at a given key path.
 	VerifyMembership(ctx context.Context, in *QueryVerifyMembershipRequest, opts ...grpc.CallOption) (*QueryVerifyMembershipResponse, error)
+	// ClientCounterparty queries the counterparty information registered for an IBC light client.
+	ClientCounterparty(ctx context.Context, in *QueryClientCounterpartyRequest, opts ...grpc.CallOption) (*QueryClientCounterpartyResponse, error)
 }
 
 type queryClient struct {
@@ -1349,6 +1461,15 @@ func (c *queryClient) VerifyMembership(ctx context.Context, in *QueryVerifyMembe
 	return out, nil
 }
 
+func (c *queryClient) ClientCounterparty(ctx context.Context, in *QueryClientCounterpartyRequest, opts ...grpc.CallOption) (*QueryClientCounterpartyResponse, error) {
+	out := new(QueryClientCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ClientState queries an IBC light client.
@@ -1373,6 +1494,8 @@ type QueryServer interface {
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
 	// VerifyMembership queries an IBC light client for proof verification of a value at a given key path.
 	VerifyMembership(context.Context, *QueryVerifyMembershipRequest) (*QueryVerifyMembershipResponse, error)
+	// ClientCounterparty queries the counterparty information registered for an IBC light client.
+	ClientCounterparty(context.Context, *QueryClientCounterpartyRequest) (*QueryClientCounterpartyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1409,6 +1532,9 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) VerifyMembership(ctx context.Context, req *QueryVerifyMembershipRequest) (*QueryVerifyMembershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyMembership not implemented")
 }
+func (*UnimplementedQueryServer) ClientCounterparty(ctx context.Context, req *QueryClientCounterpartyRequest) (*QueryClientCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientCounterparty not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1594,6 +1720,24 @@ func _Query_VerifyMembership_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClientCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/ClientCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientCounterparty(ctx, req.(*QueryClientCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1638,6 +1782,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyMembership",
 			Handler:    _Query_VerifyMembership_Handler,
 		},
+		{
+			MethodName: "ClientCounterparty",
+			Handler:    _Query_ClientCounterparty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/query.proto",
@@ -2434,6 +2582,79 @@ func (m *QueryVerifyMembershipResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClientCounterpartyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientCounterpartyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientCounterpartyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2757,6 +2978,33 @@ func (m *QueryVerifyMembershipResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClientCounterpartyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Counterparty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4869,6 +5117,191 @@ func (m *QueryVerifyMembershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClientCounterpartyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientCounterpartyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientCounterpartyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientCounterpartyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientCounterpartyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -97,7 +97,7 @@ func (k *Keeper) VerifyPacketCommitment(
 	clientID := connection.ClientId
 	// get time and block delays
 	timeDelay := connection.DelayPeriod
-	blockDelay := k.getBlockDelay(ctx, connection)
+	blockDelay := k.GetBlockDelay(ctx, connection)
 
 	merklePath := commitmenttypes.NewMerklePath(host.PacketCommitmentKey(portID, channelID, sequence))
 	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
@@ -129,7 +129,7 @@ func (k *Keeper) VerifyPacketAcknowledgement(
 	clientID := connection.ClientId
 	// get time and block delays
 	timeDelay := connection.DelayPeriod
-	blockDelay := k.getBlockDelay(ctx, connection)
+	blockDelay := k.GetBlockDelay(ctx, connection)
 
 	merklePath := commitmenttypes.NewMerklePath(host.PacketAcknowledgementKey(portID, channelID, sequence))
 	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
@@ -162,7 +162,7 @@ func (k *Keeper) VerifyPacketReceiptAbsence(
 	clientID := connection.ClientId
 	// get time and block delays
 	timeDelay := connection.DelayPeriod
-	blockDelay := k.getBlockDelay(ctx, connection)
+	blockDelay := k.GetBlockDelay(ctx, connection)
 
 	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptKey(portID, channelID, sequence))
 	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
@@ -193,7 +193,7 @@ func (k *Keeper) VerifyNextSequenceRecv(
 	clientID := connection.ClientId
 	// get time and block delays
 	timeDelay := connection.DelayPeriod
-	blockDelay := k.getBlockDelay(ctx, connection)
+	blockDelay := k.GetBlockDelay(ctx, connection)
 
 	merklePath := commitmenttypes.NewMerklePath(host.NextSequenceRecvKey(portID, channelID))
 	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
@@ -278,9 +278,9 @@ func (k *Keeper) VerifyChannelUpgrade(
 	return nil
 }
 
-// getBlockDelay calculates the block delay period from the time delay of the connection
+// GetBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
-func (k *Keeper) getBlockDelay(ctx context.Context, connection types.ConnectionEnd) uint64 {
+func (k *Keeper) GetBlockDelay(ctx context.Context, connection types.ConnectionEnd) uint64 {
 	// expectedTimePerBlock should never be zero, however if it is then return a 0 block delay for safety
 	// as the expectedTimePerBlock parameter was not set.
 	expectedTimePerBlock := k.GetParams(ctx).MaxExpectedTimePerBlock
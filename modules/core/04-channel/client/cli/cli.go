@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
 		GetCmdQueryPacketReceipt(),
+		GetCmdQueryPacketStatus(),
 		GetCmdQueryPacketAcknowledgement(),
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
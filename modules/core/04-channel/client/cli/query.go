@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences   = "sequences"
+	flagDestination = "destination"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -282,6 +283,56 @@ func GetCmdQueryPacketReceipt() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPacketStatus defines the command to query the lifecycle status of a packet
+func GetCmdQueryPacketStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-status [port-id] [channel-id] [sequence]",
+		Short: "Query the lifecycle status of a packet",
+		Long: `Query the stage of its lifecycle that a packet has reached on this chain. By default the packet sent on the
+channel is queried, use --destination to query the packet received on the channel.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-status [port-id] [channel-id] [sequence] --destination", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			destination, err := cmd.Flags().GetBool(flagDestination)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketStatusRequest{
+				PortId:      args[0],
+				ChannelId:   args[1],
+				Sequence:    seq,
+				Destination: destination,
+			}
+
+			res, err := queryClient.PacketStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagDestination, false, "query the packet received on the channel instead of the packet sent")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPacketAcknowledgement defines the command to query a packet acknowledgement
 func GetCmdQueryPacketAcknowledgement() *cobra.Command {
 	cmd := &cobra.Command{
@@ -37,20 +37,24 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, spl := range gs.SendPacketLifecycles {
+		k.SetSendPacketLifecycle(ctx, spl.PortId, spl.ChannelId, spl.Sequence, spl.Lifecycle)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx context.Context, k *keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
-		Params:              k.GetParams(ctx),
+		Channels:             k.GetAllChannels(ctx),
+		Acknowledgements:     k.GetAllPacketAcks(ctx),
+		Commitments:          k.GetAllPacketCommitments(ctx),
+		Receipts:             k.GetAllPacketReceipts(ctx),
+		SendSequences:        k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:        k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:         k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:  k.GetNextChannelSequence(ctx),
+		SendPacketLifecycles: k.GetAllSendPacketLifecycles(ctx),
+		Params:               k.GetParams(ctx),
 	}
 }
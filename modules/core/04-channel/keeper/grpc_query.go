@@ -306,6 +306,33 @@ func (q *queryServer) PacketReceipt(ctx context.Context, req *types.QueryPacketR
 	return types.NewQueryPacketReceiptResponse(recvd, nil, selfHeight), nil
 }
 
+// PacketStatus implements the Query/PacketStatus gRPC method
+func (q *queryServer) PacketStatus(ctx context.Context, req *types.QueryPacketStatusRequest) (*types.QueryPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	if !q.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	lifecycle := q.GetPacketStatus(ctx, req.PortId, req.ChannelId, req.Sequence, req.Destination)
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryPacketStatusResponse(lifecycle, selfHeight), nil
+}
+
 // PacketAcknowledgement implements the Query/PacketAcknowledgement gRPC method
 func (q *queryServer) PacketAcknowledgement(ctx context.Context, req *types.QueryPacketAcknowledgementRequest) (*types.QueryPacketAcknowledgementResponse, error) {
 	if req == nil {
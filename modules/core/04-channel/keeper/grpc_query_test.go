@@ -1034,6 +1034,344 @@ func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 	}
 }
 
+// TestQueryPacketStatus tests the PacketStatus query. The paths of the test cases disable unique channel IDs,
+// such that the channel IDs expected by other tests of the suite are not shifted.
+func (suite *KeeperTestSuite) TestQueryPacketStatus() {
+	var (
+		req       *types.QueryPacketStatusRequest
+		expStatus types.PacketLifecycleStatus
+		expHeight bool
+	)
+
+	enableLifecycleTracking := func() {
+		params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+		params.PacketLifecycleTrackingEnabled = true
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPacketStatusRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			status.Error(
+				codes.InvalidArgument,
+				errorsmod.Wrapf(host.ErrInvalidID, "identifier cannot be blank").Error(),
+			),
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPacketStatusRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  0,
+				}
+			},
+			status.Error(
+				codes.InvalidArgument,
+				fmt.Errorf("packet sequence cannot be 0").Error(),
+			),
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketStatusRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (test-port-id) channel ID (test-channel-id)").Error(),
+			),
+		},
+		{
+			"success: packet not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+				expStatus = types.PacketLifecycleStatus_Unknown
+			},
+			nil,
+		},
+		{
+			"success: packet committed",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+				enableLifecycleTracking()
+
+				sequence, err := path.EndpointA.SendPacket(suite.chainA.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_Committed
+				expHeight = true
+			},
+			nil,
+		},
+		{
+			"success: packet committed without lifecycle tracking",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				sequence, err := path.EndpointA.SendPacket(suite.chainA.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_Committed
+			},
+			nil,
+		},
+		{
+			"success: packet acknowledged successfully",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+				enableLifecycleTracking()
+
+				timeoutHeight := suite.chainA.GetTimeoutHeight()
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_AcknowledgedSuccess
+				expHeight = true
+			},
+			nil,
+		},
+		{
+			"success: packet acknowledged with an error",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+				enableLifecycleTracking()
+
+				timeoutHeight := suite.chainA.GetTimeoutHeight()
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockFailPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockFailPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_AcknowledgedError
+				expHeight = true
+			},
+			nil,
+		},
+		{
+			"success: packet timed out",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+				enableLifecycleTracking()
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_TimedOut
+				expHeight = true
+			},
+			nil,
+		},
+		{
+			"success: packet acknowledged without lifecycle tracking",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				timeoutHeight := suite.chainA.GetTimeoutHeight()
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_Completed
+			},
+			nil,
+		},
+		{
+			"success: packet timed out without lifecycle tracking",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+				expStatus = types.PacketLifecycleStatus_Completed
+			},
+			nil,
+		},
+		{
+			"success: received packet acknowledged",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				timeoutHeight := suite.chainB.GetTimeoutHeight()
+				sequence, err := path.EndpointB.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:      path.EndpointA.ChannelConfig.PortID,
+					ChannelId:   path.EndpointA.ChannelID,
+					Sequence:    sequence,
+					Destination: true,
+				}
+				expStatus = types.PacketLifecycleStatus_Received
+			},
+			nil,
+		},
+		{
+			"success: received packet awaiting async acknowledgement",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				timeoutHeight := suite.chainB.GetTimeoutHeight()
+				sequence, err := path.EndpointB.SendPacket(timeoutHeight, 0, mock.MockAsyncPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(mock.MockAsyncPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:      path.EndpointA.ChannelConfig.PortID,
+					ChannelId:   path.EndpointA.ChannelID,
+					Sequence:    sequence,
+					Destination: true,
+				}
+				expStatus = types.PacketLifecycleStatus_AsyncPending
+			},
+			nil,
+		},
+		{
+			"success: received packet pruned",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB).DisableUniqueChannelIDs()
+				path.Setup()
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
+
+				req = &types.QueryPacketStatusRequest{
+					PortId:      path.EndpointA.ChannelConfig.PortID,
+					ChannelId:   path.EndpointA.ChannelID,
+					Sequence:    1,
+					Destination: true,
+				}
+				expStatus = types.PacketLifecycleStatus_Pruned
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expStatus = types.PacketLifecycleStatus_Unknown
+			expHeight = false
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.App.GetIBCKeeper().ChannelKeeper)
+			res, err := queryServer.PacketStatus(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.Lifecycle.Status)
+
+				// the height and time at which the stage was reached are only recorded if lifecycle tracking is enabled
+				suite.Require().Equal(expHeight, !res.Lifecycle.Height.IsZero())
+				suite.Require().Equal(expHeight, res.Lifecycle.Timestamp != 0)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 	var (
 		req    *types.QueryPacketAcknowledgementRequest
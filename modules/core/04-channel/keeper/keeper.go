@@ -315,6 +315,93 @@ func (k *Keeper) deletePacketAcknowledgement(ctx context.Context, portID, channe
 	}
 }
 
+// GetPacketStatus returns the stage of its lifecycle that the packet with the given sequence has reached
+// on this chain. If destination is true, the packet received on the given channel is looked up, otherwise
+// the packet sent on the given channel is looked up. The stages reached by a sent packet are returned
+// together with the height and time at which they were reached if they were recorded, otherwise the stage
+// is derived from the packet commitment, receipt and acknowledgement stored for the packet.
+//
+// NOTE: the stages reached by sent packets are only recorded if packet lifecycle tracking is enabled in the params.
+func (k *Keeper) GetPacketStatus(ctx context.Context, portID, channelID string, sequence uint64, destination bool) types.PacketLifecycle {
+	if !destination {
+		if lifecycle, found := k.getSendPacketLifecycle(ctx, portID, channelID, sequence); found {
+			return lifecycle
+		}
+
+		if k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+			return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Committed}
+		}
+
+		// the commitment of a sent packet is only deleted once it is acknowledged or timed out
+		if nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID); found && sequence < nextSequenceSend {
+			return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Completed}
+		}
+
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Unknown}
+	}
+
+	if pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, portID, channelID); found && sequence < pruningSequenceStart {
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Pruned}
+	}
+
+	// the outcome of the acknowledgement is only recorded by the sending chain
+	if k.HasPacketAcknowledgement(ctx, portID, channelID, sequence) {
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Received}
+	}
+
+	_, received := k.GetPacketReceipt(ctx, portID, channelID, sequence)
+	if channel, found := k.GetChannel(ctx, portID, channelID); found && channel.Ordering == types.ORDERED {
+		nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, portID, channelID)
+		received = sequence < nextSequenceRecv
+	}
+
+	// a received packet without an acknowledgement awaits the asynchronous acknowledgement of the application
+	if received {
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_AsyncPending}
+	}
+
+	return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Unknown}
+}
+
+// SetSendPacketLifecycle records the lifecycle of the packet sent on the given channel.
+func (k *Keeper) SetSendPacketLifecycle(ctx context.Context, portID, channelID string, sequence uint64, lifecycle types.PacketLifecycle) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&lifecycle)
+	if err := store.Set(host.SendPacketLifecycleKey(portID, channelID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getSendPacketLifecycle returns the lifecycle record of the packet sent on the given channel.
+func (k *Keeper) getSendPacketLifecycle(ctx context.Context, portID, channelID string, sequence uint64) (types.PacketLifecycle, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(host.SendPacketLifecycleKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.PacketLifecycle{}, false
+	}
+
+	var lifecycle types.PacketLifecycle
+	k.cdc.MustUnmarshal(bz, &lifecycle)
+	return lifecycle, true
+}
+
+// recordSendPacketLifecycle records the stage of its lifecycle that the packet sent on the given channel has
+// reached at the current height and block time. The stage is recorded if packet lifecycle tracking is enabled,
+// or if a previous stage of the packet was recorded while it was enabled.
+func (k *Keeper) recordSendPacketLifecycle(ctx context.Context, portID, channelID string, sequence uint64, status types.PacketLifecycleStatus) {
+	if _, found := k.getSendPacketLifecycle(ctx, portID, channelID, sequence); !found && !k.GetParams(ctx).PacketLifecycleTrackingEnabled {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	lifecycle := types.NewPacketLifecycle(status, clienttypes.GetSelfHeight(ctx), uint64(sdkCtx.BlockTime().Unix()))
+	k.SetSendPacketLifecycle(ctx, portID, channelID, sequence, lifecycle)
+}
+
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
 // For each sequence, cb will be called. If the cb returns true, the iterator
 // will close and stop.
@@ -448,6 +535,27 @@ func (k *Keeper) GetAllPacketAcks(ctx context.Context) (acks []types.PacketState
 	return acks
 }
 
+// IterateSendPacketLifecycles provides an iterator over all the lifecycle records of sent packets. For each
+// record, cb will be called. If the cb returns true, the iterator will close and stop.
+func (k *Keeper) IterateSendPacketLifecycles(ctx context.Context, cb func(portID, channelID string, sequence uint64, lifecycle types.PacketLifecycle) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeySendPacketLifecycle))
+	k.iterateHashes(ctx, iterator, func(portID, channelID string, sequence uint64, bz []byte) bool {
+		var lifecycle types.PacketLifecycle
+		k.cdc.MustUnmarshal(bz, &lifecycle)
+		return cb(portID, channelID, sequence, lifecycle)
+	})
+}
+
+// GetAllSendPacketLifecycles returns the lifecycle records of all sent packets.
+func (k *Keeper) GetAllSendPacketLifecycles(ctx context.Context) (lifecycles []types.SendPacketLifecycle) {
+	k.IterateSendPacketLifecycles(ctx, func(portID, channelID string, sequence uint64, lifecycle types.PacketLifecycle) bool {
+		lifecycles = append(lifecycles, types.NewSendPacketLifecycle(portID, channelID, sequence, lifecycle))
+		return false
+	})
+	return lifecycles
+}
+
 // IterateChannels provides an iterator over all Channel objects. For each
 // Channel, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -85,6 +85,11 @@ func (k *Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
 
+	if k.GetParams(ctx).PacketLifecycleTrackingEnabled {
+		lifecycle := types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.GetSelfHeight(ctx), uint64(sdkCtx.BlockTime().Unix()))
+		k.SetSendPacketLifecycle(ctx, sourcePort, sourceChannel, packet.GetSequence(), lifecycle)
+	}
+
 	emitSendPacketEvent(sdkCtx, packet, channel, timeoutHeight)
 
 	k.Logger(ctx).Info(
@@ -443,6 +448,14 @@ func (k *Keeper) AcknowledgePacket(
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
+	// acknowledgements which are not encoded as a standard acknowledgement are recorded as successful
+	ackStatus := types.PacketLifecycleStatus_AcknowledgedSuccess
+	var ack types.Acknowledgement
+	if err := types.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		ackStatus = types.PacketLifecycleStatus_AcknowledgedError
+	}
+	k.recordSendPacketLifecycle(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), ackStatus)
+
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
 		"packet acknowledged",
@@ -133,6 +133,7 @@ func (k *Keeper) timeoutExecuted(
 	packet types.Packet,
 ) error {
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.recordSendPacketLifecycle(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.PacketLifecycleStatus_TimedOut)
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
 	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
@@ -43,6 +43,12 @@ func NewDecodeStore(cdc codec.BinaryCodec, kvA, kvB kv.Pair) (string, bool) {
 	case bytes.HasPrefix(kvA.Key, []byte(host.KeyPacketAckPrefix)):
 		return fmt.Sprintf("AckHash A: %X\nAckHash B: %X", kvA.Value, kvB.Value), true
 
+	case bytes.HasPrefix(kvA.Key, []byte(host.KeySendPacketLifecycle)):
+		var lifecycleA, lifecycleB types.PacketLifecycle
+		cdc.MustUnmarshal(kvA.Value, &lifecycleA)
+		cdc.MustUnmarshal(kvB.Value, &lifecycleB)
+		return fmt.Sprintf("PacketLifecycle A: %v\nPacketLifecycle B: %v", lifecycleA, lifecycleB), true
+
 	default:
 		return "", false
 	}
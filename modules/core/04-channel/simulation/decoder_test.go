@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/simulation"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
@@ -27,6 +28,8 @@ func TestDecodeStore(t *testing.T) {
 		Version: "1.0",
 	}
 
+	lifecycle := types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)
+
 	bz := []byte{0x1, 0x2, 0x3}
 
 	kvPairs := kv.Pairs{
@@ -55,6 +58,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   host.PacketAcknowledgementKey(portID, channelID, 1),
 				Value: bz,
 			},
+			{
+				Key:   host.SendPacketLifecycleKey(portID, channelID, 1),
+				Value: cdc.MustMarshal(&lifecycle),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -71,6 +78,7 @@ func TestDecodeStore(t *testing.T) {
 		{"NextSeqAck", "NextSeqAck A: 1\nNextSeqAck B: 1"},
 		{"CommitmentHash", fmt.Sprintf("CommitmentHash A: %X\nCommitmentHash B: %X", bz, bz)},
 		{"AckHash", fmt.Sprintf("AckHash A: %X\nAckHash B: %X", bz, bz)},
+		{"PacketLifecycle", fmt.Sprintf("PacketLifecycle A: %v\nPacketLifecycle B: %v", lifecycle, lifecycle)},
 		{"other", ""},
 	}
 
@@ -108,6 +108,65 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{1}
 }
 
+// PacketLifecycleStatus defines the stage of its lifecycle that a packet has reached on a chain.
+type PacketLifecycleStatus int32
+
+const (
+	// PACKET_LIFECYCLE_STATUS_UNSPECIFIED indicates that no trace of the packet was found.
+	PacketLifecycleStatus_Unknown PacketLifecycleStatus = 0
+	// PACKET_LIFECYCLE_STATUS_COMMITTED indicates that the packet was sent and awaits its acknowledgement or timeout.
+	PacketLifecycleStatus_Committed PacketLifecycleStatus = 1
+	// PACKET_LIFECYCLE_STATUS_ASYNC_PENDING indicates that the packet was received and awaits the asynchronous
+	// acknowledgement of the receiving application.
+	PacketLifecycleStatus_AsyncPending PacketLifecycleStatus = 2
+	// PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_SUCCESS indicates that the sent packet was acknowledged successfully.
+	PacketLifecycleStatus_AcknowledgedSuccess PacketLifecycleStatus = 3
+	// PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_ERROR indicates that the sent packet was acknowledged with an error.
+	PacketLifecycleStatus_AcknowledgedError PacketLifecycleStatus = 4
+	// PACKET_LIFECYCLE_STATUS_TIMED_OUT indicates that the sent packet timed out.
+	PacketLifecycleStatus_TimedOut PacketLifecycleStatus = 5
+	// PACKET_LIFECYCLE_STATUS_PRUNED indicates that the receipt and acknowledgement of the received packet were pruned.
+	PacketLifecycleStatus_Pruned PacketLifecycleStatus = 6
+	// PACKET_LIFECYCLE_STATUS_RECEIVED indicates that the packet was received and its acknowledgement was written.
+	// The outcome of the acknowledgement is recorded by the sending chain once the acknowledgement is relayed.
+	PacketLifecycleStatus_Received PacketLifecycleStatus = 7
+	// PACKET_LIFECYCLE_STATUS_COMPLETED indicates that the sent packet was acknowledged or timed out, but that its
+	// outcome was not recorded as packet lifecycle tracking was disabled.
+	PacketLifecycleStatus_Completed PacketLifecycleStatus = 8
+)
+
+var PacketLifecycleStatus_name = map[int32]string{
+	0: "PACKET_LIFECYCLE_STATUS_UNSPECIFIED",
+	1: "PACKET_LIFECYCLE_STATUS_COMMITTED",
+	2: "PACKET_LIFECYCLE_STATUS_ASYNC_PENDING",
+	3: "PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_SUCCESS",
+	4: "PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_ERROR",
+	5: "PACKET_LIFECYCLE_STATUS_TIMED_OUT",
+	6: "PACKET_LIFECYCLE_STATUS_PRUNED",
+	7: "PACKET_LIFECYCLE_STATUS_RECEIVED",
+	8: "PACKET_LIFECYCLE_STATUS_COMPLETED",
+}
+
+var PacketLifecycleStatus_value = map[string]int32{
+	"PACKET_LIFECYCLE_STATUS_UNSPECIFIED":          0,
+	"PACKET_LIFECYCLE_STATUS_COMMITTED":            1,
+	"PACKET_LIFECYCLE_STATUS_ASYNC_PENDING":        2,
+	"PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_SUCCESS": 3,
+	"PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_ERROR":   4,
+	"PACKET_LIFECYCLE_STATUS_TIMED_OUT":            5,
+	"PACKET_LIFECYCLE_STATUS_PRUNED":               6,
+	"PACKET_LIFECYCLE_STATUS_RECEIVED":             7,
+	"PACKET_LIFECYCLE_STATUS_COMPLETED":            8,
+}
+
+func (x PacketLifecycleStatus) String() string {
+	return proto.EnumName(PacketLifecycleStatus_name, int32(x))
+}
+
+func (PacketLifecycleStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{2}
+}
+
 // Channel defines pipeline for exactly-once packet delivery between specific
 // modules on separate blockchains, which has at least one end capable of
 // sending packets and one end capable of receiving packets.
@@ -561,6 +620,9 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// whether the height and time at which the packets sent by this chain were committed, acknowledged or timed out
+	// are recorded, such that the outcome of the packets is returned by the packet status query.
+	PacketLifecycleTrackingEnabled bool `protobuf:"varint,2,opt,name=packet_lifecycle_tracking_enabled,json=packetLifecycleTrackingEnabled,proto3" json:"packet_lifecycle_tracking_enabled,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -603,9 +665,84 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetPacketLifecycleTrackingEnabled() bool {
+	if m != nil {
+		return m.PacketLifecycleTrackingEnabled
+	}
+	return false
+}
+
+// PacketLifecycle records the latest stage of its lifecycle that a packet has reached on a chain, together with the
+// height and time at which it was reached. The stages reached by the packets sent by a chain are only recorded if
+// packet lifecycle tracking is enabled in the params, otherwise the stage is derived from the packet commitment,
+// receipt and acknowledgement stored by the chain and the height and timestamp are unset.
+type PacketLifecycle struct {
+	// the stage of the lifecycle reached by the packet
+	Status PacketLifecycleStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ibc.core.channel.v1.PacketLifecycleStatus" json:"status,omitempty"`
+	// the height at which the stage was reached
+	Height types.Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+	// the block timestamp (in seconds) at which the stage was reached
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *PacketLifecycle) Reset()         { *m = PacketLifecycle{} }
+func (m *PacketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*PacketLifecycle) ProtoMessage()    {}
+func (*PacketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{9}
+}
+func (m *PacketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketLifecycle.Merge(m, src)
+}
+func (m *PacketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketLifecycle proto.InternalMessageInfo
+
+func (m *PacketLifecycle) GetStatus() PacketLifecycleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketLifecycleStatus_Unknown
+}
+
+func (m *PacketLifecycle) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+func (m *PacketLifecycle) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
+	proto.RegisterEnum("ibc.core.channel.v1.PacketLifecycleStatus", PacketLifecycleStatus_name, PacketLifecycleStatus_value)
 	proto.RegisterType((*Channel)(nil), "ibc.core.channel.v1.Channel")
 	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
@@ -615,71 +752,91 @@ func init() {
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
 	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*PacketLifecycle)(nil), "ibc.core.channel.v1.PacketLifecycle")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0x55, 0xb7, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x5f,
-	0x43, 0x5a, 0xac, 0x4c, 0xfa, 0x9b, 0x2b, 0x13, 0x7c, 0x74, 0x0a, 0xb9, 0xcd, 0x8e, 0x92, 0xac,
-	0xf0, 0x0d, 0x50, 0x1e, 0x44, 0x17, 0x3e, 0xb0, 0x67, 0x21, 0x7a, 0x01, 0xf1, 0x5f, 0x6c, 0x28,
-	0x56, 0x28, 0xa4, 0x4e, 0xef, 0x7c, 0x45, 0x44, 0x61, 0x42, 0x2c, 0x2f, 0x42, 0x05, 0x7a, 0xf6,
-	0x53, 0x12, 0x52, 0x3d, 0xf1, 0xa2, 0x95, 0x7a, 0xfd, 0x8b, 0xbe, 0x31, 0x1c, 0xb4, 0xcd, 0xb6,
-	0xd9, 0x37, 0x2f, 0x5a, 0xe6, 0x4b, 0xa3, 0x31, 0x1c, 0xb4, 0x7b, 0x5d, 0xa3, 0x6e, 0x3e, 0x33,
-	0x8d, 0x86, 0x92, 0xd0, 0x8e, 0x56, 0x6b, 0xfd, 0x70, 0x87, 0x80, 0x54, 0x00, 0x1e, 0x17, 0x81,
-	0x8a, 0xa4, 0x65, 0x57, 0x6b, 0x5d, 0x8e, 0xce, 0xa8, 0x08, 0x87, 0xdc, 0xd3, 0xb7, 0xbe, 0xeb,
-	0x74, 0x8d, 0xb6, 0x92, 0xd4, 0xf6, 0x57, 0x6b, 0x3d, 0x23, 0xcc, 0x4d, 0x24, 0x73, 0xee, 0xf1,
-	0x48, 0xe6, 0x39, 0x85, 0x03, 0xee, 0xa9, 0xb7, 0x3a, 0x3d, 0xa3, 0xa1, 0xc8, 0x1a, 0xac, 0xd6,
-	0x7a, 0x9a, 0x5b, 0x48, 0x87, 0x3c, 0xf7, 0x3e, 0x6b, 0x0d, 0x7a, 0x4d, 0xb3, 0xfd, 0x5c, 0x49,
-	0x69, 0x07, 0xab, 0xb5, 0x9e, 0x8d, 0x6d, 0x74, 0x06, 0xc7, 0x5b, 0x8c, 0x7a, 0xe7, 0xdb, 0x6e,
-	0xcb, 0xe8, 0x1b, 0x4a, 0x9a, 0xd7, 0xbf, 0x03, 0x6a, 0xf2, 0xdb, 0x5f, 0x8b, 0x89, 0xb3, 0xd7,
-	0x90, 0x62, 0x4f, 0x35, 0xfa, 0x04, 0x4e, 0x3a, 0x56, 0xc3, 0xb0, 0x86, 0xed, 0x4e, 0xdb, 0xb8,
-	0xd5, 0x3d, 0x2b, 0x30, 0xc2, 0x51, 0x19, 0x0a, 0x9c, 0x35, 0x68, 0xb3, 0x5f, 0xa3, 0xa1, 0x48,
-	0xda, 0xe1, 0x6a, 0xad, 0xe7, 0x6e, 0x80, 0xa8, 0x7d, 0xce, 0x89, 0x19, 0xa2, 0x7d, 0x61, 0x72,
-	0xe1, 0xcb, 0xde, 0xbb, 0xab, 0xa2, 0xf4, 0xfe, 0xaa, 0x28, 0xfd, 0x71, 0x55, 0x94, 0x7e, 0xbe,
-	0x2e, 0x26, 0xde, 0x5f, 0x17, 0x13, 0x1f, 0xae, 0x8b, 0x89, 0x97, 0x4f, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x39, 0xda, 0x3d, 0x84, 0x94, 0x08, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x16, 0x65, 0x59, 0x8f, 0xf1, 0x8b, 0x5e, 0x37, 0x89, 0x40, 0xa4, 0x32, 0xed, 0x36, 0xa8,
+	0xe3, 0x36, 0x52, 0x5e, 0x28, 0x9a, 0xf6, 0x64, 0x53, 0x9b, 0x98, 0xb0, 0x2c, 0x09, 0xa4, 0xd4,
+	0x22, 0xb9, 0x10, 0x34, 0xb9, 0x91, 0x89, 0x48, 0x5c, 0x95, 0x5c, 0x39, 0x30, 0x7a, 0x2e, 0x10,
+	0xe8, 0xd4, 0x3f, 0x20, 0x20, 0x45, 0xcf, 0xbd, 0xf5, 0x47, 0xe4, 0x98, 0x63, 0x4e, 0x45, 0x91,
+	0xfc, 0x87, 0x9e, 0x0b, 0xee, 0x2e, 0x2d, 0xd9, 0xb0, 0xd3, 0xa0, 0x45, 0x6f, 0x3d, 0x71, 0x67,
+	0xe6, 0x9b, 0xc7, 0x7e, 0x33, 0x1c, 0x12, 0x36, 0x82, 0x43, 0xaf, 0xe6, 0xd1, 0x88, 0xd4, 0xbc,
+	0x23, 0x37, 0x0c, 0x49, 0xbf, 0x76, 0x7c, 0x27, 0x3d, 0x56, 0x87, 0x11, 0x65, 0x14, 0xad, 0x05,
+	0x87, 0x5e, 0x35, 0x81, 0x54, 0x53, 0xfd, 0xf1, 0x1d, 0xed, 0xa3, 0x1e, 0xed, 0x51, 0x6e, 0xaf,
+	0x25, 0x27, 0x01, 0xd5, 0xd6, 0xa7, 0xd1, 0xfa, 0x01, 0x09, 0x19, 0x0f, 0xc6, 0x4f, 0x02, 0xb0,
+	0xf9, 0x5b, 0x16, 0x0a, 0x86, 0x88, 0x82, 0x6e, 0xc3, 0x7c, 0xcc, 0x5c, 0x46, 0xca, 0x8a, 0xae,
+	0x6c, 0x2d, 0xdf, 0xd5, 0xaa, 0x17, 0xe4, 0xa9, 0xda, 0x09, 0xc2, 0x12, 0x40, 0xf4, 0x25, 0x14,
+	0x69, 0xe4, 0x93, 0x28, 0x08, 0x7b, 0xe5, 0xec, 0x7b, 0x9c, 0x5a, 0x09, 0xc8, 0x3a, 0xc5, 0xa2,
+	0x7d, 0x58, 0xf4, 0xe8, 0x28, 0x64, 0x24, 0x1a, 0xba, 0x11, 0x3b, 0x29, 0xcf, 0xe9, 0xca, 0xd6,
+	0xc2, 0xdd, 0x8d, 0x0b, 0x7d, 0x8d, 0x19, 0xe0, 0x6e, 0xee, 0xd5, 0xef, 0xeb, 0x19, 0xeb, 0x8c,
+	0x33, 0xfa, 0x0c, 0x56, 0x3c, 0x1a, 0x86, 0xc4, 0x63, 0x01, 0x0d, 0x9d, 0x23, 0x3a, 0x8c, 0xcb,
+	0x39, 0x7d, 0x6e, 0xab, 0x64, 0x2d, 0x4f, 0xd5, 0x7b, 0x74, 0x18, 0xa3, 0x32, 0x14, 0x8e, 0x49,
+	0x14, 0x07, 0x34, 0x2c, 0xcf, 0xeb, 0xca, 0x56, 0xc9, 0x4a, 0x45, 0x74, 0x13, 0xd4, 0xd1, 0xb0,
+	0x17, 0xb9, 0x3e, 0x71, 0x62, 0xf2, 0xfd, 0x88, 0x84, 0x1e, 0x29, 0xe7, 0x75, 0x65, 0x2b, 0x67,
+	0xad, 0x48, 0xbd, 0x2d, 0xd5, 0x5f, 0xe7, 0x5e, 0xbc, 0x5c, 0xcf, 0x6c, 0xfe, 0x99, 0x85, 0x55,
+	0xd3, 0x27, 0x21, 0x0b, 0x9e, 0x06, 0xc4, 0xff, 0x9f, 0xc0, 0x6b, 0x50, 0x18, 0xd2, 0x88, 0x39,
+	0x81, 0xcf, 0x79, 0x2b, 0x59, 0xf9, 0x44, 0x34, 0x7d, 0xf4, 0x31, 0x80, 0x2c, 0x25, 0xb1, 0x15,
+	0xb8, 0xad, 0x24, 0x35, 0xa6, 0x7f, 0x21, 0xf1, 0xc5, 0xf7, 0x11, 0xdf, 0x80, 0xc5, 0xd9, 0xfb,
+	0xcc, 0x26, 0x56, 0xde, 0x93, 0x38, 0x7b, 0x2e, 0xb1, 0x8c, 0xf6, 0x26, 0x0b, 0xf9, 0xb6, 0xeb,
+	0x3d, 0x23, 0x0c, 0x69, 0x50, 0x3c, 0xad, 0x40, 0xe1, 0x15, 0x9c, 0xca, 0x68, 0x1d, 0x16, 0x62,
+	0x3a, 0x8a, 0x3c, 0xe2, 0x24, 0xc1, 0x65, 0x30, 0x10, 0xaa, 0x36, 0x8d, 0x18, 0xba, 0x01, 0xcb,
+	0x12, 0x20, 0x33, 0xf0, 0x86, 0x94, 0xac, 0x25, 0xa1, 0x4d, 0xe7, 0xe3, 0x26, 0xa8, 0x3e, 0x89,
+	0x59, 0x10, 0xba, 0x9c, 0x69, 0x1e, 0x2c, 0xc7, 0x81, 0x2b, 0x33, 0x7a, 0x1e, 0xb1, 0x06, 0x6b,
+	0xb3, 0xd0, 0x34, 0xac, 0xa0, 0x1d, 0xcd, 0x98, 0xd2, 0xd8, 0x08, 0x72, 0xbe, 0xcb, 0x5c, 0x4e,
+	0xff, 0xa2, 0xc5, 0xcf, 0xe8, 0x11, 0x2c, 0xb3, 0x60, 0x40, 0xe8, 0x88, 0x39, 0x47, 0x24, 0xe8,
+	0x1d, 0x31, 0xde, 0x80, 0x85, 0x33, 0x33, 0x26, 0x96, 0xc1, 0xf1, 0x9d, 0xea, 0x1e, 0x47, 0xc8,
+	0x01, 0x59, 0x92, 0x7e, 0x42, 0x89, 0x3e, 0x87, 0xd5, 0x34, 0x50, 0xf2, 0x8c, 0x99, 0x3b, 0x18,
+	0xca, 0x3e, 0xa9, 0xd2, 0xd0, 0x49, 0xf5, 0x92, 0xda, 0x1f, 0x60, 0x41, 0x30, 0xcb, 0xe7, 0xfd,
+	0x9f, 0xf6, 0xe9, 0x4c, 0x5b, 0xe6, 0xce, 0xb5, 0x25, 0xbd, 0x72, 0x6e, 0x7a, 0x65, 0x99, 0xdc,
+	0x87, 0xa2, 0x48, 0x6e, 0xfa, 0xff, 0x45, 0x66, 0x99, 0xa5, 0x05, 0x2b, 0x3b, 0xde, 0xb3, 0x90,
+	0x3e, 0xef, 0x13, 0xbf, 0x47, 0x06, 0x24, 0x64, 0xa8, 0x0c, 0xf9, 0x88, 0xc4, 0xa3, 0x3e, 0x2b,
+	0x5f, 0x49, 0x8a, 0xda, 0xcb, 0x58, 0x52, 0x46, 0x57, 0x61, 0x9e, 0x44, 0x11, 0x8d, 0xca, 0x57,
+	0x93, 0x44, 0x7b, 0x19, 0x4b, 0x88, 0xbb, 0x00, 0xc5, 0x88, 0xc4, 0x43, 0x1a, 0xc6, 0x64, 0xd3,
+	0x85, 0x42, 0x47, 0xb0, 0x89, 0xbe, 0x82, 0xbc, 0x6c, 0x99, 0xf2, 0x81, 0x2d, 0x93, 0x78, 0x74,
+	0x1d, 0x4a, 0xd3, 0x1e, 0x65, 0x79, 0xe1, 0x53, 0xc5, 0xe6, 0x4b, 0x25, 0x99, 0xf8, 0xc8, 0x1d,
+	0xc4, 0x68, 0x1f, 0xd2, 0x77, 0xcc, 0x91, 0x3d, 0x94, 0xb9, 0xae, 0x5f, 0xb8, 0x46, 0x64, 0x65,
+	0x32, 0xdb, 0xb2, 0x74, 0x4d, 0xeb, 0x35, 0x61, 0x63, 0xc8, 0x19, 0x77, 0xfa, 0xc1, 0x53, 0xe2,
+	0x9d, 0x78, 0x7d, 0xe2, 0xb0, 0xc8, 0xf5, 0x9e, 0x05, 0x61, 0xcf, 0x21, 0xa1, 0x7b, 0xd8, 0x27,
+	0x82, 0xe3, 0xa2, 0x55, 0x11, 0xc0, 0x46, 0x8a, 0xeb, 0x48, 0x18, 0x16, 0xa8, 0xcd, 0x5f, 0x15,
+	0x58, 0x69, 0x9f, 0x85, 0xa0, 0x5d, 0xc8, 0x27, 0x0b, 0x73, 0x14, 0xcb, 0xd5, 0xba, 0x7d, 0x61,
+	0x89, 0xe7, 0xbc, 0x6c, 0xee, 0x61, 0x49, 0xcf, 0x19, 0x4a, 0xb3, 0xff, 0x86, 0xd2, 0xb9, 0x73,
+	0x94, 0x6e, 0xff, 0x98, 0x85, 0x79, 0x5b, 0x6e, 0xf3, 0x75, 0xbb, 0xb3, 0xd3, 0xc1, 0x4e, 0xb7,
+	0x69, 0x36, 0xcd, 0x8e, 0xb9, 0xd3, 0x30, 0x9f, 0xe0, 0xba, 0xd3, 0x6d, 0xda, 0x6d, 0x6c, 0x98,
+	0x0f, 0x4d, 0x5c, 0x57, 0x33, 0xda, 0xea, 0x78, 0xa2, 0x2f, 0x9d, 0x01, 0xa0, 0x32, 0x80, 0xf0,
+	0x4b, 0x94, 0xaa, 0xa2, 0x15, 0xc7, 0x13, 0x3d, 0x97, 0x9c, 0x51, 0x05, 0x96, 0x84, 0xa5, 0x63,
+	0x3d, 0x6e, 0xb5, 0x71, 0x53, 0xcd, 0x6a, 0x0b, 0xe3, 0x89, 0x5e, 0x90, 0xe2, 0xd4, 0x93, 0x1b,
+	0xe7, 0x84, 0x27, 0xb7, 0x5c, 0x87, 0x45, 0x61, 0x31, 0x1a, 0x2d, 0x1b, 0xd7, 0xd5, 0x9c, 0x06,
+	0xe3, 0x89, 0x9e, 0x17, 0x12, 0xd2, 0x61, 0x59, 0x58, 0x1f, 0x36, 0xba, 0xf6, 0x9e, 0xd9, 0x7c,
+	0xa4, 0xce, 0x6b, 0x8b, 0xe3, 0x89, 0x5e, 0x4c, 0x65, 0xb4, 0x0d, 0x6b, 0x33, 0x08, 0xa3, 0x75,
+	0xd0, 0x6e, 0xe0, 0x0e, 0x56, 0xf3, 0xa2, 0xfe, 0x33, 0x4a, 0x2d, 0xf7, 0xe2, 0x97, 0x4a, 0x66,
+	0xfb, 0x39, 0xcc, 0xf3, 0xcf, 0x14, 0xfa, 0x14, 0xae, 0xb6, 0xac, 0x3a, 0xb6, 0x9c, 0x66, 0xab,
+	0x89, 0xcf, 0xdd, 0x9e, 0x17, 0x98, 0xe8, 0xd1, 0x26, 0xac, 0x08, 0x54, 0xb7, 0xc9, 0x9f, 0xb8,
+	0xae, 0x2a, 0xda, 0xd2, 0x78, 0xa2, 0x97, 0x4e, 0x15, 0xc9, 0xf5, 0x05, 0x26, 0x45, 0xc8, 0xeb,
+	0x4b, 0x51, 0x26, 0xfe, 0x39, 0x07, 0x57, 0x2e, 0x6c, 0x3d, 0xba, 0x0f, 0x9f, 0xb4, 0x77, 0x8c,
+	0x7d, 0xdc, 0x71, 0x1a, 0xe6, 0x43, 0x6c, 0x3c, 0x36, 0x1a, 0xd8, 0x49, 0x6e, 0xd5, 0xb5, 0xcf,
+	0x95, 0xc5, 0xa3, 0x76, 0xc3, 0xe4, 0x65, 0x0e, 0xd1, 0x7d, 0xd8, 0xb8, 0xcc, 0xcb, 0x68, 0x1d,
+	0x1c, 0x98, 0x9d, 0xce, 0xb4, 0x56, 0x83, 0x0e, 0x06, 0x01, 0x63, 0xc4, 0x47, 0xdf, 0xc0, 0x8d,
+	0xcb, 0xbc, 0x76, 0xec, 0xc7, 0x4d, 0xc3, 0x69, 0xe3, 0x66, 0x3d, 0x61, 0x3a, 0xab, 0xa9, 0xe3,
+	0x89, 0xbe, 0xb8, 0x13, 0x9f, 0x84, 0x5e, 0x9b, 0x84, 0x7e, 0xf2, 0x3d, 0x37, 0xe1, 0x8b, 0x4b,
+	0x9d, 0x8d, 0xfd, 0x66, 0xeb, 0xbb, 0x06, 0xae, 0x3f, 0xc2, 0x75, 0xc7, 0xee, 0x1a, 0x06, 0xb6,
+	0x6d, 0x75, 0x4e, 0xbb, 0x36, 0x9e, 0xe8, 0x6b, 0x33, 0xeb, 0xc7, 0xb7, 0x47, 0x9e, 0x47, 0xe2,
+	0x18, 0x61, 0xd8, 0xfe, 0xa0, 0x50, 0xd8, 0xb2, 0x5a, 0x96, 0x9a, 0xd3, 0xae, 0x8c, 0x27, 0xfa,
+	0xea, 0x6c, 0x20, 0x9c, 0xec, 0x25, 0x74, 0xef, 0x72, 0x12, 0x3a, 0xe6, 0x01, 0xae, 0x3b, 0xad,
+	0x6e, 0x27, 0x1d, 0x9a, 0x64, 0x09, 0xf8, 0xad, 0x11, 0x43, 0x55, 0xa8, 0x5c, 0xe6, 0xd4, 0xb6,
+	0xba, 0x4d, 0x5c, 0x57, 0xf3, 0x62, 0x0c, 0xdb, 0xd1, 0x28, 0x24, 0x3e, 0xba, 0x0b, 0xfa, 0x65,
+	0x78, 0x0b, 0x1b, 0xd8, 0xfc, 0x16, 0xd7, 0xd5, 0x82, 0xc8, 0x61, 0x11, 0x8f, 0x04, 0xc7, 0xc4,
+	0xff, 0x9b, 0xee, 0xf0, 0x81, 0xac, 0xab, 0xc5, 0xd3, 0xee, 0x0c, 0xfb, 0x84, 0x11, 0x7f, 0xd7,
+	0x7e, 0xf5, 0xb6, 0xa2, 0xbc, 0x7e, 0x5b, 0x51, 0xfe, 0x78, 0x5b, 0x51, 0x7e, 0x7a, 0x57, 0xc9,
+	0xbc, 0x7e, 0x57, 0xc9, 0xbc, 0x79, 0x57, 0xc9, 0x3c, 0x79, 0xd0, 0x0b, 0xd8, 0xd1, 0xe8, 0xb0,
+	0xea, 0xd1, 0x41, 0xcd, 0xa3, 0xf1, 0x80, 0xc6, 0xb5, 0xe0, 0xd0, 0xbb, 0xd5, 0xa3, 0xb5, 0xe3,
+	0x07, 0xb5, 0x01, 0xf5, 0x47, 0x7d, 0x12, 0x8b, 0x5f, 0xe8, 0xdb, 0xf7, 0x6f, 0xa5, 0xff, 0xe4,
+	0xec, 0x64, 0x48, 0xe2, 0xc3, 0x3c, 0xff, 0x87, 0xbe, 0xf7, 0xd7, 0x00, 0x32, 0x3b, 0x73, 0x20,
+	0xb4, 0x0b, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -1154,6 +1311,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PacketLifecycleTrackingEnabled {
+		i--
+		if m.PacketLifecycleTrackingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1167,6 +1334,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChannel(v)
 	base := offset
@@ -1400,6 +1610,26 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	if m.PacketLifecycleTrackingEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *PacketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovChannel(uint64(m.Status))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovChannel(uint64(m.Timestamp))
+	}
 	return n
 }
 
@@ -2857,6 +3087,147 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketLifecycleTrackingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketLifecycleTrackingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketLifecycleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
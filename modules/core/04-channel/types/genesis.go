@@ -44,37 +44,58 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewSendPacketLifecycle creates a new SendPacketLifecycle instance.
+func NewSendPacketLifecycle(portID, channelID string, seq uint64, lifecycle PacketLifecycle) SendPacketLifecycle {
+	return SendPacketLifecycle{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+		Lifecycle: lifecycle,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (spl SendPacketLifecycle) Validate() error {
+	if spl.Lifecycle.Status == PacketLifecycleStatus_Unknown {
+		return errors.New("packet lifecycle status cannot be unspecified")
+	}
+	return validateGenFields(spl.PortId, spl.ChannelId, spl.Sequence)
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
 	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64,
-	params Params,
+	sendPacketLifecycles []SendPacketLifecycle, params Params,
 ) GenesisState {
 	return GenesisState{
-		Channels:            channels,
-		Acknowledgements:    acks,
-		Receipts:            receipts,
-		Commitments:         commitments,
-		SendSequences:       sendSeqs,
-		RecvSequences:       recvSeqs,
-		AckSequences:        ackSeqs,
-		NextChannelSequence: nextChannelSequence,
-		Params:              params,
+		Channels:             channels,
+		Acknowledgements:     acks,
+		Receipts:             receipts,
+		Commitments:          commitments,
+		SendSequences:        sendSeqs,
+		RecvSequences:        recvSeqs,
+		AckSequences:         ackSeqs,
+		NextChannelSequence:  nextChannelSequence,
+		SendPacketLifecycles: sendPacketLifecycles,
+		Params:               params,
 	}
 }
 
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
-		Params:              DefaultParams(),
+		Channels:             []IdentifiedChannel{},
+		Acknowledgements:     []PacketState{},
+		Receipts:             []PacketState{},
+		Commitments:          []PacketState{},
+		SendSequences:        []PacketSequence{},
+		RecvSequences:        []PacketSequence{},
+		AckSequences:         []PacketSequence{},
+		NextChannelSequence:  0,
+		SendPacketLifecycles: []SendPacketLifecycle{},
+		Params:               DefaultParams(),
 	}
 }
 
@@ -146,6 +167,12 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, spl := range gs.SendPacketLifecycles {
+		if err := spl.Validate(); err != nil {
+			return fmt.Errorf("invalid send packet lifecycle %v index %d: %w", spl, i, err)
+		}
+	}
+
 	return nil
 }
 
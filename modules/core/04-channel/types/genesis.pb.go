@@ -35,6 +35,8 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the lifecycle records of the packets sent by this chain.
+	SendPacketLifecycles []SendPacketLifecycle `protobuf:"bytes,10,rep,name=send_packet_lifecycles,json=sendPacketLifecycles,proto3" json:"send_packet_lifecycles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +135,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSendPacketLifecycles() []SendPacketLifecycle {
+	if m != nil {
+		return m.SendPacketLifecycles
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,45 +204,120 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// SendPacketLifecycle defines the genesis type necessary to retrieve and store the lifecycle
+// record of a packet sent on a channel.
+type SendPacketLifecycle struct {
+	PortId    string          `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64          `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Lifecycle PacketLifecycle `protobuf:"bytes,4,opt,name=lifecycle,proto3" json:"lifecycle"`
+}
+
+func (m *SendPacketLifecycle) Reset()         { *m = SendPacketLifecycle{} }
+func (m *SendPacketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*SendPacketLifecycle) ProtoMessage()    {}
+func (*SendPacketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{2}
+}
+func (m *SendPacketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendPacketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendPacketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendPacketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendPacketLifecycle.Merge(m, src)
+}
+func (m *SendPacketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendPacketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendPacketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendPacketLifecycle proto.InternalMessageInfo
+
+func (m *SendPacketLifecycle) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *SendPacketLifecycle) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *SendPacketLifecycle) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SendPacketLifecycle) GetLifecycle() PacketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return PacketLifecycle{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
+	proto.RegisterType((*SendPacketLifecycle)(nil), "ibc.core.channel.v1.SendPacketLifecycle")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xd2, 0x6b, 0x39, 0x40, 0x2f, 0x68, 0xca, 0x6e, 0x48, 0xa8, 0x4a, 0xec,
-	0x8f, 0xcc, 0x6a, 0x63, 0x87, 0xd8, 0x2d, 0xf0, 0x2f, 0xf8, 0x2d, 0xfc, 0x8a, 0x1d, 0x77, 0xe4,
-	0x34, 0xa1, 0xf6, 0x5f, 0x70, 0x42, 0x71, 0x9c, 0xac, 0x68, 0x05, 0xa9, 0xb7, 0xf8, 0xfb, 0xde,
-	0xf7, 0x79, 0xdf, 0x43, 0x3e, 0xf4, 0x8c, 0x47, 0x94, 0x50, 0x99, 0x01, 0xa1, 0x97, 0xa1, 0x10,
-	0x30, 0x23, 0x8b, 0x33, 0x12, 0x83, 0x00, 0xc5, 0x95, 0x9f, 0x66, 0x52, 0x4b, 0x7c, 0xc4, 0x23,
-	0xea, 0xe7, 0x12, 0xdf, 0x4a, 0xfc, 0xc5, 0x59, 0xf7, 0x41, 0x2c, 0x63, 0x69, 0xf6, 0x24, 0xff,
-	0x2a, 0xa4, 0xdd, 0x8d, 0xb4, 0xd2, 0x65, 0x24, 0x27, 0x3f, 0x76, 0xd1, 0xfe, 0xdb, 0x82, 0x7f,
-	0xa1, 0x43, 0x0d, 0xf8, 0x23, 0x6a, 0x59, 0x85, 0x72, 0x9d, 0x5e, 0xbd, 0xdf, 0x19, 0xbc, 0xf0,
-	0x37, 0x24, 0xfa, 0x63, 0x06, 0x42, 0xf3, 0x4f, 0x1c, 0xd8, 0x9b, 0x62, 0x38, 0x7a, 0x7c, 0x75,
-	0x73, 0x5c, 0xfb, 0x7d, 0x73, 0x7c, 0xff, 0xce, 0x2a, 0xa8, 0x90, 0x38, 0x40, 0xf7, 0x42, 0x3a,
-	0x15, 0xf2, 0xcb, 0x0c, 0x58, 0x0c, 0x09, 0x08, 0xad, 0xdc, 0x1d, 0x13, 0xd3, 0xdb, 0x18, 0x73,
-	0x1e, 0xd2, 0x29, 0x68, 0x53, 0x6d, 0xd4, 0xc8, 0x03, 0x82, 0x3b, 0x7e, 0xfc, 0x0e, 0x75, 0xa8,
-	0x4c, 0x12, 0xae, 0x0b, 0x5c, 0x7d, 0x2b, 0xdc, 0xba, 0x15, 0x8f, 0x50, 0x2b, 0x03, 0x0a, 0x3c,
-	0xd5, 0xca, 0x6d, 0x6c, 0x85, 0xa9, 0x7c, 0xf8, 0x1c, 0x1d, 0x2a, 0x10, 0x6c, 0xa2, 0xe0, 0xf3,
-	0x1c, 0x04, 0x05, 0xe5, 0xee, 0x1a, 0xd2, 0xf3, 0xff, 0x91, 0xac, 0xd6, 0xc2, 0x0e, 0x72, 0x40,
-	0x39, 0x33, 0xc4, 0x0c, 0xe8, 0x62, 0x8d, 0xd8, 0xdc, 0x9a, 0x98, 0x03, 0x6e, 0x89, 0xef, 0xd1,
-	0x41, 0x48, 0xa7, 0x6b, 0xc0, 0xbd, 0x6d, 0x81, 0xfb, 0x21, 0x9d, 0xde, 0xf2, 0x06, 0xe8, 0xa1,
-	0x80, 0xaf, 0x7a, 0x62, 0x5d, 0x15, 0xd8, 0x6d, 0xf5, 0x9c, 0x7e, 0x23, 0x38, 0xca, 0x97, 0xf6,
-	0x5f, 0x28, 0x4d, 0x78, 0x88, 0x9a, 0x69, 0x98, 0x85, 0x89, 0x72, 0xdb, 0x3d, 0xa7, 0xdf, 0x19,
-	0x3c, 0xf9, 0x47, 0x78, 0x2e, 0xb1, 0xa1, 0xd6, 0x70, 0xc2, 0xd0, 0xe1, 0xdf, 0xa5, 0xf0, 0x23,
-	0xb4, 0x97, 0xca, 0x4c, 0x4f, 0x38, 0x73, 0x9d, 0x9e, 0xd3, 0x6f, 0x07, 0xcd, 0xfc, 0x39, 0x66,
-	0xf8, 0x29, 0x42, 0x65, 0x29, 0xce, 0xdc, 0x1d, 0xb3, 0x6b, 0xdb, 0xc9, 0x98, 0xe1, 0x2e, 0x6a,
-	0x55, 0x5d, 0xeb, 0xa6, 0x6b, 0xf5, 0x1e, 0x5d, 0x5c, 0x2d, 0x3d, 0xe7, 0x7a, 0xe9, 0x39, 0xbf,
-	0x96, 0x9e, 0xf3, 0x7d, 0xe5, 0xd5, 0xae, 0x57, 0x5e, 0xed, 0xe7, 0xca, 0xab, 0x7d, 0x18, 0xc6,
-	0x5c, 0x5f, 0xce, 0x23, 0x9f, 0xca, 0x84, 0x50, 0xa9, 0x12, 0xa9, 0x08, 0x8f, 0xe8, 0x69, 0x2c,
-	0xc9, 0x62, 0x48, 0x12, 0xc9, 0xe6, 0x33, 0x50, 0xc5, 0xdd, 0xbd, 0x7c, 0x7d, 0x5a, 0x9e, 0x9e,
-	0xfe, 0x96, 0x82, 0x8a, 0x9a, 0xe6, 0xec, 0x5e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x06, 0xa2,
-	0xe9, 0xa6, 0xe9, 0x03, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0xd9, 0x82, 0x14, 0x86, 0xb6, 0xd1, 0xa1, 0xea, 0x8a, 0x71, 0x8b, 0x68, 0x0c, 0x97,
+	0xee, 0x5a, 0xf4, 0xc2, 0x15, 0x0f, 0x96, 0xc4, 0x98, 0x06, 0x6e, 0x26, 0x86, 0x2c, 0x33, 0xaf,
+	0xdb, 0x09, 0xec, 0xce, 0xba, 0x33, 0xa0, 0xfd, 0x16, 0x1e, 0xfd, 0x1e, 0x7e, 0x89, 0x1e, 0x7b,
+	0xf4, 0xd4, 0x18, 0xf8, 0x16, 0x9e, 0xcc, 0xce, 0xce, 0x2e, 0x28, 0x6b, 0x13, 0x0e, 0xde, 0x98,
+	0x79, 0xff, 0xff, 0xef, 0x3f, 0xef, 0xb1, 0x79, 0xe8, 0x29, 0x1b, 0x13, 0x87, 0xf0, 0x08, 0x1c,
+	0x72, 0xe1, 0x06, 0x01, 0x4c, 0x9d, 0xf9, 0x89, 0xe3, 0x41, 0x00, 0x82, 0x09, 0x3b, 0x8c, 0xb8,
+	0xe4, 0xb8, 0xce, 0xc6, 0xc4, 0x8e, 0x25, 0xb6, 0x96, 0xd8, 0xf3, 0x93, 0xc6, 0xa1, 0xc7, 0x3d,
+	0xae, 0xea, 0x4e, 0xfc, 0x2b, 0x91, 0x36, 0x72, 0x69, 0xa9, 0x4b, 0x49, 0x5a, 0xdf, 0xca, 0x68,
+	0xef, 0x6d, 0xc2, 0x1f, 0x4a, 0x57, 0x02, 0xfe, 0x88, 0x2a, 0x5a, 0x21, 0x4c, 0xa3, 0x59, 0x6c,
+	0xd7, 0x3a, 0x2f, 0xec, 0x9c, 0x44, 0xbb, 0x4f, 0x21, 0x90, 0xec, 0x9c, 0x01, 0x7d, 0x93, 0x5c,
+	0xf6, 0x1e, 0x5d, 0xdd, 0x1c, 0x15, 0x7e, 0xdd, 0x1c, 0xdd, 0xdb, 0x28, 0x0d, 0x32, 0x24, 0x1e,
+	0xa0, 0xbb, 0x2e, 0x99, 0x04, 0xfc, 0xf3, 0x14, 0xa8, 0x07, 0x3e, 0x04, 0x52, 0x98, 0x3b, 0x2a,
+	0xa6, 0x99, 0x1b, 0x73, 0xe6, 0x92, 0x09, 0x48, 0xf5, 0xb4, 0x5e, 0x29, 0x0e, 0x18, 0x6c, 0xf8,
+	0xf1, 0x29, 0xaa, 0x11, 0xee, 0xfb, 0x4c, 0x26, 0xb8, 0xe2, 0x56, 0xb8, 0x75, 0x2b, 0xee, 0xa1,
+	0x4a, 0x04, 0x04, 0x58, 0x28, 0x85, 0x59, 0xda, 0x0a, 0x93, 0xf9, 0xf0, 0x19, 0x3a, 0x10, 0x10,
+	0xd0, 0x91, 0x80, 0x4f, 0x33, 0x08, 0x08, 0x08, 0xf3, 0x8e, 0x22, 0x3d, 0xbb, 0x8d, 0xa4, 0xb5,
+	0x1a, 0xb6, 0x1f, 0x03, 0xd2, 0x3b, 0x45, 0x8c, 0x80, 0xcc, 0xd7, 0x88, 0xe5, 0xad, 0x89, 0x31,
+	0x60, 0x45, 0x7c, 0x8f, 0xf6, 0x5d, 0x32, 0x59, 0x03, 0xee, 0x6e, 0x0b, 0xdc, 0x73, 0xc9, 0x64,
+	0xc5, 0xeb, 0xa0, 0xfb, 0x01, 0x7c, 0x91, 0x23, 0xed, 0xca, 0xc0, 0x66, 0xa5, 0x69, 0xb4, 0x4b,
+	0x83, 0x7a, 0x5c, 0xd4, 0xdf, 0x42, 0x6a, 0xc2, 0x5d, 0x54, 0x0e, 0xdd, 0xc8, 0xf5, 0x85, 0x59,
+	0x6d, 0x1a, 0xed, 0x5a, 0xe7, 0xf1, 0x3f, 0xc2, 0x63, 0x89, 0x0e, 0xd5, 0x06, 0x4c, 0xd1, 0x03,
+	0x35, 0xe2, 0x50, 0xbd, 0x6c, 0x34, 0x65, 0xe7, 0x40, 0x2e, 0xc9, 0x14, 0x84, 0x89, 0x54, 0x1f,
+	0xed, 0x5c, 0xd4, 0x10, 0x02, 0x9a, 0xf4, 0xf2, 0x2e, 0x35, 0x68, 0xee, 0xa1, 0xd8, 0x2c, 0x89,
+	0x16, 0x45, 0x07, 0x7f, 0xb6, 0x8e, 0x1f, 0xa2, 0xdd, 0x90, 0x47, 0x72, 0xc4, 0xa8, 0x69, 0x34,
+	0x8d, 0x76, 0x75, 0x50, 0x8e, 0x8f, 0x7d, 0x8a, 0x9f, 0x20, 0x94, 0xb6, 0xce, 0xa8, 0xb9, 0xa3,
+	0x6a, 0x55, 0x7d, 0xd3, 0xa7, 0xb8, 0x81, 0x2a, 0xd9, 0x44, 0x8a, 0x6a, 0x22, 0xd9, 0xb9, 0xf5,
+	0xdd, 0x40, 0xf5, 0x9c, 0x97, 0xfd, 0x8f, 0x2c, 0x7c, 0x8a, 0xaa, 0xd9, 0xac, 0xcc, 0x92, 0x9a,
+	0xfa, 0xf3, 0x5b, 0xfe, 0xf2, 0xbf, 0xc7, 0xb4, 0x32, 0xf7, 0x86, 0x57, 0x0b, 0xcb, 0xb8, 0x5e,
+	0x58, 0xc6, 0xcf, 0x85, 0x65, 0x7c, 0x5d, 0x5a, 0x85, 0xeb, 0xa5, 0x55, 0xf8, 0xb1, 0xb4, 0x0a,
+	0x1f, 0xba, 0x1e, 0x93, 0x17, 0xb3, 0xb1, 0x4d, 0xb8, 0xef, 0x10, 0x2e, 0x7c, 0x2e, 0x1c, 0x36,
+	0x26, 0xc7, 0x1e, 0x77, 0xe6, 0x5d, 0xc7, 0xe7, 0x74, 0x36, 0x05, 0x91, 0xec, 0xa4, 0x97, 0xaf,
+	0x8f, 0xd3, 0xb5, 0x24, 0x2f, 0x43, 0x10, 0xe3, 0xb2, 0x5a, 0x49, 0xaf, 0x7e, 0x0f, 0x00, 0x81,
+	0x6d, 0x2b, 0x0e, 0x05, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +340,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SendPacketLifecycles) > 0 {
+		for iNdEx := len(m.SendPacketLifecycles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendPacketLifecycles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -414,6 +512,58 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SendPacketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendPacketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendPacketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -478,6 +628,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SendPacketLifecycles) > 0 {
+		for _, e := range m.SendPacketLifecycles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +657,28 @@ func (m *PacketSequence) Size() (n int) {
 	return n
 }
 
+func (m *SendPacketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Lifecycle.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,6 +1004,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPacketLifecycles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPacketLifecycles = append(m.SendPacketLifecycles, SendPacketLifecycle{})
+			if err := m.SendPacketLifecycles[len(m.SendPacketLifecycles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -980,6 +1192,172 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SendPacketLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendPacketLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendPacketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
@@ -69,6 +70,9 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				2,
+				[]types.SendPacketLifecycle{
+					types.NewSendPacketLifecycle(testPort1, testChannel1, 1, types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)),
+				},
 				types.Params{UpgradeTimeout: types.DefaultTimeout},
 			),
 			expErr: nil,
@@ -140,6 +144,15 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: types.ErrInvalidAcknowledgement,
 		},
+		{
+			name: "invalid send packet lifecycle",
+			genState: types.GenesisState{
+				SendPacketLifecycles: []types.SendPacketLifecycle{
+					types.NewSendPacketLifecycle(testPort1, "(testChannel1)", 1, types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)),
+				},
+			},
+			expErr: host.ErrInvalidID,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -174,6 +187,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				nil,
 				types.Params{UpgradeTimeout: types.DefaultTimeout},
 			),
 			expErr: host.ErrInvalidID,
@@ -212,6 +226,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				nil,
 				types.Params{UpgradeTimeout: types.DefaultTimeout},
 			),
 			expErr: ibcerrors.ErrInvalidSequence,
@@ -140,3 +140,12 @@ func (p PacketId) Validate() error {
 func NewPacketID(portID, channelID string, seq uint64) PacketId {
 	return PacketId{PortId: portID, ChannelId: channelID, Sequence: seq}
 }
+
+// NewPacketLifecycle returns a new instance of PacketLifecycle
+func NewPacketLifecycle(status PacketLifecycleStatus, height clienttypes.Height, timestamp uint64) PacketLifecycle {
+	return PacketLifecycle{
+		Status:    status,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
@@ -72,6 +72,14 @@ func NewQueryPacketReceiptResponse(
 	}
 }
 
+// NewQueryPacketStatusResponse creates a new QueryPacketStatusResponse instance
+func NewQueryPacketStatusResponse(lifecycle PacketLifecycle, height clienttypes.Height) *QueryPacketStatusResponse {
+	return &QueryPacketStatusResponse{
+		Lifecycle: lifecycle,
+		Height:    height,
+	}
+}
+
 // NewQueryPacketAcknowledgementResponse creates a new QueryPacketAcknowledgementResponse instance
 func NewQueryPacketAcknowledgementResponse(
 	acknowledgement []byte, proof []byte, height clienttypes.Height,
@@ -1044,6 +1044,135 @@ func (m *QueryPacketReceiptResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryPacketStatusRequest is the request type for the Query/PacketStatus RPC method
+type QueryPacketStatusRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// if true, the packet received by this chain on the channel is queried,
+	// otherwise the packet sent by this chain on the channel is queried
+	Destination bool `protobuf:"varint,4,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (m *QueryPacketStatusRequest) Reset()         { *m = QueryPacketStatusRequest{} }
+func (m *QueryPacketStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketStatusRequest) ProtoMessage()    {}
+func (*QueryPacketStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{16}
+}
+func (m *QueryPacketStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketStatusRequest.Merge(m, src)
+}
+func (m *QueryPacketStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketStatusRequest proto.InternalMessageInfo
+
+func (m *QueryPacketStatusRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPacketStatusRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPacketStatusRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryPacketStatusRequest) GetDestination() bool {
+	if m != nil {
+		return m.Destination
+	}
+	return false
+}
+
+// QueryPacketStatusResponse is the response type for the Query/PacketStatus RPC method
+type QueryPacketStatusResponse struct {
+	// the stage of its lifecycle that the packet has reached
+	Lifecycle PacketLifecycle `protobuf:"bytes,1,opt,name=lifecycle,proto3" json:"lifecycle"`
+	// height at which the status was queried
+	Height types.Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryPacketStatusResponse) Reset()         { *m = QueryPacketStatusResponse{} }
+func (m *QueryPacketStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketStatusResponse) ProtoMessage()    {}
+func (*QueryPacketStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{17}
+}
+func (m *QueryPacketStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketStatusResponse.Merge(m, src)
+}
+func (m *QueryPacketStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPacketStatusResponse) GetLifecycle() PacketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return PacketLifecycle{}
+}
+
+func (m *QueryPacketStatusResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
 // QueryPacketAcknowledgementRequest is the request type for the
 // Query/PacketAcknowledgement RPC method
 type QueryPacketAcknowledgementRequest struct {
@@ -1059,7 +1188,7 @@ func (m *QueryPacketAcknowledgementRequest) Reset()         { *m = QueryPacketAc
 func (m *QueryPacketAcknowledgementRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{16}
+	return fileDescriptor_1034a1e9abc4cca1, []int{18}
 }
 func (m *QueryPacketAcknowledgementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1254,7 @@ func (m *QueryPacketAcknowledgementResponse) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{17}
+	return fileDescriptor_1034a1e9abc4cca1, []int{19}
 }
 func (m *QueryPacketAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1321,7 @@ func (m *QueryPacketAcknowledgementsRequest) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{18}
+	return fileDescriptor_1034a1e9abc4cca1, []int{20}
 }
 func (m *QueryPacketAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,7 +1392,7 @@ func (m *QueryPacketAcknowledgementsResponse) Reset()         { *m = QueryPacket
 func (m *QueryPacketAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{19}
+	return fileDescriptor_1034a1e9abc4cca1, []int{21}
 }
 func (m *QueryPacketAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1457,7 @@ func (m *QueryUnreceivedPacketsRequest) Reset()         { *m = QueryUnreceivedPa
 func (m *QueryUnreceivedPacketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsRequest) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{20}
+	return fileDescriptor_1034a1e9abc4cca1, []int{22}
 }
 func (m *QueryUnreceivedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1520,7 @@ func (m *QueryUnreceivedPacketsResponse) Reset()         { *m = QueryUnreceivedP
 func (m *QueryUnreceivedPacketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsResponse) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{21}
+	return fileDescriptor_1034a1e9abc4cca1, []int{23}
 }
 func (m *QueryUnreceivedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1578,7 @@ func (m *QueryUnreceivedAcksRequest) Reset()         { *m = QueryUnreceivedAcksR
 func (m *QueryUnreceivedAcksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksRequest) ProtoMessage()    {}
 func (*QueryUnreceivedAcksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{22}
+	return fileDescriptor_1034a1e9abc4cca1, []int{24}
 }
 func (m *QueryUnreceivedAcksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1641,7 @@ func (m *QueryUnreceivedAcksResponse) Reset()         { *m = QueryUnreceivedAcks
 func (m *QueryUnreceivedAcksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksResponse) ProtoMessage()    {}
 func (*QueryUnreceivedAcksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{23}
+	return fileDescriptor_1034a1e9abc4cca1, []int{25}
 }
 func (m *QueryUnreceivedAcksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1697,7 @@ func (m *QueryNextSequenceReceiveRequest) Reset()         { *m = QueryNextSequen
 func (m *QueryNextSequenceReceiveRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceReceiveRequest) ProtoMessage()    {}
 func (*QueryNextSequenceReceiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{24}
+	return fileDescriptor_1034a1e9abc4cca1, []int{26}
 }
 func (m *QueryNextSequenceReceiveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1755,7 @@ func (m *QueryNextSequenceReceiveResponse) Reset()         { *m = QueryNextSeque
 func (m *QueryNextSequenceReceiveResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceReceiveResponse) ProtoMessage()    {}
 func (*QueryNextSequenceReceiveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{25}
+	return fileDescriptor_1034a1e9abc4cca1, []int{27}
 }
 func (m *QueryNextSequenceReceiveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +1818,7 @@ func (m *QueryNextSequenceSendRequest) Reset()         { *m = QueryNextSequenceS
 func (m *QueryNextSequenceSendRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceSendRequest) ProtoMessage()    {}
 func (*QueryNextSequenceSendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{26}
+	return fileDescriptor_1034a1e9abc4cca1, []int{28}
 }
 func (m *QueryNextSequenceSendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1876,7 @@ func (m *QueryNextSequenceSendResponse) Reset()         { *m = QueryNextSequence
 func (m *QueryNextSequenceSendResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceSendResponse) ProtoMessage()    {}
 func (*QueryNextSequenceSendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{27}
+	return fileDescriptor_1034a1e9abc4cca1, []int{29}
 }
 func (m *QueryNextSequenceSendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +1936,7 @@ func (m *QueryUpgradeErrorRequest) Reset()         { *m = QueryUpgradeErrorReque
 func (m *QueryUpgradeErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeErrorRequest) ProtoMessage()    {}
 func (*QueryUpgradeErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{28}
+	return fileDescriptor_1034a1e9abc4cca1, []int{30}
 }
 func (m *QueryUpgradeErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1863,7 +1992,7 @@ func (m *QueryUpgradeErrorResponse) Reset()         { *m = QueryUpgradeErrorResp
 func (m *QueryUpgradeErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeErrorResponse) ProtoMessage()    {}
 func (*QueryUpgradeErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{29}
+	return fileDescriptor_1034a1e9abc4cca1, []int{31}
 }
 func (m *QueryUpgradeErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,7 +2052,7 @@ func (m *QueryUpgradeRequest) Reset()         { *m = QueryUpgradeRequest{} }
 func (m *QueryUpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeRequest) ProtoMessage()    {}
 func (*QueryUpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{30}
+	return fileDescriptor_1034a1e9abc4cca1, []int{32}
 }
 func (m *QueryUpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1979,7 +2108,7 @@ func (m *QueryUpgradeResponse) Reset()         { *m = QueryUpgradeResponse{} }
 func (m *QueryUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeResponse) ProtoMessage()    {}
 func (*QueryUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{31}
+	return fileDescriptor_1034a1e9abc4cca1, []int{33}
 }
 func (m *QueryUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2037,7 +2166,7 @@ func (m *QueryChannelParamsRequest) Reset()         { *m = QueryChannelParamsReq
 func (m *QueryChannelParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelParamsRequest) ProtoMessage()    {}
 func (*QueryChannelParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{32}
+	return fileDescriptor_1034a1e9abc4cca1, []int{34}
 }
 func (m *QueryChannelParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2076,7 +2205,7 @@ func (m *QueryChannelParamsResponse) Reset()         { *m = QueryChannelParamsRe
 func (m *QueryChannelParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelParamsResponse) ProtoMessage()    {}
 func (*QueryChannelParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{33}
+	return fileDescriptor_1034a1e9abc4cca1, []int{35}
 }
 func (m *QueryChannelParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2129,6 +2258,8 @@ func init() {
 	proto.RegisterType((*QueryPacketCommitmentsResponse)(nil), "ibc.core.channel.v1.QueryPacketCommitmentsResponse")
 	proto.RegisterType((*QueryPacketReceiptRequest)(nil), "ibc.core.channel.v1.QueryPacketReceiptRequest")
 	proto.RegisterType((*QueryPacketReceiptResponse)(nil), "ibc.core.channel.v1.QueryPacketReceiptResponse")
+	proto.RegisterType((*QueryPacketStatusRequest)(nil), "ibc.core.channel.v1.QueryPacketStatusRequest")
+	proto.RegisterType((*QueryPacketStatusResponse)(nil), "ibc.core.channel.v1.QueryPacketStatusResponse")
 	proto.RegisterType((*QueryPacketAcknowledgementRequest)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementRequest")
 	proto.RegisterType((*QueryPacketAcknowledgementResponse)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementResponse")
 	proto.RegisterType((*QueryPacketAcknowledgementsRequest)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementsRequest")
@@ -2152,117 +2283,122 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
 
 var fileDescriptor_1034a1e9abc4cca1 = []byte{
-	// 1756 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x6f, 0x14, 0x47,
-	0x16, 0x76, 0xd9, 0xc6, 0x3f, 0x0a, 0x03, 0xa6, 0x6c, 0x2f, 0xa6, 0x6d, 0x8f, 0xcd, 0xa0, 0x5d,
-	0x0c, 0x5a, 0xba, 0xb1, 0xcd, 0xb2, 0xb0, 0x62, 0x91, 0xb0, 0x77, 0x17, 0x8c, 0x16, 0x30, 0xed,
-	0x90, 0x00, 0x52, 0x32, 0xe9, 0xe9, 0x29, 0xc6, 0x2d, 0x7b, 0xba, 0x9b, 0xe9, 0x9e, 0x01, 0xe4,
-	0x38, 0x8a, 0x72, 0x20, 0x1c, 0xa3, 0xa0, 0x28, 0x52, 0x2e, 0x91, 0x72, 0x0a, 0x91, 0xa2, 0x28,
-	0x7f, 0x41, 0x2e, 0x39, 0x70, 0x0b, 0x12, 0x39, 0x44, 0x42, 0x22, 0x11, 0x26, 0x22, 0xd7, 0x5c,
-	0x72, 0x8e, 0xba, 0xea, 0x55, 0x4f, 0xf7, 0x4c, 0x77, 0xdb, 0xe3, 0xf6, 0x48, 0x28, 0xb7, 0xe9,
-	0xea, 0x7a, 0xaf, 0xbe, 0xef, 0x7b, 0x55, 0xaf, 0xeb, 0x3d, 0x1b, 0x8f, 0x1b, 0x79, 0x5d, 0xd1,
-	0xad, 0x32, 0x55, 0xf4, 0x25, 0xcd, 0x34, 0xe9, 0x8a, 0x52, 0x9d, 0x52, 0x6e, 0x55, 0x68, 0xf9,
-	0xae, 0x6c, 0x97, 0x2d, 0xd7, 0x22, 0x03, 0x46, 0x5e, 0x97, 0xbd, 0x09, 0x32, 0x4c, 0x90, 0xab,
-	0x53, 0x52, 0xc0, 0x6a, 0xc5, 0xa0, 0xa6, 0xeb, 0x19, 0xf1, 0x5f, 0xdc, 0x4a, 0x3a, 0xa2, 0x5b,
-	0x4e, 0xc9, 0x72, 0x94, 0xbc, 0xe6, 0x50, 0xee, 0x4e, 0xa9, 0x4e, 0xe5, 0xa9, 0xab, 0x4d, 0x29,
-	0xb6, 0x56, 0x34, 0x4c, 0xcd, 0x35, 0x2c, 0x13, 0xe6, 0x1e, 0x88, 0x82, 0x20, 0x16, 0xe3, 0x53,
-	0x46, 0x8b, 0x96, 0x55, 0x5c, 0xa1, 0x8a, 0x66, 0x1b, 0x8a, 0x66, 0x9a, 0x96, 0xcb, 0xec, 0x1d,
-	0x78, 0xbb, 0x1f, 0xde, 0xb2, 0xa7, 0x7c, 0xe5, 0xa6, 0xa2, 0x99, 0x80, 0x5e, 0x1a, 0x2c, 0x5a,
-	0x45, 0x8b, 0xfd, 0x54, 0xbc, 0x5f, 0x49, 0x2b, 0x56, 0xec, 0x62, 0x59, 0x2b, 0x50, 0x3e, 0x25,
-	0x7b, 0x11, 0x0f, 0x5c, 0xf1, 0x60, 0xcf, 0xf1, 0x09, 0x2a, 0xbd, 0x55, 0xa1, 0x8e, 0x4b, 0xf6,
-	0xe1, 0x6e, 0xdb, 0x2a, 0xbb, 0x39, 0xa3, 0x30, 0x8c, 0x26, 0xd0, 0x64, 0xaf, 0xda, 0xe5, 0x3d,
-	0xce, 0x17, 0xc8, 0x18, 0xc6, 0xe0, 0xcb, 0x7b, 0xd7, 0xce, 0xde, 0xf5, 0xc2, 0xc8, 0x7c, 0x21,
-	0xfb, 0x10, 0xe1, 0xc1, 0xb0, 0x3f, 0xc7, 0xb6, 0x4c, 0x87, 0x92, 0x13, 0xb8, 0x1b, 0x66, 0x31,
-	0x87, 0x3b, 0xa7, 0x47, 0xe5, 0x08, 0xc1, 0x65, 0x61, 0x26, 0x26, 0x93, 0x41, 0xbc, 0xc3, 0x2e,
-	0x5b, 0xd6, 0x4d, 0xb6, 0x54, 0x9f, 0xca, 0x1f, 0xc8, 0x1c, 0xee, 0x63, 0x3f, 0x72, 0x4b, 0xd4,
-	0x28, 0x2e, 0xb9, 0xc3, 0x1d, 0xcc, 0xa5, 0x14, 0x70, 0xc9, 0x83, 0x54, 0x9d, 0x92, 0xcf, 0xb3,
-	0x19, 0xb3, 0x9d, 0x8f, 0x9e, 0x8d, 0xb7, 0xa9, 0x3b, 0x99, 0x15, 0x1f, 0xca, 0xbe, 0x15, 0x86,
-	0xea, 0x08, 0xee, 0xff, 0xc3, 0xb8, 0x16, 0x3b, 0x40, 0xfb, 0x37, 0x99, 0x07, 0x5a, 0xf6, 0x02,
-	0x2d, 0xf3, 0x7d, 0x03, 0x81, 0x96, 0x17, 0xb4, 0x22, 0x05, 0x5b, 0x35, 0x60, 0x99, 0x7d, 0x86,
-	0xf0, 0x50, 0xdd, 0x02, 0x20, 0xc6, 0x2c, 0xee, 0x01, 0x7e, 0xce, 0x30, 0x9a, 0xe8, 0x60, 0xfe,
-	0xa3, 0xd4, 0x98, 0x2f, 0x50, 0xd3, 0x35, 0x6e, 0x1a, 0xb4, 0x20, 0x74, 0xf1, 0xed, 0xc8, 0xb9,
-	0x10, 0xca, 0x76, 0x86, 0xf2, 0xd0, 0x86, 0x28, 0x39, 0x80, 0x20, 0x4c, 0x72, 0x12, 0x77, 0x35,
-	0xa9, 0x22, 0xcc, 0xcf, 0xde, 0x47, 0x38, 0xc3, 0x09, 0x5a, 0xa6, 0x49, 0x75, 0xcf, 0x5b, 0xbd,
-	0x96, 0x19, 0x8c, 0x75, 0xff, 0x25, 0x6c, 0xa5, 0xc0, 0x48, 0x9d, 0xd6, 0xed, 0x5b, 0xd6, 0xfa,
-	0x57, 0x84, 0xc7, 0x63, 0xa1, 0xfc, 0xb9, 0x54, 0xbf, 0x26, 0x44, 0xe7, 0x98, 0xe6, 0xd8, 0xec,
-	0x45, 0x57, 0x73, 0x69, 0xda, 0xc3, 0xfb, 0x93, 0x2f, 0x62, 0x84, 0x6b, 0x10, 0x51, 0xc3, 0xfb,
-	0x0c, 0x5f, 0x9f, 0x1c, 0x87, 0x9a, 0x73, 0xbc, 0x29, 0x70, 0x52, 0x0e, 0x47, 0x11, 0x09, 0x48,
-	0x1a, 0xf0, 0x39, 0x64, 0x44, 0x0d, 0xb7, 0xf2, 0xc8, 0x7f, 0x85, 0xf0, 0x81, 0x10, 0x43, 0x8f,
-	0x93, 0xe9, 0x54, 0x9c, 0xed, 0xd0, 0x8f, 0x1c, 0xc2, 0x7b, 0xca, 0xb4, 0x6a, 0x38, 0x86, 0x65,
-	0xe6, 0xcc, 0x4a, 0x29, 0x4f, 0xcb, 0x0c, 0x65, 0xa7, 0xba, 0x5b, 0x0c, 0x5f, 0x62, 0xa3, 0xa1,
-	0x89, 0x40, 0xa7, 0x33, 0x3c, 0x11, 0xf0, 0x3e, 0x45, 0x38, 0x9b, 0x84, 0x17, 0x82, 0xf2, 0x6f,
-	0xbc, 0x47, 0x17, 0x6f, 0x42, 0xc1, 0x18, 0x94, 0xf9, 0x27, 0x43, 0x16, 0x9f, 0x0c, 0xf9, 0xac,
-	0x79, 0x57, 0xdd, 0xad, 0x87, 0xdc, 0x90, 0x11, 0xdc, 0x0b, 0x81, 0xf4, 0x59, 0xf5, 0xf0, 0x81,
-	0xf9, 0x42, 0x2d, 0x1a, 0x1d, 0x49, 0xd1, 0xe8, 0xdc, 0x4a, 0x34, 0xca, 0x78, 0x94, 0x91, 0x5b,
-	0xd0, 0xf4, 0x65, 0xea, 0xce, 0x59, 0xa5, 0x92, 0xe1, 0x96, 0xa8, 0xe9, 0xa6, 0x8d, 0x83, 0x84,
-	0x7b, 0x1c, 0xcf, 0x85, 0xa9, 0x53, 0x08, 0x80, 0xff, 0x9c, 0xfd, 0x14, 0xe1, 0xb1, 0x98, 0x45,
-	0x41, 0x4c, 0x96, 0xb2, 0xc4, 0x28, 0x5b, 0xb8, 0x4f, 0x0d, 0x8c, 0xb4, 0x72, 0x7b, 0x7e, 0x16,
-	0x07, 0xce, 0x49, 0x2b, 0x49, 0x38, 0xcf, 0x76, 0x6c, 0x39, 0xcf, 0xbe, 0x14, 0x29, 0x3f, 0x02,
-	0xa1, 0x9f, 0x66, 0x77, 0xd6, 0xd4, 0x12, 0x99, 0x76, 0x22, 0x32, 0xd3, 0x72, 0x27, 0x7c, 0x2f,
-	0x07, 0x8d, 0x5e, 0x85, 0x34, 0x6b, 0xe1, 0xfd, 0x01, 0xa2, 0x2a, 0xd5, 0xa9, 0x61, 0xb7, 0x74,
-	0x67, 0x3e, 0x40, 0x58, 0x8a, 0x5a, 0x11, 0x64, 0x95, 0x70, 0x4f, 0xd9, 0x1b, 0xaa, 0x52, 0xee,
-	0xb7, 0x47, 0xf5, 0x9f, 0x5b, 0x79, 0x46, 0x6f, 0x43, 0xc2, 0xe4, 0xa0, 0xce, 0xea, 0xcb, 0xa6,
-	0x75, 0x7b, 0x85, 0x16, 0x8a, 0xb4, 0xd5, 0x07, 0xf5, 0xa1, 0x48, 0x7d, 0x31, 0x2b, 0x83, 0x2c,
-	0x93, 0x78, 0x8f, 0x16, 0x7e, 0x05, 0x47, 0xb6, 0x7e, 0xb8, 0x95, 0xe7, 0xf6, 0x45, 0x22, 0xd6,
-	0x57, 0xe5, 0xf0, 0x92, 0x33, 0x78, 0xc4, 0x66, 0x00, 0x73, 0xb5, 0xb3, 0x96, 0x13, 0x82, 0x3b,
-	0xc3, 0x9d, 0x13, 0x1d, 0x93, 0x9d, 0xea, 0x7e, 0xbb, 0xee, 0x64, 0x2f, 0x8a, 0x09, 0xd9, 0xdf,
-	0x11, 0x3e, 0x98, 0x48, 0x13, 0x62, 0xf2, 0x7f, 0xdc, 0x5f, 0x27, 0xfe, 0xe6, 0xd3, 0x40, 0x83,
-	0xe5, 0xab, 0x90, 0x0b, 0x3e, 0x11, 0x79, 0xf9, 0xaa, 0x29, 0xce, 0x1c, 0xc7, 0x9c, 0x3a, 0xb4,
-	0x1b, 0x84, 0xa4, 0x63, 0xa3, 0x90, 0xdc, 0x81, 0x74, 0x1c, 0x01, 0x0c, 0x82, 0x31, 0x8a, 0x7b,
-	0x6b, 0xfe, 0x10, 0xf3, 0x57, 0x1b, 0x08, 0x68, 0xd2, 0xde, 0xa4, 0x26, 0xf7, 0x44, 0xba, 0xaa,
-	0x2d, 0x7d, 0x56, 0x5f, 0x4e, 0x2d, 0xc8, 0x31, 0x3c, 0x08, 0x82, 0x68, 0xfa, 0x72, 0x83, 0x12,
-	0xc4, 0x16, 0x3b, 0xaf, 0x26, 0x41, 0x05, 0x8f, 0x44, 0xe2, 0x68, 0x31, 0xff, 0xeb, 0x70, 0x57,
-	0xbe, 0x44, 0xef, 0xf8, 0xf1, 0x50, 0x39, 0x80, 0xb4, 0xf7, 0xf0, 0x6f, 0x10, 0x9e, 0x88, 0xf7,
-	0x0d, 0xbc, 0xa6, 0xf1, 0x90, 0x49, 0xef, 0xd4, 0x36, 0x4b, 0x0e, 0xd8, 0xb3, 0xa5, 0x3a, 0xd5,
-	0x01, 0xb3, 0xd1, 0xb6, 0x95, 0x29, 0xf0, 0x75, 0xb8, 0xcb, 0x05, 0x21, 0x2f, 0x52, 0xb3, 0x90,
-	0x56, 0x8b, 0x2f, 0xc4, 0xd1, 0x6b, 0x74, 0x0c, 0x42, 0xfc, 0x1d, 0x93, 0xb0, 0x10, 0x0e, 0x35,
-	0x0b, 0xa0, 0x42, 0xbf, 0x59, 0x67, 0xd5, 0x4a, 0x09, 0x54, 0x3c, 0xcc, 0x37, 0x22, 0x6f, 0xb0,
-	0xfc, 0xb7, 0x5c, 0xb6, 0xca, 0x69, 0xe9, 0x7f, 0x87, 0xe0, 0x1a, 0x12, 0x76, 0xea, 0x27, 0xda,
-	0x5d, 0xd4, 0x1b, 0xe0, 0xb1, 0xb7, 0x5d, 0xb8, 0xf5, 0x1f, 0x88, 0xcc, 0xb2, 0x60, 0xca, 0x26,
-	0x02, 0xfc, 0x3e, 0x1a, 0x18, 0x6b, 0xa5, 0x34, 0xa2, 0xcb, 0x04, 0x2c, 0xd2, 0xaa, 0xf2, 0xb5,
-	0xe8, 0x32, 0xf9, 0xfe, 0x40, 0x90, 0xd3, 0xb8, 0x1b, 0xda, 0x5b, 0x89, 0x5d, 0x26, 0x30, 0x03,
-	0xa4, 0xc2, 0xa4, 0x95, 0x02, 0x8c, 0x40, 0x18, 0xa1, 0x8e, 0x5b, 0xd0, 0xca, 0x5a, 0x49, 0xe4,
-	0xca, 0xec, 0x15, 0xc8, 0xa4, 0x75, 0x2f, 0x81, 0xd3, 0x0c, 0xee, 0xb2, 0xd9, 0x08, 0x50, 0x1a,
-	0x89, 0xf9, 0x86, 0x32, 0x23, 0x98, 0x3a, 0xfd, 0xcb, 0x08, 0xde, 0xc1, 0x7c, 0x92, 0xcf, 0x11,
-	0xee, 0x06, 0xc7, 0x64, 0x32, 0xd2, 0x34, 0xa2, 0xff, 0x27, 0x1d, 0xde, 0xc4, 0x4c, 0x8e, 0x2f,
-	0x3b, 0xfb, 0xfe, 0x93, 0x17, 0x0f, 0xda, 0x4f, 0x93, 0x7f, 0x29, 0x09, 0xfd, 0x4d, 0x47, 0x59,
-	0xad, 0x05, 0x74, 0x4d, 0xf1, 0xc2, 0xec, 0x28, 0xab, 0x10, 0xfc, 0x35, 0x72, 0x1f, 0xe1, 0x1e,
-	0xd1, 0xaf, 0x21, 0x1b, 0xaf, 0x2d, 0x94, 0x93, 0x8e, 0x6c, 0x66, 0x2a, 0xe0, 0xfc, 0x2b, 0xc3,
-	0x39, 0x4e, 0xc6, 0x12, 0x71, 0x92, 0x6f, 0x11, 0x26, 0x8d, 0x4d, 0x24, 0x32, 0x93, 0xb0, 0x52,
-	0x5c, 0xf7, 0x4b, 0x3a, 0xde, 0x9c, 0x11, 0x00, 0x3d, 0xc3, 0x80, 0x9e, 0x24, 0x27, 0xa2, 0x81,
-	0xfa, 0x86, 0x9e, 0xa6, 0xfe, 0xc3, 0x5a, 0x8d, 0xc1, 0x63, 0x8f, 0x41, 0x43, 0x07, 0x27, 0x91,
-	0x41, 0x5c, 0x2b, 0x29, 0x91, 0x41, 0x6c, 0x93, 0x28, 0x7b, 0x99, 0x31, 0x98, 0x27, 0xe7, 0xb6,
-	0xbe, 0x25, 0x94, 0x60, 0x6b, 0x89, 0x7c, 0xd4, 0x8e, 0x87, 0x22, 0x5b, 0x20, 0xe4, 0xc4, 0xc6,
-	0x00, 0xa3, 0x7a, 0x3c, 0xd2, 0x3f, 0x9b, 0xb6, 0x03, 0x6e, 0x1f, 0x20, 0x46, 0xee, 0x3d, 0x44,
-	0xde, 0x4d, 0xc3, 0x2e, 0xdc, 0xae, 0x51, 0x44, 0xdf, 0x47, 0x59, 0xad, 0xeb, 0x20, 0xad, 0x29,
-	0x3c, 0xed, 0x04, 0x5e, 0xf0, 0x81, 0x35, 0xf2, 0x14, 0xe1, 0xfe, 0xfa, 0x32, 0x9c, 0x4c, 0xc5,
-	0xf3, 0x8a, 0x69, 0xb3, 0x48, 0xd3, 0xcd, 0x98, 0x80, 0x0a, 0x6f, 0x33, 0x11, 0x6e, 0x90, 0x6b,
-	0x29, 0x34, 0x68, 0xb8, 0xf8, 0x3a, 0xca, 0xaa, 0xf8, 0x88, 0xaf, 0x91, 0x27, 0x08, 0xef, 0x6d,
-	0x68, 0x32, 0x90, 0x26, 0xb0, 0xfa, 0xa7, 0x70, 0xa6, 0x29, 0x1b, 0x20, 0x78, 0x95, 0x11, 0xbc,
-	0x4c, 0x2e, 0x6e, 0x2b, 0x41, 0xf2, 0x3d, 0xc2, 0xbb, 0x42, 0xf5, 0x3d, 0x91, 0x37, 0x42, 0x17,
-	0x6e, 0x3d, 0x48, 0xca, 0xa6, 0xe7, 0x03, 0x93, 0x37, 0x19, 0x93, 0x37, 0xc8, 0xd5, 0xf4, 0x4c,
-	0xe0, 0x9a, 0x11, 0x8a, 0xd3, 0x3a, 0xc2, 0x43, 0x91, 0xf5, 0x60, 0xd2, 0xd1, 0x4c, 0xea, 0x26,
-	0x24, 0x1d, 0xcd, 0xc4, 0x5e, 0x40, 0xf6, 0x3a, 0x63, 0xba, 0x48, 0xae, 0xa4, 0x67, 0xaa, 0xe9,
-	0xcb, 0x21, 0x96, 0x2f, 0x11, 0xfe, 0x4b, 0x74, 0xd5, 0x4b, 0x9a, 0x85, 0xeb, 0xef, 0xcb, 0x93,
-	0xcd, 0x1b, 0x02, 0xd1, 0x1b, 0x8c, 0xe8, 0x6b, 0x44, 0xdd, 0x16, 0xa2, 0x61, 0x3a, 0xf7, 0xda,
-	0xf1, 0xde, 0x86, 0x6a, 0x32, 0xe9, 0xdc, 0xc5, 0xd5, 0xc4, 0x49, 0xe7, 0x2e, 0xb6, 0x5c, 0xdd,
-	0xa6, 0xf4, 0x1a, 0x95, 0x5a, 0x12, 0xea, 0xec, 0x35, 0xa5, 0xe2, 0x03, 0xca, 0xd9, 0x40, 0xf9,
-	0x37, 0x84, 0x77, 0x87, 0x6b, 0x4a, 0xa2, 0x6c, 0x86, 0x51, 0xa0, 0x0a, 0x96, 0x8e, 0x6d, 0xde,
-	0x00, 0xf8, 0xbf, 0xc3, 0xe8, 0x57, 0x89, 0xdb, 0x1a, 0xf6, 0xa1, 0xa2, 0x3a, 0x44, 0xdb, 0xdb,
-	0xf1, 0xe4, 0x07, 0x84, 0x07, 0x22, 0x8a, 0x4e, 0x92, 0x70, 0x0d, 0x88, 0xaf, 0x7f, 0xa5, 0x7f,
-	0x34, 0x69, 0x05, 0x12, 0x2c, 0x30, 0x09, 0x2e, 0x90, 0xf3, 0x29, 0x24, 0x08, 0x55, 0x84, 0xde,
-	0x8d, 0xa8, 0xbf, 0xbe, 0x7e, 0x4c, 0xfa, 0x52, 0xc6, 0x14, 0xb1, 0x49, 0x5f, 0xca, 0xb8, 0xf2,
-	0x74, 0x5b, 0x3e, 0x24, 0x8d, 0xf5, 0xad, 0x77, 0x4d, 0xed, 0x0b, 0xd6, 0x84, 0xe4, 0x68, 0xc2,
-	0x56, 0x6b, 0x2c, 0x48, 0x25, 0x79, 0xb3, 0xd3, 0xb7, 0x31, 0x28, 0x50, 0x67, 0xe5, 0x58, 0xd5,
-	0x49, 0xbe, 0x44, 0xb8, 0x1b, 0x96, 0x4a, 0x2a, 0x4c, 0xc2, 0x25, 0x63, 0x52, 0x61, 0x52, 0x57,
-	0x0c, 0x66, 0x2f, 0x30, 0xc8, 0xff, 0x21, 0xb3, 0xe9, 0x21, 0x93, 0x8f, 0x11, 0xde, 0x15, 0x2a,
-	0xcf, 0x92, 0xbe, 0xdb, 0x51, 0x45, 0x5e, 0xd2, 0x77, 0x3b, 0xb2, 0xee, 0xcb, 0x1e, 0x64, 0xf0,
-	0xc7, 0xc8, 0x48, 0x24, 0x7c, 0x5e, 0xe7, 0xcd, 0x2e, 0x3e, 0x7a, 0x9e, 0x41, 0x8f, 0x9f, 0x67,
-	0xd0, 0xcf, 0xcf, 0x33, 0xe8, 0xc3, 0xf5, 0x4c, 0xdb, 0xe3, 0xf5, 0x4c, 0xdb, 0x8f, 0xeb, 0x99,
-	0xb6, 0x1b, 0xa7, 0x8a, 0x86, 0xbb, 0x54, 0xc9, 0xcb, 0xba, 0x55, 0x52, 0xe0, 0x9f, 0x54, 0x8c,
-	0xbc, 0x7e, 0xb4, 0x68, 0x29, 0xd5, 0x53, 0x4a, 0xc9, 0x2a, 0x54, 0x56, 0xa8, 0xc3, 0xbd, 0x1e,
-	0x3b, 0x7e, 0x54, 0x38, 0x76, 0xef, 0xda, 0xd4, 0xc9, 0x77, 0xb1, 0xbf, 0x16, 0xce, 0xfc, 0x11,
-	0x00, 0x00, 0xff, 0xff, 0x49, 0x5b, 0x44, 0x98, 0x34, 0x23, 0x00, 0x00,
+	// 1833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xdf, 0x6f, 0x14, 0x5f,
+	0x15, 0xef, 0x6d, 0x97, 0xfe, 0x38, 0x2d, 0x50, 0x6e, 0x5b, 0x69, 0xa7, 0xed, 0xb6, 0x2c, 0x2a,
+	0x85, 0xc8, 0x0c, 0x6d, 0x91, 0x1f, 0x06, 0x49, 0x68, 0x15, 0x28, 0xe1, 0x47, 0xd9, 0x5a, 0x05,
+	0x8c, 0xae, 0xb3, 0xb3, 0xb7, 0xdb, 0x49, 0xbb, 0x33, 0xcb, 0xce, 0xec, 0x42, 0x53, 0x6b, 0x8c,
+	0x0f, 0xc8, 0x23, 0x91, 0x18, 0x13, 0x5f, 0x4c, 0x7c, 0x12, 0x13, 0x63, 0xf4, 0x1f, 0xf0, 0xc5,
+	0x07, 0xe2, 0x8b, 0x24, 0xf8, 0x60, 0x42, 0x82, 0x86, 0x92, 0xe0, 0xab, 0x2f, 0x3e, 0x9b, 0xb9,
+	0x73, 0xee, 0xec, 0xcc, 0xee, 0xec, 0x74, 0xb7, 0xd3, 0x4d, 0xc8, 0xf7, 0x6d, 0xe7, 0xce, 0x39,
+	0xe7, 0x7e, 0x3e, 0x9f, 0x73, 0xe7, 0xcc, 0x9c, 0xd3, 0xc2, 0x94, 0x9e, 0xd5, 0x14, 0xcd, 0x2c,
+	0x31, 0x45, 0x5b, 0x57, 0x0d, 0x83, 0x6d, 0x2a, 0x95, 0x59, 0xe5, 0x71, 0x99, 0x95, 0xb6, 0xe4,
+	0x62, 0xc9, 0xb4, 0x4d, 0x3a, 0xa4, 0x67, 0x35, 0xd9, 0x31, 0x90, 0xd1, 0x40, 0xae, 0xcc, 0x4a,
+	0x3e, 0xaf, 0x4d, 0x9d, 0x19, 0xb6, 0xe3, 0xe4, 0xfe, 0x72, 0xbd, 0xa4, 0x33, 0x9a, 0x69, 0x15,
+	0x4c, 0x4b, 0xc9, 0xaa, 0x16, 0x73, 0xc3, 0x29, 0x95, 0xd9, 0x2c, 0xb3, 0xd5, 0x59, 0xa5, 0xa8,
+	0xe6, 0x75, 0x43, 0xb5, 0x75, 0xd3, 0x40, 0xdb, 0x13, 0x61, 0x10, 0xc4, 0x66, 0xae, 0xc9, 0x44,
+	0xde, 0x34, 0xf3, 0x9b, 0x4c, 0x51, 0x8b, 0xba, 0xa2, 0x1a, 0x86, 0x69, 0x73, 0x7f, 0x0b, 0xef,
+	0x8e, 0xe1, 0x5d, 0x7e, 0x95, 0x2d, 0xaf, 0x29, 0xaa, 0x81, 0xe8, 0xa5, 0xe1, 0xbc, 0x99, 0x37,
+	0xf9, 0x4f, 0xc5, 0xf9, 0x15, 0xb5, 0x63, 0xb9, 0x98, 0x2f, 0xa9, 0x39, 0xe6, 0x9a, 0xa4, 0xee,
+	0xc0, 0xd0, 0x7d, 0x07, 0xf6, 0xa2, 0x6b, 0x90, 0x66, 0x8f, 0xcb, 0xcc, 0xb2, 0xe9, 0x71, 0xe8,
+	0x29, 0x9a, 0x25, 0x3b, 0xa3, 0xe7, 0x46, 0xc9, 0x34, 0x99, 0xe9, 0x4b, 0x77, 0x3b, 0x97, 0x4b,
+	0x39, 0x3a, 0x09, 0x80, 0xb1, 0x9c, 0x7b, 0x9d, 0xfc, 0x5e, 0x1f, 0xae, 0x2c, 0xe5, 0x52, 0xaf,
+	0x08, 0x0c, 0x07, 0xe3, 0x59, 0x45, 0xd3, 0xb0, 0x18, 0xbd, 0x00, 0x3d, 0x68, 0xc5, 0x03, 0xf6,
+	0xcf, 0x4d, 0xc8, 0x21, 0x82, 0xcb, 0xc2, 0x4d, 0x18, 0xd3, 0x61, 0x38, 0x54, 0x2c, 0x99, 0xe6,
+	0x1a, 0xdf, 0x6a, 0x20, 0xed, 0x5e, 0xd0, 0x45, 0x18, 0xe0, 0x3f, 0x32, 0xeb, 0x4c, 0xcf, 0xaf,
+	0xdb, 0xa3, 0x5d, 0x3c, 0xa4, 0xe4, 0x0b, 0xe9, 0x26, 0xa9, 0x32, 0x2b, 0xdf, 0xe4, 0x16, 0x0b,
+	0x89, 0xd7, 0xef, 0xa7, 0x3a, 0xd2, 0xfd, 0xdc, 0xcb, 0x5d, 0x4a, 0xfd, 0x30, 0x08, 0xd5, 0x12,
+	0xdc, 0xaf, 0x03, 0x54, 0x73, 0x87, 0x68, 0xbf, 0x2a, 0xbb, 0x89, 0x96, 0x9d, 0x44, 0xcb, 0xee,
+	0xb9, 0xc1, 0x44, 0xcb, 0xcb, 0x6a, 0x9e, 0xa1, 0x6f, 0xda, 0xe7, 0x99, 0x7a, 0x4f, 0x60, 0xa4,
+	0x66, 0x03, 0x14, 0x63, 0x01, 0x7a, 0x91, 0x9f, 0x35, 0x4a, 0xa6, 0xbb, 0x78, 0xfc, 0x30, 0x35,
+	0x96, 0x72, 0xcc, 0xb0, 0xf5, 0x35, 0x9d, 0xe5, 0x84, 0x2e, 0x9e, 0x1f, 0xbd, 0x11, 0x40, 0xd9,
+	0xc9, 0x51, 0x9e, 0xda, 0x13, 0xa5, 0x0b, 0xc0, 0x0f, 0x93, 0x5e, 0x82, 0xee, 0x16, 0x55, 0x44,
+	0xfb, 0xd4, 0x73, 0x02, 0x49, 0x97, 0xa0, 0x69, 0x18, 0x4c, 0x73, 0xa2, 0xd5, 0x6a, 0x99, 0x04,
+	0xd0, 0xbc, 0x9b, 0x78, 0x94, 0x7c, 0x2b, 0xf4, 0x7a, 0x08, 0x8b, 0xfd, 0x68, 0xfd, 0x1f, 0x02,
+	0x53, 0x0d, 0xa1, 0x7c, 0xb1, 0x54, 0x7f, 0x20, 0x44, 0x77, 0x31, 0x2d, 0x72, 0xeb, 0x15, 0x5b,
+	0xb5, 0x59, 0xdc, 0x87, 0xf7, 0x5f, 0x9e, 0x88, 0x21, 0xa1, 0x51, 0x44, 0x15, 0x8e, 0xeb, 0x9e,
+	0x3e, 0x19, 0x17, 0x6a, 0xc6, 0x72, 0x4c, 0xf0, 0x49, 0x39, 0x1d, 0x46, 0xc4, 0x27, 0xa9, 0x2f,
+	0xe6, 0x88, 0x1e, 0xb6, 0xdc, 0xce, 0x47, 0xfe, 0x0f, 0x04, 0x4e, 0x04, 0x18, 0x3a, 0x9c, 0x0c,
+	0xab, 0x6c, 0x1d, 0x84, 0x7e, 0xf4, 0x14, 0x1c, 0x2d, 0xb1, 0x8a, 0x6e, 0xe9, 0xa6, 0x91, 0x31,
+	0xca, 0x85, 0x2c, 0x2b, 0x71, 0x94, 0x89, 0xf4, 0x11, 0xb1, 0x7c, 0x97, 0xaf, 0x06, 0x0c, 0x91,
+	0x4e, 0x22, 0x68, 0x88, 0x78, 0xdf, 0x11, 0x48, 0x45, 0xe1, 0xc5, 0xa4, 0x7c, 0x13, 0x8e, 0x6a,
+	0xe2, 0x4e, 0x20, 0x19, 0xc3, 0xb2, 0xfb, 0xca, 0x90, 0xc5, 0x2b, 0x43, 0xbe, 0x66, 0x6c, 0xa5,
+	0x8f, 0x68, 0x81, 0x30, 0x74, 0x1c, 0xfa, 0x30, 0x91, 0x1e, 0xab, 0x5e, 0x77, 0x61, 0x29, 0x57,
+	0xcd, 0x46, 0x57, 0x54, 0x36, 0x12, 0xfb, 0xc9, 0x46, 0x09, 0x26, 0x38, 0xb9, 0x65, 0x55, 0xdb,
+	0x60, 0xf6, 0xa2, 0x59, 0x28, 0xe8, 0x76, 0x81, 0x19, 0x76, 0xdc, 0x3c, 0x48, 0xd0, 0x6b, 0x39,
+	0x21, 0x0c, 0x8d, 0x61, 0x02, 0xbc, 0xeb, 0xd4, 0xaf, 0x09, 0x4c, 0x36, 0xd8, 0x14, 0xc5, 0xe4,
+	0x25, 0x4b, 0xac, 0xf2, 0x8d, 0x07, 0xd2, 0xbe, 0x95, 0x76, 0x1e, 0xcf, 0xdf, 0x34, 0x02, 0x67,
+	0xc5, 0x95, 0x24, 0x58, 0x67, 0xbb, 0xf6, 0x5d, 0x67, 0x3f, 0x89, 0x92, 0x1f, 0x82, 0xd0, 0x2b,
+	0xb3, 0xfd, 0x55, 0xb5, 0x44, 0xa5, 0x9d, 0x0e, 0xad, 0xb4, 0x6e, 0x10, 0xf7, 0x2c, 0xfb, 0x9d,
+	0x3e, 0x87, 0x32, 0x6b, 0xc2, 0x98, 0x8f, 0x68, 0x9a, 0x69, 0x4c, 0x2f, 0xb6, 0xf5, 0x64, 0xbe,
+	0x24, 0x20, 0x85, 0xed, 0x88, 0xb2, 0x4a, 0xd0, 0x5b, 0x72, 0x96, 0x2a, 0xcc, 0x8d, 0xdb, 0x9b,
+	0xf6, 0xae, 0xdb, 0xf9, 0x8c, 0xbe, 0x20, 0x30, 0xea, 0x43, 0xe5, 0xe4, 0xaa, 0x6c, 0xb5, 0x51,
+	0x06, 0x3a, 0x0d, 0xfd, 0x39, 0x66, 0xd9, 0x22, 0xf7, 0x09, 0x4e, 0xd5, 0xbf, 0xe4, 0x3c, 0x25,
+	0x63, 0x21, 0x90, 0x50, 0xa7, 0x9b, 0xd0, 0xb7, 0xa9, 0xaf, 0x31, 0x6d, 0x4b, 0xdb, 0x14, 0x55,
+	0xf0, 0xcb, 0x11, 0x87, 0xef, 0xb6, 0xb0, 0x45, 0xf2, 0x55, 0x67, 0xdf, 0xd9, 0xe9, 0x6c, 0xf1,
+	0xec, 0x3c, 0xc1, 0xb7, 0x8c, 0xbb, 0xc5, 0x35, 0x6d, 0xc3, 0x30, 0x9f, 0x6c, 0xb2, 0x5c, 0x9e,
+	0xb5, 0xbb, 0xba, 0xbd, 0x12, 0xef, 0x8b, 0x06, 0x3b, 0xa3, 0x46, 0x33, 0x70, 0x54, 0x0d, 0xde,
+	0xc2, 0x3a, 0x57, 0xbb, 0xdc, 0xce, 0x62, 0xf7, 0x31, 0x12, 0xeb, 0xe7, 0x52, 0xf1, 0xe8, 0x55,
+	0x18, 0x2f, 0x72, 0x80, 0x99, 0x6a, 0x81, 0xca, 0x08, 0xc1, 0xad, 0xd1, 0xc4, 0x74, 0xd7, 0x4c,
+	0x22, 0x3d, 0x56, 0xac, 0x29, 0x87, 0x2b, 0xc2, 0x20, 0xf5, 0x3f, 0x02, 0x27, 0x23, 0x69, 0x62,
+	0x4e, 0x6e, 0xc3, 0x60, 0x8d, 0xf8, 0xcd, 0xd7, 0xce, 0x3a, 0xcf, 0xcf, 0xa1, 0x80, 0xfe, 0x4a,
+	0xbc, 0xcc, 0x56, 0x0d, 0x51, 0xa8, 0x5c, 0xcc, 0xb1, 0x53, 0xbb, 0x47, 0x4a, 0xba, 0xf6, 0x4a,
+	0xc9, 0x53, 0x48, 0x36, 0x02, 0x86, 0xc9, 0x98, 0x80, 0xbe, 0x6a, 0x3c, 0xc2, 0xe3, 0x55, 0x17,
+	0x62, 0x14, 0x86, 0x67, 0xa2, 0xc6, 0x57, 0xb7, 0xbe, 0xa6, 0x6d, 0xc4, 0x16, 0xe4, 0x1c, 0x0c,
+	0xa3, 0x20, 0xaa, 0xb6, 0x51, 0xa7, 0x04, 0x2d, 0x8a, 0x93, 0x57, 0x95, 0xa0, 0x0c, 0xe3, 0xa1,
+	0x38, 0xda, 0xcc, 0xff, 0x21, 0x36, 0x18, 0x77, 0xd9, 0x53, 0x2f, 0x1f, 0x69, 0x17, 0x40, 0xdc,
+	0xe6, 0xe5, 0x4f, 0x04, 0xa6, 0x1b, 0xc7, 0x46, 0x5e, 0x73, 0x30, 0x62, 0xb0, 0xa7, 0xd5, 0xc3,
+	0x92, 0x41, 0xf6, 0x7c, 0xab, 0x44, 0x7a, 0xc8, 0xa8, 0xf7, 0x6d, 0x67, 0x09, 0xfc, 0x2e, 0x4c,
+	0xd4, 0x41, 0x5e, 0x61, 0x46, 0x2e, 0xae, 0x16, 0xbf, 0x13, 0x8f, 0x5e, 0x7d, 0x60, 0x14, 0xe2,
+	0x6b, 0x40, 0x83, 0x42, 0x58, 0xcc, 0xc8, 0xa1, 0x0a, 0x83, 0x46, 0x8d, 0x57, 0x3b, 0x25, 0x48,
+	0xe3, 0xe7, 0xc5, 0xaa, 0x3b, 0x95, 0xfa, 0x76, 0xa9, 0x64, 0x96, 0xe2, 0xd2, 0xff, 0xab, 0xf8,
+	0x40, 0x08, 0x06, 0xf5, 0x0a, 0xed, 0x61, 0xe6, 0x2c, 0xb8, 0xb9, 0x2f, 0xda, 0xf8, 0x91, 0x70,
+	0x22, 0xb4, 0xca, 0xa2, 0x2b, 0x37, 0x44, 0xf8, 0x03, 0xcc, 0xb7, 0xd6, 0x4e, 0x69, 0xc4, 0x68,
+	0x0e, 0x59, 0xc4, 0x55, 0xe5, 0x8f, 0x62, 0x34, 0xe7, 0xc5, 0x43, 0x41, 0xae, 0x40, 0x0f, 0xce,
+	0x04, 0x23, 0x47, 0x73, 0xe8, 0x86, 0x48, 0x85, 0x4b, 0x3b, 0x05, 0x18, 0x87, 0x31, 0x7f, 0xf3,
+	0xbb, 0xac, 0x96, 0xd4, 0x82, 0xa8, 0x95, 0xa9, 0xfb, 0x20, 0x85, 0xdd, 0x44, 0x4e, 0xf3, 0xd0,
+	0x5d, 0xe4, 0x2b, 0x48, 0x69, 0xbc, 0xc1, 0x3b, 0x94, 0x3b, 0xa1, 0xe9, 0xdc, 0x9f, 0x27, 0xe1,
+	0x10, 0x8f, 0x49, 0x7f, 0x4b, 0xa0, 0x07, 0x03, 0xd3, 0x99, 0x50, 0xd7, 0x90, 0xa1, 0xa9, 0x74,
+	0xba, 0x09, 0x4b, 0x17, 0x5f, 0x6a, 0xe1, 0x67, 0x6f, 0x3f, 0xbe, 0xec, 0xbc, 0x42, 0xbf, 0xa1,
+	0x44, 0x0c, 0x85, 0x2d, 0x65, 0xbb, 0x9a, 0xd0, 0x1d, 0xc5, 0x49, 0xb3, 0xa5, 0x6c, 0x63, 0xf2,
+	0x77, 0xe8, 0x73, 0x02, 0xbd, 0x18, 0xd7, 0xa2, 0x7b, 0xef, 0x2d, 0x94, 0x93, 0xce, 0x34, 0x63,
+	0x8a, 0x38, 0xbf, 0xc2, 0x71, 0x4e, 0xd1, 0xc9, 0x48, 0x9c, 0xf4, 0x2f, 0x04, 0x68, 0xfd, 0xe4,
+	0x8d, 0xce, 0x47, 0xec, 0xd4, 0x68, 0x64, 0x28, 0x9d, 0x6f, 0xcd, 0x09, 0x81, 0x5e, 0xe5, 0x40,
+	0x2f, 0xd1, 0x0b, 0xe1, 0x40, 0x3d, 0x47, 0x47, 0x53, 0xef, 0x62, 0xa7, 0xca, 0xe0, 0x8d, 0xc3,
+	0xa0, 0x6e, 0xec, 0x15, 0xc9, 0xa0, 0xd1, 0xfc, 0x4d, 0x3a, 0xdf, 0x9a, 0x13, 0x32, 0xb8, 0xc7,
+	0x19, 0x2c, 0xd1, 0x1b, 0xfb, 0x3f, 0x12, 0x8a, 0x7f, 0x1e, 0x47, 0x7f, 0xd1, 0x09, 0x23, 0xa1,
+	0x73, 0x23, 0x7a, 0x61, 0x6f, 0x80, 0x61, 0x83, 0x31, 0xe9, 0x62, 0xcb, 0x7e, 0xc8, 0xed, 0xe7,
+	0x84, 0x93, 0xfb, 0x29, 0xa1, 0x3f, 0x89, 0xc3, 0x2e, 0x38, 0xe3, 0x52, 0xc4, 0xb0, 0x4c, 0xd9,
+	0xae, 0x19, 0xbb, 0xed, 0x28, 0x6e, 0xd9, 0xf1, 0xdd, 0x70, 0x17, 0x76, 0xe8, 0x3b, 0x02, 0x83,
+	0xb5, 0xb3, 0x0b, 0x3a, 0xdb, 0x98, 0x57, 0x83, 0xd9, 0x94, 0x34, 0xd7, 0x8a, 0x0b, 0xaa, 0xf0,
+	0x23, 0x2e, 0xc2, 0x23, 0xfa, 0x20, 0x86, 0x06, 0x75, 0x1f, 0xbe, 0x96, 0xb2, 0x2d, 0x5e, 0xe2,
+	0x3b, 0xf4, 0x2d, 0x81, 0x63, 0xb5, 0xdb, 0x5b, 0xb4, 0x05, 0xac, 0xde, 0x53, 0x38, 0xdf, 0x92,
+	0x0f, 0x12, 0x5c, 0xe5, 0x04, 0xef, 0xd1, 0x3b, 0x07, 0x4a, 0x90, 0xfe, 0x9d, 0xc0, 0xe1, 0xc0,
+	0x50, 0x84, 0xca, 0x7b, 0xa1, 0x0b, 0xce, 0x6b, 0x24, 0xa5, 0x69, 0x7b, 0x64, 0xf2, 0x03, 0xce,
+	0xe4, 0x7b, 0x74, 0x35, 0x3e, 0x13, 0xfc, 0xcc, 0x08, 0xe4, 0xe9, 0x6f, 0x04, 0x06, 0xfc, 0xd3,
+	0x0b, 0x7a, 0x76, 0x2f, 0x80, 0x81, 0xc1, 0x8b, 0x24, 0x37, 0x6b, 0x8e, 0x74, 0xbe, 0xcf, 0xe9,
+	0xac, 0xd2, 0x95, 0xf8, 0x74, 0x2c, 0x1e, 0xd9, 0x4f, 0x66, 0x97, 0xc0, 0x48, 0x68, 0x73, 0x1b,
+	0x55, 0x67, 0xa2, 0x46, 0x23, 0xd2, 0xc5, 0x96, 0xfd, 0x90, 0xe7, 0x43, 0xce, 0x73, 0x85, 0xde,
+	0x8f, 0xcf, 0x53, 0xd5, 0x36, 0x02, 0x2c, 0x3f, 0x11, 0xf8, 0x52, 0xe8, 0xe6, 0x16, 0x6d, 0x15,
+	0xae, 0x97, 0xc6, 0x4b, 0xad, 0x3b, 0x22, 0xd1, 0x47, 0x9c, 0xe8, 0x77, 0x68, 0xfa, 0x40, 0x88,
+	0x06, 0xe9, 0x3c, 0xeb, 0x84, 0x63, 0x75, 0xad, 0x71, 0x54, 0x11, 0x69, 0xd4, 0xe0, 0x4b, 0xf3,
+	0x2d, 0xf9, 0x1c, 0xe8, 0xbb, 0x22, 0xac, 0x4e, 0x46, 0x0c, 0x0d, 0x76, 0x94, 0xb2, 0x07, 0x28,
+	0x53, 0x44, 0xca, 0xff, 0x25, 0x70, 0x24, 0xd8, 0x20, 0x53, 0xa5, 0x19, 0x46, 0xbe, 0x96, 0x5e,
+	0x3a, 0xd7, 0xbc, 0x03, 0xf2, 0xff, 0x31, 0xa7, 0x5f, 0xa1, 0x76, 0x7b, 0xd8, 0x07, 0x26, 0x04,
+	0x01, 0xda, 0xce, 0x89, 0xa7, 0xff, 0x20, 0x30, 0x14, 0xd2, 0x41, 0xd3, 0x88, 0x6f, 0x9a, 0xc6,
+	0xcd, 0xbc, 0xf4, 0xf5, 0x16, 0xbd, 0x50, 0x82, 0x65, 0x2e, 0xc1, 0x2d, 0x7a, 0x33, 0x86, 0x04,
+	0x81, 0xf6, 0xd6, 0xf9, 0xbc, 0x1b, 0xac, 0x6d, 0x86, 0xa3, 0x5e, 0xfb, 0x0d, 0x3a, 0x72, 0x69,
+	0xae, 0x15, 0x97, 0x03, 0x7c, 0x2b, 0xd6, 0x37, 0xeb, 0xce, 0x37, 0xf7, 0x80, 0xbf, 0xc1, 0x8d,
+	0x7a, 0x87, 0x84, 0x74, 0xd7, 0x92, 0xdc, 0xac, 0xf9, 0x01, 0x26, 0x05, 0x9b, 0xc6, 0x0c, 0x6f,
+	0xa1, 0xe9, 0xef, 0x09, 0xf4, 0xe0, 0x56, 0x51, 0x5d, 0x56, 0xb0, 0xff, 0x95, 0x4e, 0x37, 0x61,
+	0x89, 0x90, 0x6f, 0x71, 0xc8, 0xdf, 0xa2, 0x0b, 0xf1, 0x21, 0xd3, 0x5f, 0x12, 0x38, 0x1c, 0xe8,
+	0x35, 0xa3, 0x3e, 0x42, 0xc2, 0x3a, 0x56, 0x49, 0x69, 0xda, 0x1e, 0xe1, 0x9f, 0xe4, 0xf0, 0x27,
+	0xe9, 0x78, 0x28, 0x7c, 0xb7, 0x69, 0x5d, 0x58, 0x79, 0xfd, 0x21, 0x49, 0xde, 0x7c, 0x48, 0x92,
+	0x7f, 0x7f, 0x48, 0x92, 0x17, 0xbb, 0xc9, 0x8e, 0x37, 0xbb, 0xc9, 0x8e, 0x7f, 0xee, 0x26, 0x3b,
+	0x1e, 0x5d, 0xce, 0xeb, 0xf6, 0x7a, 0x39, 0x2b, 0x6b, 0x66, 0x41, 0xc1, 0x7f, 0x53, 0xd2, 0xb3,
+	0xda, 0xd9, 0xbc, 0xa9, 0x54, 0x2e, 0x2b, 0x05, 0x33, 0x57, 0xde, 0x64, 0x96, 0x1b, 0xf5, 0xdc,
+	0xf9, 0xb3, 0x22, 0xb0, 0xbd, 0x55, 0x64, 0x56, 0xb6, 0x9b, 0xff, 0xbd, 0x78, 0xfe, 0xff, 0x03,
+	0x00, 0xdc, 0xdc, 0xad, 0x42, 0x36, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2298,6 +2434,8 @@ type QueryClient interface {
 	// PacketReceipt queries if a given packet sequence has been received on the
 	// queried chain
 	PacketReceipt(ctx context.Context, in *QueryPacketReceiptRequest, opts ...grpc.CallOption) (*QueryPacketReceiptResponse, error)
+	// PacketStatus queries the stage of its lifecycle that a packet has reached on this chain.
+	PacketStatus(ctx context.Context, in *QueryPacketStatusRequest, opts ...grpc.CallOption) (*QueryPacketStatusResponse, error)
 	// PacketAcknowledgement queries a stored packet acknowledgement hash.
 	PacketAcknowledgement(ctx context.Context, in *QueryPacketAcknowledgementRequest, opts ...grpc.CallOption) (*QueryPacketAcknowledgementResponse, error)
 	// PacketAcknowledgements returns all the packet acknowledgements associated
@@ -2401,6 +2539,15 @@ func (c *queryClient) PacketReceipt(ctx context.Context, in *QueryPacketReceiptR
 	return out, nil
 }
 
+func (c *queryClient) PacketStatus(ctx context.Context, in *QueryPacketStatusRequest, opts ...grpc.CallOption) (*QueryPacketStatusResponse, error) {
+	out := new(QueryPacketStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PacketStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PacketAcknowledgement(ctx context.Context, in *QueryPacketAcknowledgementRequest, opts ...grpc.CallOption) (*QueryPacketAcknowledgementResponse, error) {
 	out := new(QueryPacketAcknowledgementResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PacketAcknowledgement", in, out, opts...)
@@ -2505,6 +2652,8 @@ type QueryServer interface {
 	// PacketReceipt queries if a given packet sequence has been received on the
 	// queried chain
 	PacketReceipt(context.Context, *QueryPacketReceiptRequest) (*QueryPacketReceiptResponse, error)
+	// PacketStatus queries the stage of its lifecycle that a packet has reached on this chain.
+	PacketStatus(context.Context, *QueryPacketStatusRequest) (*QueryPacketStatusResponse, error)
 	// PacketAcknowledgement queries a stored packet acknowledgement hash.
 	PacketAcknowledgement(context.Context, *QueryPacketAcknowledgementRequest) (*QueryPacketAcknowledgementResponse, error)
 	// PacketAcknowledgements returns all the packet acknowledgements associated
@@ -2556,6 +2705,9 @@ func (*UnimplementedQueryServer) PacketCommitments(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) PacketReceipt(ctx context.Context, req *QueryPacketReceiptRequest) (*QueryPacketReceiptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketReceipt not implemented")
 }
+func (*UnimplementedQueryServer) PacketStatus(ctx context.Context, req *QueryPacketStatusRequest) (*QueryPacketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketStatus not implemented")
+}
 func (*UnimplementedQueryServer) PacketAcknowledgement(ctx context.Context, req *QueryPacketAcknowledgementRequest) (*QueryPacketAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketAcknowledgement not implemented")
 }
@@ -2732,6 +2884,24 @@ func _Query_PacketReceipt_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PacketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/PacketStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketStatus(ctx, req.(*QueryPacketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PacketAcknowledgement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPacketAcknowledgementRequest)
 	if err := dec(in); err != nil {
@@ -2930,6 +3100,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PacketReceipt",
 			Handler:    _Query_PacketReceipt_Handler,
 		},
+		{
+			MethodName: "PacketStatus",
+			Handler:    _Query_PacketStatus_Handler,
+		},
 		{
 			MethodName: "PacketAcknowledgement",
 			Handler:    _Query_PacketAcknowledgement_Handler,
@@ -3739,6 +3913,101 @@ func (m *QueryPacketReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPacketStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination {
+		i--
+		if m.Destination {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPacketAcknowledgementRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3849,20 +4118,20 @@ func (m *QueryPacketAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA20 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j19 int
+		dAtA22 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j21 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j21++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintQuery(dAtA, i, uint64(j19))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintQuery(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -3975,20 +4244,20 @@ func (m *QueryUnreceivedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA25 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j24 int
+		dAtA27 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j26 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j24++
+				j26++
 			}
-			dAtA25[j24] = uint8(num)
-			j24++
+			dAtA27[j26] = uint8(num)
+			j26++
 		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintQuery(dAtA, i, uint64(j24))
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintQuery(dAtA, i, uint64(j26))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4040,20 +4309,20 @@ func (m *QueryUnreceivedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA28 := make([]byte, len(m.Sequences)*10)
-		var j27 int
+		dAtA30 := make([]byte, len(m.Sequences)*10)
+		var j29 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j29++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintQuery(dAtA, i, uint64(j27))
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintQuery(dAtA, i, uint64(j29))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4081,20 +4350,20 @@ func (m *QueryUnreceivedAcksRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if len(m.PacketAckSequences) > 0 {
-		dAtA30 := make([]byte, len(m.PacketAckSequences)*10)
-		var j29 int
+		dAtA32 := make([]byte, len(m.PacketAckSequences)*10)
+		var j31 int
 		for _, num := range m.PacketAckSequences {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j31++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintQuery(dAtA, i, uint64(j29))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintQuery(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4146,20 +4415,20 @@ func (m *QueryUnreceivedAcksResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA33 := make([]byte, len(m.Sequences)*10)
-		var j32 int
+		dAtA35 := make([]byte, len(m.Sequences)*10)
+		var j34 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j34++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintQuery(dAtA, i, uint64(j32))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintQuery(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4882,7 +5151,7 @@ func (m *QueryPacketReceiptResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryPacketAcknowledgementRequest) Size() (n int) {
+func (m *QueryPacketStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4899,26 +5168,62 @@ func (m *QueryPacketAcknowledgementRequest) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovQuery(uint64(m.Sequence))
 	}
+	if m.Destination {
+		n += 2
+	}
 	return n
 }
 
-func (m *QueryPacketAcknowledgementResponse) Size() (n int) {
+func (m *QueryPacketStatusResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Acknowledgement)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.ProofHeight.Size()
+	l = m.Lifecycle.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	return n
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketAcknowledgementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketAcknowledgementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryPacketAcknowledgementsRequest) Size() (n int) {
@@ -7458,6 +7763,275 @@ func (m *QueryPacketReceiptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPacketStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Destination = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPacketAcknowledgementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,33 @@
+package channelv2
+
+import (
+	sdktelemetry "github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/core/internal/v2/telemetry"
+)
+
+// MaxAsyncPacketExpiriesPerBlock is the maximum number of expired async packets for which an
+// error acknowledgement is written in a single BeginBlocker. Remaining expired packets are processed
+// in subsequent blocks, or may be expired by anyone using MsgExpireAsyncPacket.
+const MaxAsyncPacketExpiriesPerBlock = 100
+
+// BeginBlocker writes error acknowledgements for packets awaiting an asynchronous acknowledgement
+// whose deadline has elapsed.
+func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	k.ExpireAsyncPackets(ctx, MaxAsyncPacketExpiriesPerBlock)
+}
+
+// EndBlocker reports the number of packets awaiting their acknowledgement or timeout for each client
+// over which packets have been sent. Nothing is done if telemetry is disabled.
+func EndBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	if !sdktelemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	k.IteratePendingCommitmentCounts(ctx, func(clientID string, count uint64) bool {
+		telemetry.ReportPendingCommitments(clientID, count)
+		return false
+	})
+}
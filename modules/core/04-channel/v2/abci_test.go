@@ -0,0 +1,57 @@
+package channelv2_test
+
+import (
+	"time"
+
+	channelv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
+)
+
+// TestBeginBlocker tests that error acknowledgements are written for expired async packets.
+func (suite *ModuleTestSuite) TestBeginBlocker() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	ctx := suite.chainB.GetContext()
+	k := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2
+
+	deadline := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(2 * time.Hour).Unix())
+
+	// mock the receipt of two packets awaiting an async acknowledgement, only the first of which has a deadline
+	expiringPacket := types.NewPacket(1, path.EndpointA.ClientID, path.EndpointB.ClientID, timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	pendingPacket := types.NewPacket(2, path.EndpointA.ClientID, path.EndpointB.ClientID, timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	for _, packet := range []types.Packet{expiringPacket, pendingPacket} {
+		k.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
+		k.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence, packet)
+	}
+	k.SetAsyncPacketDeadline(ctx, expiringPacket.DestinationClient, expiringPacket.Sequence, deadline)
+
+	// the deadline has not yet elapsed
+	channelv2.BeginBlocker(ctx, k)
+
+	_, found := k.GetAsyncPacket(ctx, expiringPacket.DestinationClient, expiringPacket.Sequence)
+	suite.Require().True(found)
+	suite.Require().Empty(k.GetPacketAcknowledgement(ctx, expiringPacket.DestinationClient, expiringPacket.Sequence))
+
+	// the deadline has elapsed
+	ctx = ctx.WithBlockTime(time.Unix(int64(deadline), 0))
+	channelv2.BeginBlocker(ctx, k)
+
+	_, found = k.GetAsyncPacket(ctx, expiringPacket.DestinationClient, expiringPacket.Sequence)
+	suite.Require().False(found)
+
+	_, found = k.GetAsyncPacketDeadline(ctx, expiringPacket.DestinationClient, expiringPacket.Sequence)
+	suite.Require().False(found)
+	suite.Require().Empty(k.GetExpiredAsyncPackets(ctx, deadline, channelv2.MaxAsyncPacketExpiriesPerBlock))
+
+	// the sentinel error acknowledgement is written as the counterparty has not opted in to structured error acknowledgements
+	expAck := types.NewAcknowledgement(types.ErrorAcknowledgement[:])
+	suite.Require().Equal(types.CommitAcknowledgement(expAck), k.GetPacketAcknowledgement(ctx, expiringPacket.DestinationClient, expiringPacket.Sequence))
+
+	// packets without a deadline never expire
+	_, found = k.GetAsyncPacket(ctx, pendingPacket.DestinationClient, pendingPacket.Sequence)
+	suite.Require().True(found)
+}
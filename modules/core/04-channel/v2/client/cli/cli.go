@@ -24,8 +24,14 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryPacketCommitments(),
 		getCmdQueryPacketAcknowledgement(),
 		getCmdQueryPacketReceipt(),
+		getCmdQueryPacketStatus(),
 		getCmdQueryUnreceivedPackets(),
 		getCmdQueryUnreceivedAcks(),
+		getCmdQueryPruningSequenceStart(),
+		getCmdQueryAsyncPackets(),
+		getCmdQueryPacket(),
+		getCmdQueryPackets(),
+		getCmdQueryChannelParams(),
 	)
 
 	return queryCmd
@@ -41,8 +47,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// TODO: Add v2 packet commands: https://github.com/cosmos/ibc-go/issues/7853
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		newSendPacketTxCmd(),
+		newRelayPacketTxCmd(),
+	)
 
 	return txCmd
 }
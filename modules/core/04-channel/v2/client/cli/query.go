@@ -15,7 +15,8 @@ import (
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences   = "sequences"
+	flagDestination = "destination"
 )
 
 // getCmdQueryNextSequenceSend defines the command to query a next send sequence for a given client
@@ -348,3 +349,227 @@ The return value represents:
 
 	return cmd
 }
+
+// getCmdQueryPacketStatus defines the command to query the lifecycle status of a packet for a given client and sequence.
+func getCmdQueryPacketStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-status [client-id] [sequence]",
+		Short: "Query the lifecycle status of a packet",
+		Long: `Query the stage of its lifecycle that a packet has reached on this chain, together with the height and time
+at which it was sent if it awaits its acknowledgement or timeout. By default the packet sent over the client is
+queried, use --destination to query the packet received over the client.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-status [client-id] [sequence] --destination", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			destination, err := cmd.Flags().GetBool(flagDestination)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PacketStatus(cmd.Context(), types.NewQueryPacketStatusRequest(args[0], seq, destination))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagDestination, false, "query the packet received over the client instead of the packet sent")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryPruningSequenceStart defines the command to query the pruning sequence start for a given client.
+func getCmdQueryPruningSequenceStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning-sequence-start [client-id]",
+		Short: "Query the pruning sequence start",
+		Long:  "Query the next sequence of packet acknowledgements and receipts to be pruned for a given client",
+		Example: fmt.Sprintf(
+			"%s query %s %s pruning-sequence-start [client-id]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PruningSequenceStart(cmd.Context(), types.NewQueryPruningSequenceStartRequest(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryChannelParams defines the command to query the channel v2 parameters
+func getCmdQueryChannelParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc channel/v2 parameters",
+		Long:    "Query the current ibc channel/v2 parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s %s params", version.AppName, exported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelParams(cmd.Context(), &types.QueryChannelParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryAsyncPackets defines the command to query the packets awaiting an asynchronous acknowledgement for a given client
+func getCmdQueryAsyncPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "async-packets [client-id]",
+		Short: "Query all packets awaiting an asynchronous acknowledgement",
+		Long:  "Query all packets awaiting an asynchronous acknowledgement written by the application for a given client, together with their deadlines",
+		Example: fmt.Sprintf(
+			"%s query %s %s async-packets [client-id]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAsyncPacketsRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AsyncPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "async packets")
+
+	return cmd
+}
+
+// getCmdQueryPacket defines the command to query an archived packet for a given client and sequence.
+func getCmdQueryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet [client-id] [sequence]",
+		Short: "Query an archived packet",
+		Long:  "Query a packet sent over a given client which is archived until it is acknowledged or times out",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet [client-id] [sequence]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Packet(cmd.Context(), types.NewQueryPacketRequest(args[0], seq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryPackets defines the command to query all archived packets for a given client
+func getCmdQueryPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packets [client-id]",
+		Short: "Query all archived packets",
+		Long:  "Query all packets sent over a given client which are archived until they are acknowledged or time out",
+		Example: fmt.Sprintf(
+			"%s query %s %s packets [client-id]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketsRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Packets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "archived packets")
+
+	return cmd
+}
@@ -0,0 +1,317 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+const (
+	flagDestinationPort        = "destination-port"
+	flagVersion                = "version"
+	flagEncoding               = "encoding"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagSourceNode             = "source-node"
+	flagProofHeight            = "proof-height"
+	flagAcknowledgementFile    = "acknowledgement-file"
+)
+
+// defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in seconds) relative
+// to the local clock time. The default is currently set to a 10 minute timeout.
+var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Seconds())
+
+// newSendPacketTxCmd returns the command to create a new MsgSendPacket transaction
+func newSendPacketTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-packet [client-id] [port] [payload-file]",
+		Short: "Send a packet with a single payload over a client",
+		Long: strings.TrimSpace(`Send a packet with a single payload over a client. The payload value is read as raw bytes from the payload file
+and is sent from the given port to the port given by the {destination-port} flag, which defaults to the same port. The version
+and encoding of the payload must be set using the {version} and {encoding} flags. The timeout timestamp is relative to the
+local clock time unless the {absolute-timeouts} flag is used, and defaults to 10 minutes from now. Note that the port must be
+bound to an application which accepts packets sent by the signer of the transaction.`),
+		Example: fmt.Sprintf(
+			"%s tx %s %s send-packet [client-id] [port] [payload-file] --version [version] --encoding [encoding]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID, sourcePort := args[0], args[1]
+
+			value, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			destinationPort, err := cmd.Flags().GetString(flagDestinationPort)
+			if err != nil {
+				return err
+			}
+
+			if destinationPort == "" {
+				destinationPort = sourcePort
+			}
+
+			payloadVersion, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			if err != nil {
+				return err
+			}
+
+			// if the timeout is not absolute, CLI users rely solely on local clock time in order to calculate the timeout timestamp.
+			if !absoluteTimeouts {
+				if timeoutTimestamp == 0 {
+					return errors.New("relative timeouts must provide a non zero value timestamp")
+				}
+
+				// use local clock time as reference time for calculating timeout timestamp.
+				now := time.Now().Unix()
+				if now <= 0 {
+					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+				}
+
+				timeoutTimestamp = uint64(now) + timeoutTimestamp
+			}
+
+			payload := types.NewPayload(sourcePort, destinationPort, payloadVersion, encoding, value)
+			msg := types.NewMsgSendPacket(clientID, timeoutTimestamp, clientCtx.GetFromAddress().String(), payload)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDestinationPort, "", "Port on the counterparty chain to which the payload is sent. Defaults to the source port.")
+	cmd.Flags().String(flagVersion, "", "Version of the application the payload is sent to.")
+	cmd.Flags().String(flagEncoding, "", "Encoding of the payload value.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in seconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flag is used as an absolute unix timestamp in seconds.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newRelayPacketTxCmd returns the command to relay a packet, creating a new MsgRecvPacket, MsgAcknowledgement
+// or MsgTimeout transaction depending on the state of the packet on the source node.
+func newRelayPacketTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-packet [packet-file]",
+		Short: "Relay a packet using proofs queried from a counterparty node",
+		Long: strings.TrimSpace(`Relay the JSON encoded packet read from the packet file, such as the packet returned by the packet query of the
+sending chain. The proofs are queried from the node of the counterparty chain given by the {source-node} flag and the
+matching message is submitted to this chain:
+  - if the packet commitment is stored on the counterparty chain, the packet is received;
+  - if an acknowledgement for the packet is stored on the counterparty chain, the packet is acknowledged. As only the
+    commitment of the acknowledgement is stored, the JSON encoded acknowledgement must be given by the {acknowledgement-file} flag;
+  - otherwise, the packet is timed out using the proof that no receipt is stored on the counterparty chain.
+The proofs are queried at the height given by the {proof-height} flag, which defaults to the latest height. The client on
+this chain must have been updated to the returned proof height before the transaction is submitted.`),
+		Example: fmt.Sprintf(
+			"%s tx %s %s relay-packet [packet-file] --source-node [node-uri]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var packet types.Packet
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &packet); err != nil {
+				return errorsmod.Wrap(err, "failed to unmarshal packet")
+			}
+
+			if err := packet.ValidateBasic(); err != nil {
+				return err
+			}
+
+			sourceCtx, err := getSourceClientContext(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg, err := relayPacketMsg(cmd, clientCtx, sourceCtx, packet)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSourceNode, "", "<host>:<port> to CometBFT RPC interface of the counterparty chain from which proofs are queried")
+	cmd.Flags().Int64(flagProofHeight, 0, "Height of the counterparty chain at which proofs are queried. Defaults to the latest height.")
+	cmd.Flags().String(flagAcknowledgementFile, "", "File containing the JSON encoded acknowledgement written by the counterparty chain.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getSourceClientContext returns a client context which queries the node of the counterparty chain given by the
+// source node flag at the proof height flag.
+func getSourceClientContext(cmd *cobra.Command, clientCtx client.Context) (client.Context, error) {
+	sourceNode, err := cmd.Flags().GetString(flagSourceNode)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	if strings.TrimSpace(sourceNode) == "" {
+		return client.Context{}, fmt.Errorf("the %s flag must be set", flagSourceNode)
+	}
+
+	proofHeight, err := cmd.Flags().GetInt64(flagProofHeight)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	rpcClient, err := client.NewClientFromNode(sourceNode)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	// the chain ID of the counterparty chain is required to determine the revision number of the proof height.
+	status, err := rpcClient.Status(cmd.Context())
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	return clientCtx.
+		WithNodeURI(sourceNode).
+		WithClient(rpcClient).
+		WithChainID(status.NodeInfo.Network).
+		WithHeight(proofHeight), nil
+}
+
+// relayPacketMsg returns the message relaying the packet to this chain, based on the state of the packet on the
+// counterparty chain queried using the source client context.
+func relayPacketMsg(cmd *cobra.Command, clientCtx, sourceCtx client.Context, packet types.Packet) (sdk.Msg, error) {
+	signer := clientCtx.GetFromAddress().String()
+
+	commitmentRes, err := queryPacketCommitmentABCI(sourceCtx, packet.SourceClient, packet.Sequence)
+	switch {
+	case err == nil:
+		if !bytes.Equal(commitmentRes.Commitment, types.CommitPacket(packet)) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet with sequence (%d) does not match the packet commitment stored on the counterparty chain", packet.Sequence)
+		}
+
+		return types.NewMsgRecvPacket(packet, commitmentRes.Proof, commitmentRes.ProofHeight, signer), nil
+	case !errors.Is(err, types.ErrPacketCommitmentNotFound):
+		return nil, err
+	}
+
+	ackRes, err := queryPacketAcknowledgementABCI(sourceCtx, packet.DestinationClient, packet.Sequence)
+	switch {
+	case err == nil:
+		ack, err := readAcknowledgement(cmd, clientCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(ackRes.Acknowledgement, types.CommitAcknowledgement(ack)) {
+			return nil, errorsmod.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement does not match the acknowledgement commitment stored on the counterparty chain")
+		}
+
+		return types.NewMsgAcknowledgement(packet, ack, ackRes.Proof, ackRes.ProofHeight, signer), nil
+	case !errors.Is(err, types.ErrAcknowledgementNotFound):
+		return nil, err
+	}
+
+	receiptRes, err := queryPacketReceiptABCI(sourceCtx, packet.DestinationClient, packet.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	if receiptRes.Received {
+		return nil, errorsmod.Wrapf(types.ErrAcknowledgementNotFound, "packet with sequence (%d) has been received by the counterparty chain but not yet acknowledged", packet.Sequence)
+	}
+
+	blockTime, err := queryBlockTime(cmd, sourceCtx, int64(receiptRes.ProofHeight.RevisionHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	// packet timeouts are in seconds, so the block time of the counterparty chain is truncated to seconds
+	if uint64(blockTime.Unix()) < packet.TimeoutTimestamp {
+		return nil, errorsmod.Wrapf(types.ErrTimeoutNotReached, "packet with sequence (%d) has not been received by the counterparty chain and has not timed out yet, block time: %d, timeout timestamp: %d", packet.Sequence, blockTime.Unix(), packet.TimeoutTimestamp)
+	}
+
+	return types.NewMsgTimeout(packet, receiptRes.Proof, receiptRes.ProofHeight, signer), nil
+}
+
+// queryBlockTime returns the time of the block at the given height of the chain queried using the client context.
+func queryBlockTime(cmd *cobra.Command, clientCtx client.Context, height int64) (time.Time, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	block, err := node.Block(cmd.Context(), &height)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return block.Block.Time, nil
+}
+
+// readAcknowledgement reads the JSON encoded acknowledgement from the file given by the acknowledgement file flag.
+func readAcknowledgement(cmd *cobra.Command, clientCtx client.Context) (types.Acknowledgement, error) {
+	ackFile, err := cmd.Flags().GetString(flagAcknowledgementFile)
+	if err != nil {
+		return types.Acknowledgement{}, err
+	}
+
+	if strings.TrimSpace(ackFile) == "" {
+		return types.Acknowledgement{}, fmt.Errorf("the packet has been acknowledged on the counterparty chain, the %s flag must be set", flagAcknowledgementFile)
+	}
+
+	bz, err := os.ReadFile(ackFile)
+	if err != nil {
+		return types.Acknowledgement{}, err
+	}
+
+	var ack types.Acknowledgement
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &ack); err != nil {
+		return types.Acknowledgement{}, errorsmod.Wrap(err, "failed to unmarshal acknowledgement")
+	}
+
+	return ack, nil
+}
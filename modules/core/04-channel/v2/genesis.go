@@ -27,31 +27,101 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, seq := range gs.SendSequences {
 		k.SetNextSequenceSend(ctx, seq.ClientId, seq.Sequence)
 	}
+
+	// set pruning sequences
+	for _, seq := range gs.PruningSequences {
+		k.SetPruningSequenceStart(ctx, seq.ClientId, seq.Sequence)
+	}
+
+	// set async packets
+	for _, asyncPacket := range gs.AsyncPackets {
+		k.SetAsyncPacket(ctx, asyncPacket.ClientId, asyncPacket.Sequence, asyncPacket.Packet)
+		if asyncPacket.Deadline != 0 {
+			k.SetAsyncPacketDeadline(ctx, asyncPacket.ClientId, asyncPacket.Sequence, asyncPacket.Deadline)
+		}
+	}
+
+	// set ordered stream states
+	for _, state := range gs.OrderedStreamStates {
+		k.SetOrderedStreamState(ctx, state)
+	}
+
+	// set archived packets
+	for _, packet := range gs.ArchivedPackets {
+		k.SetArchivedPacket(ctx, packet.SourceClient, packet.Sequence, packet)
+	}
+
+	// set send packet lifecycles
+	for _, spl := range gs.SendPacketLifecycles {
+		k.SetSendPacketLifecycle(ctx, spl.ClientId, spl.Sequence, spl.Lifecycle)
+	}
+
+	// set recv proof heights
+	for _, rph := range gs.RecvProofHeights {
+		k.SetRecvProofHeight(ctx, rph.ClientId, rph.ProofHeight)
+	}
+
+	k.SetParams(ctx, gs.Params)
 }
 
 func ExportGenesis(ctx context.Context, k *keeper.Keeper) types.GenesisState {
 	clientStates := k.ClientKeeper.GetAllGenesisClients(ctx)
 	gs := types.GenesisState{
-		Acknowledgements: make([]types.PacketState, 0),
-		Commitments:      make([]types.PacketState, 0),
-		Receipts:         make([]types.PacketState, 0),
-		SendSequences:    make([]types.PacketSequence, 0),
+		Acknowledgements:     make([]types.PacketState, 0),
+		Commitments:          make([]types.PacketState, 0),
+		Receipts:             make([]types.PacketState, 0),
+		SendSequences:        make([]types.PacketSequence, 0),
+		PruningSequences:     make([]types.PacketSequence, 0),
+		AsyncPackets:         make([]types.AsyncPacket, 0),
+		OrderedStreamStates:  make([]types.OrderedStreamState, 0),
+		ArchivedPackets:      make([]types.Packet, 0),
+		RecvProofHeights:     make([]types.ClientProofHeight, 0),
+		SendPacketLifecycles: make([]types.SendPacketLifecycle, 0),
+		Params:               k.GetParams(ctx),
 	}
+	// packet state is stored under client identifiers as well as under the identifiers of aliased v1 channels
+	ids := make([]string, 0, len(clientStates))
 	for _, clientState := range clientStates {
-		acks := k.GetAllPacketAcknowledgementsForClient(ctx, clientState.ClientId)
+		ids = append(ids, clientState.ClientId)
+	}
+	ids = append(ids, k.GetAllAliasableV1ChannelIDs(ctx)...)
+
+	for _, id := range ids {
+		acks := k.GetAllPacketAcknowledgementsForClient(ctx, id)
 		gs.Acknowledgements = append(gs.Acknowledgements, acks...)
 
-		comms := k.GetAllPacketCommitmentsForClient(ctx, clientState.ClientId)
+		comms := k.GetAllPacketCommitmentsForClient(ctx, id)
 		gs.Commitments = append(gs.Commitments, comms...)
 
-		receipts := k.GetAllPacketReceiptsForClient(ctx, clientState.ClientId)
+		receipts := k.GetAllPacketReceiptsForClient(ctx, id)
 		gs.Receipts = append(gs.Receipts, receipts...)
 
-		seq, ok := k.GetNextSequenceSend(ctx, clientState.ClientId)
+		seq, ok := k.GetNextSequenceSend(ctx, id)
 		if ok {
-			gs.SendSequences = append(gs.SendSequences, types.NewPacketSequence(clientState.ClientId, seq))
+			gs.SendSequences = append(gs.SendSequences, types.NewPacketSequence(id, seq))
+		}
+
+		pruningSeq, ok := k.GetPruningSequenceStart(ctx, id)
+		if ok {
+			gs.PruningSequences = append(gs.PruningSequences, types.NewPacketSequence(id, pruningSeq))
+		}
+
+		asyncPackets := k.GetAllAsyncPacketsForClient(ctx, id)
+		gs.AsyncPackets = append(gs.AsyncPackets, asyncPackets...)
+
+		archivedPackets := k.GetAllArchivedPacketsForClient(ctx, id)
+		gs.ArchivedPackets = append(gs.ArchivedPackets, archivedPackets...)
+
+		sendPacketLifecycles := k.GetAllSendPacketLifecyclesForClient(ctx, id)
+		gs.SendPacketLifecycles = append(gs.SendPacketLifecycles, sendPacketLifecycles...)
+
+		recvProofHeight, ok := k.GetRecvProofHeight(ctx, id)
+		if ok {
+			gs.RecvProofHeights = append(gs.RecvProofHeights, types.NewClientProofHeight(id, recvProofHeight))
 		}
 	}
 
+	gs.OrderedStreamStates = append(gs.OrderedStreamStates, k.GetAllOrderedStreamStates(ctx)...)
+
 	return gs
 }
@@ -1,9 +1,11 @@
 package channelv2_test
 
 import (
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channelv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
 )
 
 // TestInitExportGenesis tests the import and export flow for the channel v2 keeper.
@@ -14,6 +16,10 @@ func (suite *ModuleTestSuite) TestInitExportGenesis() {
 	path2 := ibctesting.NewPath(suite.chainA, suite.chainC)
 	path2.SetupV2()
 
+	// packet state of aliased v1 channels is stored under the channel identifier
+	path3 := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path3.Setup()
+
 	app := suite.chainA.App
 
 	emptyGenesis := types.DefaultGenesisState()
@@ -26,14 +32,30 @@ func (suite *ModuleTestSuite) TestInitExportGenesis() {
 		receipt := types.NewPacketState(clientState.ClientId, uint64(i+1), []byte{byte(0x2)})
 		commitment := types.NewPacketState(clientState.ClientId, uint64(i+1), []byte("commit_hash"))
 		seq := types.NewPacketSequence(clientState.ClientId, uint64(i+1))
+		packet := types.NewPacket(uint64(i+1), ibctesting.FirstClientID, clientState.ClientId, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+		asyncPacket := types.NewAsyncPacket(clientState.ClientId, uint64(i+1), packet)
+		asyncPacket.Deadline = uint64(1000 + i)
 
 		validGs.Acknowledgements = append(validGs.Acknowledgements, ack)
 		validGs.Receipts = append(validGs.Receipts, receipt)
 		validGs.Commitments = append(validGs.Commitments, commitment)
 		validGs.SendSequences = append(validGs.SendSequences, seq)
+		validGs.PruningSequences = append(validGs.PruningSequences, seq)
+		validGs.AsyncPackets = append(validGs.AsyncPackets, asyncPacket)
+		validGs.RecvProofHeights = append(validGs.RecvProofHeights, types.NewClientProofHeight(clientState.ClientId, clienttypes.NewHeight(1, uint64(i+1))))
+		validGs.SendPacketLifecycles = append(validGs.SendPacketLifecycles, types.NewSendPacketLifecycle(clientState.ClientId, uint64(i+1), types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(1, uint64(i+1)), uint64(1000+i))))
 		emptyGenesis.SendSequences = append(emptyGenesis.SendSequences, seq)
 	}
 
+	channelID := path3.EndpointA.ChannelID
+	packet := types.NewPacket(1, channelID, path3.EndpointB.ChannelID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	aliasedGs := validGs
+	aliasedGs.Acknowledgements = append(aliasedGs.Acknowledgements, types.NewPacketState(channelID, 1, []byte("ack")))
+	aliasedGs.Receipts = append(aliasedGs.Receipts, types.NewPacketState(channelID, 1, []byte{byte(0x2)}))
+	aliasedGs.Commitments = append(aliasedGs.Commitments, types.NewPacketState(channelID, 1, []byte("commit_hash")))
+	aliasedGs.SendSequences = append(aliasedGs.SendSequences, types.NewPacketSequence(channelID, 2))
+	aliasedGs.AsyncPackets = append(aliasedGs.AsyncPackets, types.NewAsyncPacket(channelID, 1, packet))
+
 	tests := []struct {
 		name     string
 		genState types.GenesisState
@@ -46,6 +68,10 @@ func (suite *ModuleTestSuite) TestInitExportGenesis() {
 			name:     "valid",
 			genState: validGs,
 		},
+		{
+			name:     "valid with aliased v1 channel",
+			genState: aliasedGs,
+		},
 	}
 
 	for _, tt := range tests {
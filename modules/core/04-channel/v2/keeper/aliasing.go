@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypesv1 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// counterpartyEnd holds the information required to verify the counterparty state of an IBC v2 packet.
+type counterpartyEnd struct {
+	// clientID is the identifier of the client used to verify the counterparty state.
+	clientID string
+	// info is the counterparty information of the packet source or destination identifier.
+	info clienttypes.CounterpartyInfo
+	// delayTimePeriod and delayBlockPeriod are the delay periods of the connection of an aliased v1 channel.
+	delayTimePeriod  uint64
+	delayBlockPeriod uint64
+}
+
+// AliasV1Channel returns the client identifier and the counterparty information of the provided v1 channel,
+// allowing the channel identifier to be used as a source or destination identifier of IBC v2 packets.
+// The counterparty information is derived from the channel counterparty and the connection the channel is
+// built upon. Only OPEN and UNORDERED channels may be aliased.
+func (k *Keeper) AliasV1Channel(ctx context.Context, portID, channelID string) (string, clienttypes.CounterpartyInfo, bool) {
+	end, ok := k.aliasV1Channel(ctx, portID, channelID, true)
+	if !ok {
+		return "", clienttypes.CounterpartyInfo{}, false
+	}
+
+	return end.clientID, end.info, true
+}
+
+// aliasV1Channel returns the counterparty end of the provided UNORDERED v1 channel. The channel must be
+// OPEN if requireOpen is true.
+func (k *Keeper) aliasV1Channel(ctx context.Context, portID, channelID string, requireOpen bool) (counterpartyEnd, bool) {
+	channel, ok := k.channelKeeperV1.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return counterpartyEnd{}, false
+	}
+
+	if (requireOpen && channel.State != channeltypesv1.OPEN) || channel.Ordering != channeltypesv1.UNORDERED {
+		return counterpartyEnd{}, false
+	}
+
+	connection, ok := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !ok {
+		return counterpartyEnd{}, false
+	}
+
+	// IBC v2 paths are appended to the last element of the merkle prefix, thus an empty element is
+	// added so that the paths are stored directly under the counterparty connection key prefix.
+	merklePrefix := [][]byte{connection.Counterparty.Prefix.KeyPrefix, []byte("")}
+
+	return counterpartyEnd{
+		clientID:         connection.ClientId,
+		info:             clienttypes.NewCounterpartyInfo(merklePrefix, channel.Counterparty.ChannelId),
+		delayTimePeriod:  connection.DelayPeriod,
+		delayBlockPeriod: k.connectionKeeper.GetBlockDelay(ctx, connection),
+	}, true
+}
+
+// GetAllAliasableV1ChannelIDs returns the identifiers of all UNORDERED v1 channels. As these channels may
+// have been aliased, IBC v2 packet state may be stored under their identifiers, regardless of their state.
+func (k *Keeper) GetAllAliasableV1ChannelIDs(ctx context.Context) []string {
+	var channelIDs []string
+	for _, channel := range k.channelKeeperV1.GetAllChannels(ctx) {
+		if channel.Ordering == channeltypesv1.UNORDERED {
+			channelIDs = append(channelIDs, channel.ChannelId)
+		}
+	}
+
+	return channelIDs
+}
+
+// getCounterparty returns the counterparty end of the provided identifier. The identifier is either a client
+// identifier for which a counterparty has been registered, or the identifier of a v1 channel bound to the
+// provided port. Aliased v1 channels must be OPEN, unless the counterparty end is used to time out a packet,
+// as packets sent over a channel which has since been closed must still be able to time out.
+// Counterparties whose registration is pending confirmation are not returned, as no packets may flow over them.
+func (k *Keeper) getCounterparty(ctx context.Context, portID, id string, timeout bool) (counterpartyEnd, bool) {
+	if counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, id); ok {
+		if k.ClientKeeper.IsCounterpartyPending(ctx, id) {
+			return counterpartyEnd{}, false
+		}
+
+		return counterpartyEnd{clientID: id, info: counterparty}, true
+	}
+
+	return k.aliasV1Channel(ctx, portID, id, !timeout)
+}
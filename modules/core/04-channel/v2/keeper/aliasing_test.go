@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypesv1 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v9/modules/core/24-host/v2"
+	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	"github.com/cosmos/ibc-go/v9/testing/mock"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
+)
+
+// setupAliasedChannels creates open v1 channels bound to the mock v2 application ports on both chains of the path.
+func (suite *KeeperTestSuite) setupAliasedChannels(path *ibctesting.Path) {
+	path.SetupConnections()
+
+	path.EndpointA.ChannelID = ibctesting.FirstChannelID
+	path.EndpointB.ChannelID = ibctesting.FirstChannelID
+
+	channelA := channeltypesv1.NewChannel(channeltypesv1.OPEN, channeltypesv1.UNORDERED, channeltypesv1.NewCounterparty(mockv2.PortIDB, path.EndpointB.ChannelID), []string{path.EndpointA.ConnectionID}, mock.Version)
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), mockv2.PortIDA, path.EndpointA.ChannelID, channelA)
+
+	channelB := channeltypesv1.NewChannel(channeltypesv1.OPEN, channeltypesv1.UNORDERED, channeltypesv1.NewCounterparty(mockv2.PortIDA, path.EndpointA.ChannelID), []string{path.EndpointB.ConnectionID}, mock.Version)
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainB.GetContext(), mockv2.PortIDB, path.EndpointB.ChannelID, channelB)
+
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+}
+
+func (suite *KeeperTestSuite) TestAliasV1Channel() {
+	var (
+		path   *ibctesting.Path
+		portID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFound bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				portID = mockv2.PortIDB
+			},
+			false,
+		},
+		{
+			"failure: channel is not open",
+			func() {
+				channel, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID)
+				channel.State = channeltypesv1.CLOSED
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID, channel)
+			},
+			false,
+		},
+		{
+			"failure: channel is ordered",
+			func() {
+				channel, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID)
+				channel.Ordering = channeltypesv1.ORDERED
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID, channel)
+			},
+			false,
+		},
+		{
+			"failure: connection not found",
+			func() {
+				channel, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID)
+				channel.ConnectionHops = []string{ibctesting.InvalidID}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID, channel)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.setupAliasedChannels(path)
+
+			portID = mockv2.PortIDA
+
+			tc.malleate()
+
+			clientID, counterparty, found := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.AliasV1Channel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID)
+
+			if tc.expFound {
+				suite.Require().True(found)
+				suite.Require().Equal(path.EndpointA.ClientID, clientID)
+
+				merklePrefix := [][]byte{path.EndpointB.Chain.GetPrefix().KeyPrefix, []byte("")}
+				suite.Require().Equal(clienttypes.NewCounterpartyInfo(merklePrefix, path.EndpointB.ChannelID), counterparty)
+			} else {
+				suite.Require().False(found)
+				suite.Require().Empty(clientID)
+				suite.Require().Empty(counterparty)
+			}
+		})
+	}
+}
+
+// TestAliasedChannelPacketFlow tests sending, receiving and acknowledging an IBC v2 packet using
+// v1 channel identifiers as the packet source and destination identifiers.
+func (suite *KeeperTestSuite) TestAliasedChannelPacketFlow() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.setupAliasedChannels(path)
+
+	timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+	msgSendPacket := types.NewMsgSendPacket(path.EndpointA.ChannelID, timeoutTimestamp, suite.chainA.SenderAccount.GetAddress().String(), payload)
+	_, err := suite.chainA.SendMsgs(msgSendPacket)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(1, path.EndpointA.ChannelID, path.EndpointB.ChannelID, timeoutTimestamp, payload)
+	commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	suite.Require().Equal(types.CommitPacket(packet), commitment)
+
+	nextSequenceSend, found := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), nextSequenceSend)
+
+	// receive the packet on chainB using the aliased channel of chainB
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	proof, proofHeight := path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+	msgRecvPacket := types.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+	_, err = suite.chainB.SendMsgs(msgRecvPacket)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.HasPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+
+	// acknowledge the packet on chainA
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	ack := types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+	proof, proofHeight = path.EndpointB.QueryProof(hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence))
+	msgAcknowledgement := types.NewMsgAcknowledgement(packet, ack, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+	_, err = suite.chainA.SendMsgs(msgAcknowledgement)
+	suite.Require().NoError(err)
+
+	commitment = suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	suite.Require().Empty(commitment)
+}
+
+// TestAliasedChannelConnectionDelay tests that the delay period of the connection of an aliased v1 channel
+// is enforced when verifying the counterparty state.
+func (suite *KeeperTestSuite) TestAliasedChannelConnectionDelay() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.setupAliasedChannels(path)
+
+	connection := path.EndpointB.GetConnection()
+	connection.DelayPeriod = uint64(time.Minute.Nanoseconds())
+	suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID, connection)
+
+	timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+	msgSendPacket := types.NewMsgSendPacket(path.EndpointA.ChannelID, timeoutTimestamp, suite.chainA.SenderAccount.GetAddress().String(), payload)
+	_, err := suite.chainA.SendMsgs(msgSendPacket)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	packet := types.NewPacket(1, path.EndpointA.ChannelID, path.EndpointB.ChannelID, timeoutTimestamp, payload)
+	proof, proofHeight := path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+
+	err = suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.RecvPacketTest(suite.chainB.GetContext(), packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, ibctm.ErrDelayPeriodNotPassed)
+}
+
+// TestAliasedChannelTimeoutOnClosedChannel tests that an IBC v2 packet sent over an aliased v1 channel
+// can be timed out once the channel has been closed.
+func (suite *KeeperTestSuite) TestAliasedChannelTimeoutOnClosedChannel() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.setupAliasedChannels(path)
+
+	timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+	msgSendPacket := types.NewMsgSendPacket(path.EndpointA.ChannelID, timeoutTimestamp, suite.chainA.SenderAccount.GetAddress().String(), payload)
+	_, err := suite.chainA.SendMsgs(msgSendPacket)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(1, path.EndpointA.ChannelID, path.EndpointB.ChannelID, timeoutTimestamp, payload)
+
+	channel, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), mockv2.PortIDA, path.EndpointA.ChannelID)
+	channel.State = channeltypesv1.CLOSED
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), mockv2.PortIDA, path.EndpointA.ChannelID, channel)
+
+	// the packet can no longer be sent or acknowledged over the closed channel
+	_, _, found := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.AliasV1Channel(suite.chainA.GetContext(), mockv2.PortIDA, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	proof, proofHeight := path.EndpointB.QueryProof(hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence))
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.TimeoutPacketTest(suite.chainA.GetContext(), packet, proof, proofHeight)
+	suite.Require().NoError(err)
+
+	commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	suite.Require().Empty(commitment)
+}
@@ -35,6 +35,10 @@ func emitSendPacketEvents(ctx context.Context, packet types.Packet) {
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventSendPacket(packet)); err != nil {
+		panic(err)
+	}
 }
 
 // emitRecvPacketEvents emits events for the RecvPacket handler.
@@ -60,10 +64,15 @@ func emitRecvPacketEvents(ctx context.Context, packet types.Packet) {
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventRecvPacket(packet)); err != nil {
+		panic(err)
+	}
 }
 
-// emitWriteAcknowledgementEvents emits events for WriteAcknowledgement.
-func emitWriteAcknowledgementEvents(ctx context.Context, packet types.Packet, ack types.Acknowledgement) {
+// emitWriteAcknowledgementEvents emits events for WriteAcknowledgement. The acknowledgement error is emitted
+// separately from the written acknowledgement, as it is only written for counterparties which have opted in to it.
+func emitWriteAcknowledgementEvents(ctx context.Context, packet types.Packet, ack types.Acknowledgement, ackErr types.AcknowledgementError, hasAckErr bool) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	encodedPacket, err := proto.Marshal(&packet)
@@ -76,25 +85,36 @@ func emitWriteAcknowledgementEvents(ctx context.Context, packet types.Packet, ac
 		panic(err)
 	}
 
+	writeAckEvent := sdk.NewEvent(
+		types.EventTypeWriteAck,
+		sdk.NewAttribute(types.AttributeKeySrcClient, packet.SourceClient),
+		sdk.NewAttribute(types.AttributeKeyDstClient, packet.DestinationClient),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.TimeoutTimestamp)),
+		sdk.NewAttribute(types.AttributeKeyEncodedPacketHex, hex.EncodeToString(encodedPacket)),
+		sdk.NewAttribute(types.AttributeKeyEncodedAckHex, hex.EncodeToString(encodedAck)),
+	)
+
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeWriteAck,
-			sdk.NewAttribute(types.AttributeKeySrcClient, packet.SourceClient),
-			sdk.NewAttribute(types.AttributeKeyDstClient, packet.DestinationClient),
-			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
-			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.TimeoutTimestamp)),
-			sdk.NewAttribute(types.AttributeKeyEncodedPacketHex, hex.EncodeToString(encodedPacket)),
-			sdk.NewAttribute(types.AttributeKeyEncodedAckHex, hex.EncodeToString(encodedAck)),
-		),
+		writeAckEvent.AppendAttributes(acknowledgementErrorAttributes(ackErr, hasAckErr)...),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	writeAckTypedEvent := types.NewEventWriteAck(packet, ack)
+	if hasAckErr {
+		writeAckTypedEvent.AcknowledgementError = &ackErr
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(writeAckTypedEvent); err != nil {
+		panic(err)
+	}
 }
 
 // emitAcknowledgePacketEvents emits events for the AcknowledgePacket handler.
-func emitAcknowledgePacketEvents(ctx context.Context, packet types.Packet) {
+func emitAcknowledgePacketEvents(ctx context.Context, packet types.Packet, ack types.Acknowledgement) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	encodedPacket, err := proto.Marshal(&packet)
@@ -102,20 +122,26 @@ func emitAcknowledgePacketEvents(ctx context.Context, packet types.Packet) {
 		panic(err)
 	}
 
+	ackPacketEvent := sdk.NewEvent(
+		types.EventTypeAcknowledgePacket,
+		sdk.NewAttribute(types.AttributeKeySrcClient, packet.SourceClient),
+		sdk.NewAttribute(types.AttributeKeyDstClient, packet.DestinationClient),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.TimeoutTimestamp)),
+		sdk.NewAttribute(types.AttributeKeyEncodedPacketHex, hex.EncodeToString(encodedPacket)),
+	)
+
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeAcknowledgePacket,
-			sdk.NewAttribute(types.AttributeKeySrcClient, packet.SourceClient),
-			sdk.NewAttribute(types.AttributeKeyDstClient, packet.DestinationClient),
-			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
-			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.TimeoutTimestamp)),
-			sdk.NewAttribute(types.AttributeKeyEncodedPacketHex, hex.EncodeToString(encodedPacket)),
-		),
+		ackPacketEvent.AppendAttributes(acknowledgementErrorAttributes(ack.AcknowledgementError())...),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventAcknowledgePacket(packet, ack)); err != nil {
+		panic(err)
+	}
 }
 
 // emitTimeoutPacketEvents emits events for the TimeoutPacket handler.
@@ -141,4 +167,38 @@ func emitTimeoutPacketEvents(ctx context.Context, packet types.Packet) {
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventTimeoutPacket(packet)); err != nil {
+		panic(err)
+	}
+}
+
+// emitCloseOrderedStreamEvents emits events for the closure of an ordered stream following a packet timeout.
+func emitCloseOrderedStreamEvents(ctx context.Context, clientID, portID string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCloseOrderedStream,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// acknowledgementErrorAttributes returns the event attributes for the codespace and code of an acknowledgement error.
+// No attributes are returned if the acknowledgement does not contain any error information.
+func acknowledgementErrorAttributes(ackErr types.AcknowledgementError, found bool) []sdk.Attribute {
+	if !found {
+		return nil
+	}
+
+	return []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAckErrorCodespace, ackErr.Codespace),
+		sdk.NewAttribute(types.AttributeKeyAckErrorCode, fmt.Sprintf("%d", ackErr.Code)),
+	}
 }
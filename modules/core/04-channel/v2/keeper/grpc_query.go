@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -212,6 +213,80 @@ func (q *queryServer) PacketReceipt(ctx context.Context, req *types.QueryPacketR
 	return types.NewQueryPacketReceiptResponse(hasReceipt, nil, clienttypes.GetSelfHeight(ctx)), nil
 }
 
+// PacketStatus implements the Query/PacketStatus gRPC method.
+func (q *queryServer) PacketStatus(ctx context.Context, req *types.QueryPacketStatusRequest) (*types.QueryPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	lifecycle := q.GetPacketStatus(ctx, req.ClientId, req.Sequence, req.Destination)
+
+	return types.NewQueryPacketStatusResponse(lifecycle, clienttypes.GetSelfHeight(ctx)), nil
+}
+
+// Packet implements the Query/Packet gRPC method.
+func (q *queryServer) Packet(ctx context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	packet, found := q.GetArchivedPacket(ctx, req.ClientId, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, "archived packet not found")
+	}
+
+	return types.NewQueryPacketResponse(packet, clienttypes.GetSelfHeight(ctx)), nil
+}
+
+// Packets implements the Query/Packets gRPC method.
+func (q *queryServer) Packets(ctx context.Context, req *types.QueryPacketsRequest) (*types.QueryPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var packets []types.Packet
+	store := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.PacketArchivePrefixKey(req.ClientId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var packet types.Packet
+		if err := q.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketsResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+		Height:     clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
 // UnreceivedPackets implements the Query/UnreceivedPackets gRPC method. Given
 // a list of counterparty packet commitments, the querier checks if the packet
 // has already been received by checking if a receipt exists on this
@@ -304,3 +379,79 @@ func (q *queryServer) UnreceivedAcks(ctx context.Context, req *types.QueryUnrece
 		Height:    selfHeight,
 	}, nil
 }
+
+// PruningSequenceStart implements the Query/PruningSequenceStart gRPC method
+func (q *queryServer) PruningSequenceStart(ctx context.Context, req *types.QueryPruningSequenceStartRequest) (*types.QueryPruningSequenceStartResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, found := q.ClientKeeper.GetClientCounterparty(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "client-id %s", req.ClientId).Error(),
+		)
+	}
+
+	// pruning starts from the first sequence if no acknowledgements have been pruned yet
+	sequence, found := q.GetPruningSequenceStart(ctx, req.ClientId)
+	if !found {
+		sequence = 1
+	}
+
+	return types.NewQueryPruningSequenceStartResponse(sequence), nil
+}
+
+// AsyncPackets implements the Query/AsyncPackets gRPC method.
+func (q *queryServer) AsyncPackets(ctx context.Context, req *types.QueryAsyncPacketsRequest) (*types.QueryAsyncPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var asyncPackets []types.AsyncPacket
+	store := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.AsyncPacketPrefixKey(req.ClientId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		sequence, err := strconv.ParseUint(string(key), 10, 64)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidPacket, "invalid async packet sequence: %v", err)
+		}
+
+		var packet types.Packet
+		if err := q.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+
+		asyncPacket := types.NewAsyncPacket(req.ClientId, sequence, packet)
+		asyncPacket.Deadline, _ = q.GetAsyncPacketDeadline(ctx, req.ClientId, sequence)
+
+		asyncPackets = append(asyncPackets, asyncPacket)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAsyncPacketsResponse{
+		AsyncPackets: asyncPackets,
+		Pagination:   pageRes,
+		Height:       clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// ChannelParams implements the Query/ChannelParams gRPC method.
+func (q *queryServer) ChannelParams(ctx context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return types.NewQueryChannelParamsResponse(q.GetParams(ctx)), nil
+}
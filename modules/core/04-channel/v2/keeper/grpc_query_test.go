@@ -1,16 +1,20 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
 )
 
 func (suite *KeeperTestSuite) TestQueryPacketCommitment() {
@@ -504,6 +508,236 @@ func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPacketStatus() {
+	var (
+		expStatus types.PacketLifecycleStatus
+		expHeight bool
+		path      *ibctesting.Path
+		req       *types.QueryPacketStatusRequest
+	)
+
+	enableLifecycleTracking := func() {
+		params := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainA.GetContext())
+		params.PacketLifecycleTrackingEnabled = true
+		suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: packet committed",
+			func() {
+				enableLifecycleTracking()
+
+				packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				expStatus = types.PacketLifecycleStatus_Committed
+				expHeight = true
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: packet committed without lifecycle tracking",
+			func() {
+				packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				expStatus = types.PacketLifecycleStatus_Committed
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: packet acknowledged successfully",
+			func() {
+				enableLifecycleTracking()
+
+				packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+				suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement)))
+
+				expStatus = types.PacketLifecycleStatus_AcknowledgedSuccess
+				expHeight = true
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: packet acknowledged with an error",
+			func() {
+				enableLifecycleTracking()
+
+				packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewErrorMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+				suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, types.NewAcknowledgement(types.ErrorAcknowledgement[:])))
+
+				expStatus = types.PacketLifecycleStatus_AcknowledgedError
+				expHeight = true
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: packet timed out",
+			func() {
+				enableLifecycleTracking()
+
+				packet, err := path.EndpointA.MsgSendPacket(uint64(suite.chainA.GetContext().BlockTime().Unix()), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.MsgTimeoutPacket(packet))
+
+				expStatus = types.PacketLifecycleStatus_TimedOut
+				expHeight = true
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: packet acknowledged without lifecycle tracking",
+			func() {
+				packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+				suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement)))
+
+				expStatus = types.PacketLifecycleStatus_Completed
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: packet timed out without lifecycle tracking",
+			func() {
+				packet, err := path.EndpointA.MsgSendPacket(uint64(suite.chainA.GetContext().BlockTime().Unix()), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.MsgTimeoutPacket(packet))
+
+				expStatus = types.PacketLifecycleStatus_Completed
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, false)
+			},
+			nil,
+		},
+		{
+			"success: received packet acknowledged",
+			func() {
+				suite.chainA.GetSimApp().MockModuleV2A.IBCApp.OnRecvPacket = func(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					return types.RecvPacketResult{Status: types.PacketStatus_Failure}
+				}
+
+				packet, err := path.EndpointB.MsgSendPacket(suite.chainB.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.MsgRecvPacket(packet))
+
+				expStatus = types.PacketLifecycleStatus_Received
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, true)
+			},
+			nil,
+		},
+		{
+			"success: received packet awaiting async acknowledgement",
+			func() {
+				suite.chainA.GetSimApp().MockModuleV2A.IBCApp.OnRecvPacket = func(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					return types.RecvPacketResult{Status: types.PacketStatus_Async}
+				}
+
+				packet, err := path.EndpointB.MsgSendPacket(suite.chainB.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.MsgRecvPacket(packet))
+
+				expStatus = types.PacketLifecycleStatus_AsyncPending
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, packet.Sequence, true)
+			},
+			nil,
+		},
+		{
+			"success: received packet pruned",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ClientID, 2)
+
+				expStatus = types.PacketLifecycleStatus_Pruned
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, 1, true)
+			},
+			nil,
+		},
+		{
+			"success: packet not found",
+			func() {
+				expStatus = types.PacketLifecycleStatus_Unknown
+				req = types.NewQueryPacketStatusRequest(path.EndpointA.ClientID, 1, false)
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = types.NewQueryPacketStatusRequest("", 1, false)
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = types.NewQueryPacketStatusRequest(ibctesting.FirstClientID, 0, false)
+			},
+			status.Error(codes.InvalidArgument, "packet sequence cannot be 0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expHeight = false
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			tc.malleate()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2)
+			res, err := queryServer.PacketStatus(suite.chainA.GetContext(), req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.Lifecycle.Status)
+
+				// the height and time at which the stage was reached are only recorded if lifecycle tracking is enabled
+				suite.Require().Equal(expHeight, !res.Lifecycle.Height.IsZero())
+				suite.Require().Equal(expHeight, res.Lifecycle.Timestamp != 0)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryNextSequenceSend() {
 	var (
 		req    *types.QueryNextSequenceSendRequest
@@ -829,3 +1063,382 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPruningSequenceStart() {
+	var (
+		req    *types.QueryPruningSequenceStartRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expSeq = 42
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ClientID, expSeq)
+				req = types.NewQueryPruningSequenceStartRequest(path.EndpointA.ClientID)
+			},
+			nil,
+		},
+		{
+			"success: no acknowledgements pruned",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expSeq = 1
+				req = types.NewQueryPruningSequenceStartRequest(path.EndpointA.ClientID)
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = types.NewQueryPruningSequenceStartRequest("")
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"counterparty not found",
+			func() {
+				req = types.NewQueryPruningSequenceStartRequest(ibctesting.FirstClientID)
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("client-id %s: %s", ibctesting.FirstClientID, clienttypes.ErrCounterpartyNotFound.Error())),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+			res, err := queryServer.PruningSequenceStart(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.PruningSequenceStart)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAsyncPackets() {
+	var (
+		req             *types.QueryAsyncPacketsRequest
+		expAsyncPackets []types.AsyncPacket
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expAsyncPackets = make([]types.AsyncPacket, 0, 5) // reset expected async packets
+				for i := uint64(1); i <= 5; i++ {
+					packet := types.NewPacket(i, path.EndpointB.ClientID, path.EndpointA.ClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, i, packet)
+
+					asyncPacket := types.NewAsyncPacket(path.EndpointA.ClientID, i, packet)
+					if i%2 == 0 {
+						asyncPacket.Deadline = 1000 + i
+						suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacketDeadline(suite.chainA.GetContext(), path.EndpointA.ClientID, i, asyncPacket.Deadline)
+					}
+
+					expAsyncPackets = append(expAsyncPackets, asyncPacket)
+				}
+
+				req = &types.QueryAsyncPacketsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      6,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: with pagination",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expAsyncPackets = make([]types.AsyncPacket, 0, 5) // reset expected async packets
+				for i := uint64(1); i <= 5; i++ {
+					packet := types.NewPacket(i, path.EndpointB.ClientID, path.EndpointA.ClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, i, packet)
+					expAsyncPackets = append(expAsyncPackets, types.NewAsyncPacket(path.EndpointA.ClientID, i, packet))
+				}
+
+				limit := uint64(3)
+				expAsyncPackets = expAsyncPackets[:limit]
+
+				req = &types.QueryAsyncPacketsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      limit,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryAsyncPacketsRequest{
+					ClientId: "",
+				}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2)
+			res, err := queryServer.AsyncPackets(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAsyncPackets, res.AsyncPackets)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacket() {
+	var (
+		req       *types.QueryPacketRequest
+		expPacket types.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expPacket = types.NewPacket(1, path.EndpointA.ClientID, path.EndpointB.ClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, 1, expPacket)
+
+				req = types.NewQueryPacketRequest(path.EndpointA.ClientID, 1)
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = types.NewQueryPacketRequest("", 1)
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = types.NewQueryPacketRequest(ibctesting.FirstClientID, 0)
+			},
+			status.Error(codes.InvalidArgument, "packet sequence cannot be 0"),
+		},
+		{
+			"packet not found",
+			func() {
+				req = types.NewQueryPacketRequest(ibctesting.FirstClientID, 1)
+			},
+			status.Error(codes.NotFound, "archived packet not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2)
+			res, err := queryServer.Packet(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPacket, res.Packet)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPackets() {
+	var (
+		req        *types.QueryPacketsRequest
+		expPackets []types.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expPackets = make([]types.Packet, 0, 5) // reset expected packets
+				for i := uint64(1); i <= 5; i++ {
+					packet := types.NewPacket(i, path.EndpointA.ClientID, path.EndpointB.ClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, i, packet)
+					expPackets = append(expPackets, packet)
+				}
+
+				req = &types.QueryPacketsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      6,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: with pagination",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupV2()
+
+				expPackets = make([]types.Packet, 0, 5) // reset expected packets
+				for i := uint64(1); i <= 5; i++ {
+					packet := types.NewPacket(i, path.EndpointA.ClientID, path.EndpointB.ClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, i, packet)
+					expPackets = append(expPackets, packet)
+				}
+
+				limit := uint64(3)
+				expPackets = expPackets[:limit]
+
+				req = &types.QueryPacketsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      limit,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryPacketsRequest{
+					ClientId: "",
+				}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2)
+			res, err := queryServer.Packets(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPackets, res.Packets)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.DefaultParams()
+
+	queryServer := keeper.NewQueryServer(suite.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+	res, err := queryServer.ChannelParams(ctx, &types.QueryChannelParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
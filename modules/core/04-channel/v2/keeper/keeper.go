@@ -3,8 +3,13 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
 
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -12,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	connectionkeeper "github.com/cosmos/ibc-go/v9/modules/core/03-connection/keeper"
 	channelkeeperv1 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
@@ -90,6 +96,14 @@ func (k *Keeper) SetPacketReceipt(ctx context.Context, clientID string, sequence
 	}
 }
 
+// deletePacketReceipt deletes the packet receipt from the receipt path.
+func (k *Keeper) deletePacketReceipt(ctx context.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(hostv2.PacketReceiptKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
 // GetPacketAcknowledgement fetches the packet acknowledgement from the store.
 func (k *Keeper) GetPacketAcknowledgement(ctx context.Context, clientID string, sequence uint64) []byte {
 	store := k.storeService.OpenKVStore(ctx)
@@ -109,6 +123,14 @@ func (k *Keeper) SetPacketAcknowledgement(ctx context.Context, clientID string,
 	}
 }
 
+// deletePacketAcknowledgement deletes the packet acknowledgement hash from the acknowledgement path.
+func (k *Keeper) deletePacketAcknowledgement(ctx context.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(hostv2.PacketAcknowledgementKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
 // HasPacketAcknowledgement checks if the packet ack hash is already on the store.
 func (k *Keeper) HasPacketAcknowledgement(ctx context.Context, clientID string, sequence uint64) bool {
 	return len(k.GetPacketAcknowledgement(ctx, clientID, sequence)) > 0
@@ -128,21 +150,44 @@ func (k *Keeper) GetPacketCommitment(ctx context.Context, clientID string, seque
 }
 
 // SetPacketCommitment writes the commitment hash under the commitment path.
+// The pending commitment count of the client is incremented if no commitment was stored for the sequence.
 func (k *Keeper) SetPacketCommitment(ctx context.Context, clientID string, sequence uint64, commitment []byte) {
 	store := k.storeService.OpenKVStore(ctx)
+	if !k.HasPacketCommitment(ctx, clientID, sequence) {
+		k.setPendingCommitmentCount(ctx, clientID, k.GetPendingCommitmentCount(ctx, clientID)+1)
+	}
+
 	if err := store.Set(hostv2.PacketCommitmentKey(clientID, sequence), commitment); err != nil {
 		panic(err)
 	}
 }
 
 // DeletePacketCommitment deletes the packet commitment hash under the commitment path.
+// The pending commitment count of the client is decremented if a commitment was stored for the sequence.
+// The count is never decremented below zero.
 func (k *Keeper) DeletePacketCommitment(ctx context.Context, clientID string, sequence uint64) {
 	store := k.storeService.OpenKVStore(ctx)
+	if k.HasPacketCommitment(ctx, clientID, sequence) {
+		if count := k.GetPendingCommitmentCount(ctx, clientID); count > 0 {
+			k.setPendingCommitmentCount(ctx, clientID, count-1)
+		}
+	}
+
 	if err := store.Delete(hostv2.PacketCommitmentKey(clientID, sequence)); err != nil {
 		panic(err)
 	}
 }
 
+// HasPacketCommitment returns true if a packet commitment is stored for the given client and sequence.
+func (k *Keeper) HasPacketCommitment(ctx context.Context, clientID string, sequence uint64) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(hostv2.PacketCommitmentKey(clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
 // GetNextSequenceSend returns the next send sequence from the sequence path
 func (k *Keeper) GetNextSequenceSend(ctx context.Context, clientID string) (uint64, bool) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -165,6 +210,57 @@ func (k *Keeper) SetNextSequenceSend(ctx context.Context, clientID string, seque
 	}
 }
 
+// GetPruningSequenceStart returns the next sequence of packet acknowledgements and receipts to be pruned
+// for the provided client. It returns false if no acknowledgements and receipts have been pruned yet.
+func (k *Keeper) GetPruningSequenceStart(ctx context.Context, clientID string) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PruningSequenceStartKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPruningSequenceStart writes the next sequence of packet acknowledgements and receipts to be pruned
+// for the provided client.
+func (k *Keeper) SetPruningSequenceStart(ctx context.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bigEndianBz := sdk.Uint64ToBigEndian(sequence)
+	if err := store.Set(types.PruningSequenceStartKey(clientID), bigEndianBz); err != nil {
+		panic(err)
+	}
+}
+
+// GetRecvProofHeight returns the latest proof height at which a packet commitment was verified when
+// receiving a packet over the provided client. It returns false if no packet has been received yet.
+func (k *Keeper) GetRecvProofHeight(ctx context.Context, clientID string) (clienttypes.Height, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.RecvProofHeightKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return clienttypes.Height{}, false
+	}
+
+	var height clienttypes.Height
+	k.cdc.MustUnmarshal(bz, &height)
+	return height, true
+}
+
+// SetRecvProofHeight writes the latest proof height at which a packet commitment was verified when
+// receiving a packet over the provided client.
+func (k *Keeper) SetRecvProofHeight(ctx context.Context, clientID string, height clienttypes.Height) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&height)
+	if err := store.Set(types.RecvProofHeightKey(clientID), bz); err != nil {
+		panic(err)
+	}
+}
+
 // SetAsyncPacket writes the packet under the async path
 func (k *Keeper) SetAsyncPacket(ctx context.Context, clientID string, sequence uint64, packet types.Packet) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -189,12 +285,114 @@ func (k *Keeper) GetAsyncPacket(ctx context.Context, clientID string, sequence u
 	return packet, true
 }
 
-// DeleteAsyncPacket deletes the packet from the async path
+// DeleteAsyncPacket deletes the packet from the async path, together with its deadline if any.
 func (k *Keeper) DeleteAsyncPacket(ctx context.Context, clientID string, sequence uint64) {
 	store := k.storeService.OpenKVStore(ctx)
 	if err := store.Delete(types.AsyncPacketKey(clientID, sequence)); err != nil {
 		panic(err)
 	}
+
+	k.deleteAsyncPacketDeadline(ctx, clientID, sequence)
+}
+
+// SetArchivedPacket archives the full packet sent over the given client until it is acknowledged or times out.
+func (k *Keeper) SetArchivedPacket(ctx context.Context, clientID string, sequence uint64, packet types.Packet) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&packet)
+	if err := store.Set(types.PacketArchiveKey(clientID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetArchivedPacket fetches the archived packet sent over the given client.
+func (k *Keeper) GetArchivedPacket(ctx context.Context, clientID string, sequence uint64) (types.Packet, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketArchiveKey(clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.Packet{}, false
+	}
+	var packet types.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// deleteArchivedPacket deletes the archived packet sent over the given client, if any.
+func (k *Keeper) deleteArchivedPacket(ctx context.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PacketArchiveKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// SetAsyncPacketDeadline sets the unix timestamp in seconds after which the packet awaiting an asynchronous
+// acknowledgement expires, and queues the packet for expiry. Any previously set deadline is replaced.
+func (k *Keeper) SetAsyncPacketDeadline(ctx context.Context, clientID string, sequence uint64, deadline uint64) {
+	k.deleteAsyncPacketDeadline(ctx, clientID, sequence)
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.AsyncPacketDeadlineKey(clientID, sequence), sdk.Uint64ToBigEndian(deadline)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.AsyncPacketExpiryQueueKey(deadline, clientID, sequence), []byte{byte(1)}); err != nil {
+		panic(err)
+	}
+}
+
+// GetAsyncPacketDeadline returns the deadline of the packet awaiting an asynchronous acknowledgement.
+// If no deadline is set, the packet does not expire and false is returned.
+func (k *Keeper) GetAsyncPacketDeadline(ctx context.Context, clientID string, sequence uint64) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.AsyncPacketDeadlineKey(clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// deleteAsyncPacketDeadline deletes the deadline of the packet awaiting an asynchronous acknowledgement
+// and removes the packet from the expiry queue.
+func (k *Keeper) deleteAsyncPacketDeadline(ctx context.Context, clientID string, sequence uint64) {
+	deadline, found := k.GetAsyncPacketDeadline(ctx, clientID, sequence)
+	if !found {
+		return
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.AsyncPacketDeadlineKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.AsyncPacketExpiryQueueKey(deadline, clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetExpiredAsyncPackets returns the identifiers of at most limit packets awaiting an asynchronous
+// acknowledgement whose deadline is at or before the given unix timestamp, in the order in which they expired.
+func (k *Keeper) GetExpiredAsyncPackets(ctx context.Context, timestamp uint64, limit int) []types.PacketSequence {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := store.Iterator([]byte(types.KeyAsyncPacketExpiryQueue+"/"), types.AsyncPacketExpiryQueuePrefixKey(timestamp+1))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var expired []types.PacketSequence
+	for ; iterator.Valid() && len(expired) < limit; iterator.Next() {
+		_, clientID, sequence, err := types.ParseAsyncPacketExpiryQueueKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		expired = append(expired, types.NewPacketSequence(clientID, sequence))
+	}
+	return expired
 }
 
 // extractSequenceFromKey takes the full store key as well as a packet store prefix and extracts
@@ -228,6 +426,310 @@ func (k *Keeper) GetAllPacketReceiptsForClient(ctx context.Context, clientID str
 	return k.getAllPacketsForClientStore(ctx, clientID, hostv2.PacketReceiptPrefixKey)
 }
 
+// GetPendingCommitmentCount returns the number of packets sent over the given client which await
+// their acknowledgement or timeout. The count is maintained as packet commitments are set and deleted.
+func (k *Keeper) GetPendingCommitmentCount(ctx context.Context, clientID string) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PendingCommitmentCountKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPendingCommitmentCount sets the number of packets sent over the given client which await
+// their acknowledgement or timeout. The count is kept once it drops to zero, such that the
+// clients over which packets have been sent keep being reported.
+func (k *Keeper) setPendingCommitmentCount(ctx context.Context, clientID string, count uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PendingCommitmentCountKey(clientID), sdk.Uint64ToBigEndian(count)); err != nil {
+		panic(err)
+	}
+}
+
+// IteratePendingCommitmentCounts iterates over the pending commitment counts of all clients over which
+// packets have been sent. For each client, cb will be called. If the cb returns true, the iterator will
+// close and stop.
+func (k *Keeper) IteratePendingCommitmentCounts(ctx context.Context, cb func(clientID string, count uint64) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := []byte(types.KeyPendingCommitmentCount + "/")
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		clientID := string(iterator.Key()[len(prefix):])
+		if cb(clientID, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllAsyncPacketsForClient returns all stored packets awaiting an asynchronous acknowledgement
+// for a specified client ID.
+func (k *Keeper) GetAllAsyncPacketsForClient(ctx context.Context, clientID string) []types.AsyncPacket {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storePrefix := types.AsyncPacketPrefixKey(clientID)
+	iterator := storetypes.KVStorePrefixIterator(store, storePrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var packets []types.AsyncPacket
+	for ; iterator.Valid(); iterator.Next() {
+		sequence, err := strconv.ParseUint(string(bytes.TrimPrefix(iterator.Key(), storePrefix)), 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("failed to parse async packet sequence for client %s: %w", clientID, err))
+		}
+
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		asyncPacket := types.NewAsyncPacket(clientID, sequence, packet)
+		asyncPacket.Deadline, _ = k.GetAsyncPacketDeadline(ctx, clientID, sequence)
+
+		packets = append(packets, asyncPacket)
+	}
+	return packets
+}
+
+// GetAllArchivedPacketsForClient returns all archived packets sent over the specified client ID.
+func (k *Keeper) GetAllArchivedPacketsForClient(ctx context.Context, clientID string) []types.Packet {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.PacketArchivePrefixKey(clientID))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var packets []types.Packet
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// GetOrderedStreamState returns the state of the ordered stream identified by the given client and port.
+// If no state is stored, the initial state of the stream is returned.
+func (k *Keeper) GetOrderedStreamState(ctx context.Context, clientID, portID string) types.OrderedStreamState {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.OrderedStreamKey(clientID, portID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.NewOrderedStreamState(clientID, portID, 1, 1, false)
+	}
+
+	var state types.OrderedStreamState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// HasOrderedStreamState returns true if the state of the ordered stream identified by the given client and
+// port is stored, that is, if a packet has been sent or received over the stream.
+func (k *Keeper) HasOrderedStreamState(ctx context.Context, clientID, portID string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.OrderedStreamKey(clientID, portID))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// SetOrderedStreamState sets the state of an ordered stream.
+func (k *Keeper) SetOrderedStreamState(ctx context.Context, state types.OrderedStreamState) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&state)
+	if err := store.Set(types.OrderedStreamKey(state.ClientId, state.PortId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllOrderedStreamStates returns the state of all ordered streams over which packets have been
+// sent or received.
+func (k *Keeper) GetAllOrderedStreamStates(ctx context.Context) []types.OrderedStreamState {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyOrderedStream+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var states []types.OrderedStreamState
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.OrderedStreamState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+
+		states = append(states, state)
+	}
+	return states
+}
+
+// GetPacketStatus returns the stage of its lifecycle that the packet with the given sequence has reached
+// on this chain. If destination is true, the packet received over the given client is looked up, otherwise
+// the packet sent over the given client is looked up. The stages reached by a sent packet are returned
+// together with the height and time at which they were reached if they were recorded, otherwise the stage
+// is derived from the packet commitment, receipt and acknowledgement stored for the packet.
+//
+// NOTE: the stages reached by sent packets are only recorded if packet lifecycle tracking is enabled in the params.
+func (k *Keeper) GetPacketStatus(ctx context.Context, clientID string, sequence uint64, destination bool) types.PacketLifecycle {
+	if !destination {
+		if lifecycle, found := k.getSendPacketLifecycle(ctx, clientID, sequence); found {
+			return lifecycle
+		}
+
+		if len(k.GetPacketCommitment(ctx, clientID, sequence)) != 0 {
+			return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Committed}
+		}
+
+		// the commitment of a sent packet is only deleted once it is acknowledged or timed out
+		if nextSequenceSend, found := k.GetNextSequenceSend(ctx, clientID); found && sequence < nextSequenceSend {
+			return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Completed}
+		}
+
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Unknown}
+	}
+
+	if pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, clientID); found && sequence < pruningSequenceStart {
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Pruned}
+	}
+
+	// the outcome of the acknowledgement is only recorded by the sending chain
+	if k.HasPacketAcknowledgement(ctx, clientID, sequence) {
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Received}
+	}
+
+	// a received packet without an acknowledgement awaits the asynchronous acknowledgement of the application
+	if k.HasPacketReceipt(ctx, clientID, sequence) {
+		return types.PacketLifecycle{Status: types.PacketLifecycleStatus_AsyncPending}
+	}
+
+	return types.PacketLifecycle{Status: types.PacketLifecycleStatus_Unknown}
+}
+
+// SetSendPacketLifecycle records the lifecycle of the packet sent over the given client.
+func (k *Keeper) SetSendPacketLifecycle(ctx context.Context, clientID string, sequence uint64, lifecycle types.PacketLifecycle) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&lifecycle)
+	if err := store.Set(types.SendPacketLifecycleKey(clientID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getSendPacketLifecycle returns the lifecycle record of the packet sent over the given client.
+func (k *Keeper) getSendPacketLifecycle(ctx context.Context, clientID string, sequence uint64) (types.PacketLifecycle, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.SendPacketLifecycleKey(clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.PacketLifecycle{}, false
+	}
+
+	var lifecycle types.PacketLifecycle
+	k.cdc.MustUnmarshal(bz, &lifecycle)
+	return lifecycle, true
+}
+
+// recordSendPacketLifecycle records the stage of its lifecycle that the packet sent over the given client has
+// reached at the current height and block time. The stage is recorded if packet lifecycle tracking is enabled,
+// or if a previous stage of the packet was recorded while it was enabled.
+func (k *Keeper) recordSendPacketLifecycle(ctx context.Context, clientID string, sequence uint64, status types.PacketLifecycleStatus) {
+	if _, found := k.getSendPacketLifecycle(ctx, clientID, sequence); !found && !k.GetParams(ctx).PacketLifecycleTrackingEnabled {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	lifecycle := types.NewPacketLifecycle(status, clienttypes.GetSelfHeight(ctx), uint64(sdkCtx.BlockTime().Unix()))
+	k.SetSendPacketLifecycle(ctx, clientID, sequence, lifecycle)
+}
+
+// GetAllSendPacketLifecyclesForClient returns the lifecycle records of all packets sent over the specified client ID.
+func (k *Keeper) GetAllSendPacketLifecyclesForClient(ctx context.Context, clientID string) []types.SendPacketLifecycle {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storePrefix := types.SendPacketLifecyclePrefixKey(clientID)
+	iterator := storetypes.KVStorePrefixIterator(store, storePrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var lifecycles []types.SendPacketLifecycle
+	for ; iterator.Valid(); iterator.Next() {
+		sequence, err := strconv.ParseUint(string(bytes.TrimPrefix(iterator.Key(), storePrefix)), 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("failed to parse send packet lifecycle sequence for client %s: %w", clientID, err))
+		}
+
+		var lifecycle types.PacketLifecycle
+		k.cdc.MustUnmarshal(iterator.Value(), &lifecycle)
+
+		lifecycles = append(lifecycles, types.NewSendPacketLifecycle(clientID, sequence, lifecycle))
+	}
+	return lifecycles
+}
+
+// SetParams sets the channel v2 parameters.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
+
+// ValidateParamsUpdate returns an error if the given params change the ordered stream configured for a client
+// over which packets have already been sent or received. The ordering of a client is fixed once it is in use,
+// as changing it would break the ordering guarantees of the packets in flight.
+func (k *Keeper) ValidateParamsUpdate(ctx context.Context, params types.Params) error {
+	current := k.GetParams(ctx)
+
+	for _, clientStream := range append(slices.Clone(current.OrderedStreams), params.OrderedStreams...) {
+		clientID := clientStream.ClientId
+		currentStream, _ := current.GetOrderedStream(clientID)
+		stream, _ := params.GetOrderedStream(clientID)
+		if currentStream == stream {
+			continue
+		}
+
+		if k.isClientInUse(ctx, clientID, currentStream) {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "cannot change the ordered stream of client %s once packets have been sent or received over it", clientID)
+		}
+	}
+
+	return nil
+}
+
+// isClientInUse returns true if any packet has been sent or received over the given client.
+func (k *Keeper) isClientInUse(ctx context.Context, clientID string, stream types.OrderedStream) bool {
+	if sequence, found := k.GetNextSequenceSend(ctx, clientID); found && sequence > 1 {
+		return true
+	}
+
+	if _, found := k.GetRecvProofHeight(ctx, clientID); found {
+		return true
+	}
+
+	return stream.PortId != "" && k.HasOrderedStreamState(ctx, clientID, stream.PortId)
+}
+
+// GetParams returns the total set of the channel v2 parameters.
+func (k *Keeper) GetParams(ctx context.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("channel v2 params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
 // prefixKeyConstructor is a function that constructs a store key for a specific packet store using the provided
 // clientID.
 type prefixKeyConstructor func(clientID string) []byte
@@ -5,7 +5,10 @@ import (
 
 	testifysuite "github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
 )
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -29,3 +32,150 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
 	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
 }
+
+func (suite *KeeperTestSuite) TestGetPendingCommitmentCount() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	keeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	ack := types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+
+	suite.Require().Zero(keeperA.GetPendingCommitmentCount(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+	packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	_, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(uint64(2), keeperA.GetPendingCommitmentCount(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+	suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	suite.Require().Equal(uint64(1), keeperA.GetPendingCommitmentCount(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+	// overwriting or deleting a missing commitment does not change the count
+	keeperA.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence+1, []byte("commitment"))
+	keeperA.DeletePacketCommitment(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence)
+	suite.Require().Equal(uint64(1), keeperA.GetPendingCommitmentCount(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+	// the count is not decremented below zero for commitments stored before the count was maintained
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	store.Delete(types.PendingCommitmentCountKey(path.EndpointA.ClientID))
+	keeperA.DeletePacketCommitment(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence+1)
+	suite.Require().Zero(keeperA.GetPendingCommitmentCount(suite.chainA.GetContext(), path.EndpointA.ClientID))
+	keeperA.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence+1, []byte("commitment"))
+
+	// packets sent over other clients are not counted
+	suite.Require().Zero(keeperA.GetPendingCommitmentCount(suite.chainA.GetContext(), ibctesting.InvalidID))
+
+	counts := make(map[string]uint64)
+	keeperA.IteratePendingCommitmentCounts(suite.chainA.GetContext(), func(clientID string, count uint64) bool {
+		counts[clientID] = count
+		return false
+	})
+	suite.Require().Equal(map[string]uint64{path.EndpointA.ClientID: 1}, counts)
+}
+
+func (suite *KeeperTestSuite) TestValidateParamsUpdate() {
+	var (
+		path   *ibctesting.Path
+		params types.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: ordered stream added to unused client",
+			func() {
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+			},
+			nil,
+		},
+		{
+			"success: ordered stream of active client unchanged",
+			func() {
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+
+				_, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				params.MaxPacketBytes++
+			},
+			nil,
+		},
+		{
+			"failure: ordered stream added to client with sent packets",
+			func() {
+				_, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+			},
+			types.ErrInvalidParams,
+		},
+		{
+			"failure: ordered stream added to client with received packets",
+			func() {
+				packet, err := path.EndpointB.MsgSendPacket(suite.chainB.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+				suite.Require().NoError(err)
+				suite.Require().NoError(path.EndpointA.MsgRecvPacket(packet))
+
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+			},
+			types.ErrInvalidParams,
+		},
+		{
+			"failure: ordered stream removed from active client",
+			func() {
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+
+				_, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				params.OrderedStreams = nil
+			},
+			types.ErrInvalidParams,
+		},
+		{
+			"failure: ordered stream port changed for active client",
+			func() {
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+
+				_, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameB)}
+			},
+			types.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			params = suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainA.GetContext())
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.ValidateParamsUpdate(suite.chainA.GetContext(), params)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
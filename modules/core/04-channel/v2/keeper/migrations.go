@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams migrates params to the default channel v2 params.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated ibc channel v2 params")
+	return nil
+}
+
+// MigratePendingCommitmentCounts sets the pending commitment count of every client, and of every aliased v1 channel,
+// to the number of packet commitments stored for it. The count is only maintained as packet commitments are set and
+// deleted, thus it must be seeded for the packets sent before the count was introduced.
+func (m Migrator) MigratePendingCommitmentCounts(ctx sdk.Context) error {
+	// packet commitments are stored under client identifiers as well as under the identifiers of aliased v1 channels
+	var ids []string
+	for _, clientState := range m.keeper.ClientKeeper.GetAllGenesisClients(ctx) {
+		ids = append(ids, clientState.ClientId)
+	}
+	ids = append(ids, m.keeper.GetAllAliasableV1ChannelIDs(ctx)...)
+
+	for _, id := range ids {
+		if count := len(m.keeper.GetAllPacketCommitmentsForClient(ctx, id)); count > 0 {
+			m.keeper.setPendingCommitmentCount(ctx, id, uint64(count))
+		}
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated ibc channel v2 pending commitment counts")
+	return nil
+}
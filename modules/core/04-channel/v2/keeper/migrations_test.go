@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestMigrateDefaultParams tests the migration for the channel v2 params
+func (suite *KeeperTestSuite) TestMigrateDefaultParams() {
+	testCases := []struct {
+		name           string
+		expectedParams types.Params
+	}{
+		{
+			"success: default params",
+			types.DefaultParams(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			migrator := keeper.NewMigrator(suite.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+			err := migrator.MigrateParams(ctx)
+			suite.Require().NoError(err)
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetParams(ctx)
+			suite.Require().Equal(tc.expectedParams, params)
+		})
+	}
+}
+
+// TestMigratePendingCommitmentCounts tests the migration seeding the pending commitment counts
+func (suite *KeeperTestSuite) TestMigratePendingCommitmentCounts() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	aliasedPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.setupAliasedChannels(aliasedPath)
+
+	ctx := suite.chainA.GetContext()
+	keeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2
+
+	keeperA.SetPacketCommitment(ctx, path.EndpointA.ClientID, 1, []byte("commitment"))
+	keeperA.SetPacketCommitment(ctx, path.EndpointA.ClientID, 2, []byte("commitment"))
+	keeperA.SetPacketCommitment(ctx, aliasedPath.EndpointA.ChannelID, 1, []byte("commitment"))
+
+	// the counts are not stored for the commitments of packets sent before the upgrade
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	store.Delete(types.PendingCommitmentCountKey(path.EndpointA.ClientID))
+	store.Delete(types.PendingCommitmentCountKey(aliasedPath.EndpointA.ChannelID))
+	suite.Require().Zero(keeperA.GetPendingCommitmentCount(ctx, path.EndpointA.ClientID))
+
+	migrator := keeper.NewMigrator(keeperA)
+	err := migrator.MigratePendingCommitmentCounts(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(uint64(2), keeperA.GetPendingCommitmentCount(ctx, path.EndpointA.ClientID))
+	suite.Require().Equal(uint64(1), keeperA.GetPendingCommitmentCount(ctx, aliasedPath.EndpointA.ChannelID))
+
+	// clients without any commitment are not reported
+	suite.Require().False(store.Has(types.PendingCommitmentCountKey(aliasedPath.EndpointA.ClientID)))
+}
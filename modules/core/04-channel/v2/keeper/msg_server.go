@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 	"time"
 
@@ -10,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/api"
 	internalerrors "github.com/cosmos/ibc-go/v9/modules/core/internal/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/internal/v2/telemetry"
 )
@@ -27,11 +27,6 @@ func (k *Keeper) SendPacket(ctx context.Context, msg *types.MsgSendPacket) (*typ
 		return nil, errorsmod.Wrap(types.ErrTimeoutElapsed, "timeout is less than the current block timestamp")
 	}
 
-	// timeoutTimestamp must be less than current block time + MaxTimeoutDelta
-	if timeout.After(sdkCtx.BlockTime().Add(types.MaxTimeoutDelta)) {
-		return nil, errorsmod.Wrap(types.ErrInvalidTimeout, "timeout exceeds the maximum expected value")
-	}
-
 	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		sdkCtx.Logger().Error("send packet failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
@@ -45,13 +40,19 @@ func (k *Keeper) SendPacket(ctx context.Context, msg *types.MsgSendPacket) (*typ
 	}
 
 	for _, pd := range msg.Payloads {
-		cbs := k.Router.Route(pd.SourcePort)
+		cbs, ok := k.Router.GetRoute(pd.SourcePort)
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrRouteNotFound, "source port %s", pd.SourcePort)
+		}
+
 		err := cbs.OnSendPacket(ctx, msg.SourceClient, destChannel, sequence, pd, signer)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	defer telemetry.ReportSendPacket(types.NewPacket(sequence, msg.SourceClient, destChannel, msg.TimeoutTimestamp, msg.Payloads...))
+
 	return &types.MsgSendPacketResponse{Sequence: sequence}, nil
 }
 
@@ -79,164 +80,522 @@ func (k *Keeper) RecvPacket(ctx context.Context, msg *types.MsgRecvPacket) (*typ
 		// no-ops do not need event emission as they will be ignored
 		sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", msg.Packet.SourceClient)
 		return &types.MsgRecvPacketResponse{Result: types.NOOP}, nil
+	case types.ErrOrderedStreamTimedOut:
+		// the packet is not received, but the closing of the ordered stream is persisted
+		writeFn()
+		sdkCtx.Logger().Info("ordered stream closed on packet timeout", "source-client", msg.Packet.SourceClient, "dest-client", msg.Packet.DestinationClient)
+		return &types.MsgRecvPacketResponse{Result: types.FAILURE}, nil
 	default:
 		sdkCtx.Logger().Error("receive packet failed", "source-client", msg.Packet.SourceClient, "error", errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
+	if err := k.onRecvPacket(ctx, msg.Packet, signer); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.Logger().Info("receive packet callback succeeded", "source-client", msg.Packet.SourceClient, "dest-client", msg.Packet.DestinationClient, "result", types.SUCCESS.String())
+	return &types.MsgRecvPacketResponse{Result: types.SUCCESS}, nil
+}
+
+// Acknowledgement defines an rpc handler method for MsgAcknowledgement.
+func (k *Keeper) Acknowledgement(ctx context.Context, msg *types.MsgAcknowledgement) (*types.MsgAcknowledgementResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		sdkCtx.Logger().Error("acknowledgement failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	sendTime := k.getPacketSendTime(ctx, msg.Packet)
+
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+	err = k.acknowledgePacket(cacheCtx, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+
+	switch err {
+	case nil:
+		writeFn()
+	case types.ErrNoOpMsg:
+		sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", msg.Packet.SourceClient)
+		return &types.MsgAcknowledgementResponse{Result: types.NOOP}, nil
+	default:
+		sdkCtx.Logger().Error("acknowledgement failed", "source-client", msg.Packet.SourceClient, "error", errorsmod.Wrap(err, "acknowledge packet verification failed"))
+		return nil, errorsmod.Wrap(err, "acknowledge packet verification failed")
+	}
+
+	if err := k.onAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer, sendTime); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcknowledgementResponse{Result: types.SUCCESS}, nil
+}
+
+// Timeout implements the PacketMsgServer Timeout method.
+func (k *Keeper) Timeout(ctx context.Context, timeout *types.MsgTimeout) (*types.MsgTimeoutResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	signer, err := sdk.AccAddressFromBech32(timeout.Signer)
+	if err != nil {
+		sdkCtx.Logger().Error("timeout packet failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+	err = k.timeoutPacket(cacheCtx, timeout.Packet, timeout.ProofUnreceived, timeout.ProofHeight)
+
+	switch err {
+	case nil:
+		writeFn()
+	case types.ErrNoOpMsg:
+		sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", timeout.Packet.SourceClient)
+		return &types.MsgTimeoutResponse{Result: types.NOOP}, nil
+	default:
+		sdkCtx.Logger().Error("timeout failed", "source-client", timeout.Packet.SourceClient, "error", errorsmod.Wrap(err, "timeout packet verification failed"))
+		return nil, errorsmod.Wrap(err, "timeout packet verification failed")
+	}
+
+	if err := k.onTimeoutPacket(ctx, timeout.Packet, signer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTimeoutResponse{Result: types.SUCCESS}, nil
+}
+
+// onRecvPacket executes the application callbacks of a received packet and writes its acknowledgement,
+// unless the acknowledgement is written asynchronously by the application.
+func (k *Keeper) onRecvPacket(ctx context.Context, packet types.Packet, signer sdk.AccAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
 	// build up the recv results for each application callback.
 	ack := types.Acknowledgement{
 		AppAcknowledgements: [][]byte{},
 	}
 
-	var isAsync bool
+	// Cache context so that we may discard state changes from all callbacks if any of the
+	// application callbacks are unsuccessful. Payloads of a packet are executed atomically:
+	// either every payload is received successfully or none of the state changes are written.
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+
+	var isAsync, expiryExempt bool
 	isSuccess := true
-	for _, pd := range msg.Packet.Payloads {
-		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-		cacheCtx, writeFn = sdkCtx.CacheContext()
-		cb := k.Router.Route(pd.DestinationPort)
-		res := cb.OnRecvPacket(cacheCtx, msg.Packet.SourceClient, msg.Packet.DestinationClient, msg.Packet.Sequence, pd, signer)
-
-		if res.Status != types.PacketStatus_Failure {
-			// successful app acknowledgement cannot equal sentinel error acknowledgement
-			if bytes.Equal(res.GetAcknowledgement(), types.ErrorAcknowledgement[:]) {
-				return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be sentinel error acknowledgement")
-			}
-			// write application state changes for asynchronous and successful acknowledgements
-			writeFn()
-			// append app acknowledgement to the overall acknowledgement
-			ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
-		} else {
+	// errorAck is the error app acknowledgement written if any of the application callbacks fail.
+	// It defaults to the sentinel error acknowledgement if no structured error acknowledgement is available.
+	errorAck := types.ErrorAcknowledgement[:]
+	for _, pd := range packet.Payloads {
+		cb, ok := k.Router.GetRoute(pd.DestinationPort)
+		if !ok {
+			// packets destined to a port without a registered application are acknowledged with an error
+			// acknowledgement so that the sending chain can revert the packet send
+			routeErr := errorsmod.Wrapf(types.ErrRouteNotFound, "destination port %s", pd.DestinationPort)
+			sdkCtx.Logger().Error("receive packet failed", "destination-port", pd.DestinationPort, "error", routeErr)
+			errorAck = types.NewErrorAppAcknowledgement(routeErr)
 			isSuccess = false
-			// construct acknowledgement with single app acknowledgement that is the sentinel error acknowledgement
-			ack = types.Acknowledgement{
-				AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]},
+			break
+		}
+
+		res := cb.OnRecvPacket(cacheCtx, packet.SourceClient, packet.DestinationClient, packet.Sequence, pd, signer)
+
+		if res.Status == types.PacketStatus_Failure {
+			// applications may return a structured error acknowledgement, any other acknowledgement
+			// is replaced with the sentinel error acknowledgement
+			if types.IsErrorAppAcknowledgement(res.GetAcknowledgement()) && types.NewAcknowledgement(res.GetAcknowledgement()).Validate() == nil {
+				errorAck = res.GetAcknowledgement()
 			}
-			// Modify events in cached context to reflect unsuccessful acknowledgement
-			sdkCtx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
+			isSuccess = false
 			break
 		}
 
+		// successful app acknowledgement cannot be an error acknowledgement
+		if types.IsErrorAppAcknowledgement(res.GetAcknowledgement()) {
+			return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be an error acknowledgement")
+		}
+
 		if res.Status == types.PacketStatus_Async {
 			// Set packet acknowledgement to async if any of the acknowledgements are async.
 			isAsync = true
-			// Return error if there is more than 1 payload
-			// TODO: Handle case where there are multiple payloads
-			if len(msg.Packet.Payloads) > 1 {
-				return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
+			// Asynchronous acknowledgements are written for the packet as a whole, thus
+			// they are only supported for packets containing a single payload.
+			if len(packet.Payloads) > 1 {
+				return errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
+			}
+
+			if exempt, ok := cb.(api.AsyncAcknowledgementExpiryExempt); ok {
+				expiryExempt = exempt.ExemptFromAsyncAcknowledgementExpiry()
 			}
 		}
+
+		// append app acknowledgement to the overall acknowledgement
+		ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
+	}
+
+	if isSuccess {
+		// write application state changes for asynchronous and successful acknowledgements
+		writeFn()
+	} else {
+		// construct acknowledgement with single app acknowledgement that is the error acknowledgement
+		ack = types.NewAcknowledgement(errorAck)
+		// Modify events in cached context to reflect unsuccessful acknowledgement
+		sdkCtx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
 	}
 
 	if !isAsync {
 		// If the application callback was successful, the acknowledgement must have the same number of app acknowledgements as the packet payloads.
 		if isSuccess {
-			if len(ack.AppAcknowledgements) != len(msg.Packet.Payloads) {
-				return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "length of app acknowledgement %d does not match length of app payload %d", len(ack.AppAcknowledgements), len(msg.Packet.Payloads))
+			if len(ack.AppAcknowledgements) != len(packet.Payloads) {
+				return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "length of app acknowledgement %d does not match length of app payload %d", len(ack.AppAcknowledgements), len(packet.Payloads))
 			}
 		}
 
 		// Validate ack before forwarding to WriteAcknowledgement.
 		if err := ack.Validate(); err != nil {
-			return nil, err
+			return err
 		}
 		// Set packet acknowledgement only if the acknowledgement is not async.
 		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 		// acknowledgement is async.
-		if err := k.writeAcknowledgement(ctx, msg.Packet, ack); err != nil {
-			return nil, err
+		if err := k.writeAcknowledgement(ctx, packet, ack); err != nil {
+			return err
 		}
 	} else {
 		// store the packet temporarily until the application returns an acknowledgement
-		k.SetAsyncPacket(ctx, msg.Packet.DestinationClient, msg.Packet.Sequence, msg.Packet)
+		k.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence, packet)
+
+		// an error acknowledgement is written on behalf of the application if it has not
+		// written the acknowledgement before the expiry configured in the params, unless
+		// the application has opted out of the expiry of its asynchronous acknowledgements.
+		if expiryDelta := k.GetParams(ctx).AsyncAcknowledgementExpiryDelta; expiryDelta > 0 && !expiryExempt {
+			deadline := uint64(sdkCtx.BlockTime().Add(expiryDelta).Unix())
+			k.SetAsyncPacketDeadline(ctx, packet.DestinationClient, packet.Sequence, deadline)
+		}
 	}
 
-	// TODO: store the packet for async applications to access if required.
-	defer telemetry.ReportRecvPacket(msg.Packet)
+	telemetry.ReportRecvPacket(packet)
 
-	sdkCtx.Logger().Info("receive packet callback succeeded", "source-client", msg.Packet.SourceClient, "dest-client", msg.Packet.DestinationClient, "result", types.SUCCESS.String())
-	return &types.MsgRecvPacketResponse{Result: types.SUCCESS}, nil
+	return nil
 }
 
-// Acknowledgement defines an rpc handler method for MsgAcknowledgement.
-func (k *Keeper) Acknowledgement(ctx context.Context, msg *types.MsgAcknowledgement) (*types.MsgAcknowledgementResponse, error) {
+// onAcknowledgementPacket executes the application callbacks of an acknowledged packet.
+func (k *Keeper) onAcknowledgementPacket(ctx context.Context, packet types.Packet, acknowledgement types.Acknowledgement, relayer sdk.AccAddress, sendTime time.Time) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
-	if err != nil {
-		sdkCtx.Logger().Error("acknowledgement failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
-		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
-	}
 
-	cacheCtx, writeFn := sdkCtx.CacheContext()
-	err = k.acknowledgePacket(cacheCtx, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
-
-	switch err {
-	case nil:
-		writeFn()
-	case types.ErrNoOpMsg:
-		sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", msg.Packet.SourceClient)
-		return &types.MsgAcknowledgementResponse{Result: types.NOOP}, nil
-	default:
-		sdkCtx.Logger().Error("acknowledgement failed", "source-client", msg.Packet.SourceClient, "error", errorsmod.Wrap(err, "acknowledge packet verification failed"))
-		return nil, errorsmod.Wrap(err, "acknowledge packet verification failed")
-	}
+	recvSuccess := acknowledgement.Success()
+	for i, pd := range packet.Payloads {
+		cbs, ok := k.Router.GetRoute(pd.SourcePort)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrRouteNotFound, "source port %s", pd.SourcePort)
+		}
 
-	recvSuccess := !bytes.Equal(msg.Acknowledgement.AppAcknowledgements[0], types.ErrorAcknowledgement[:])
-	for i, pd := range msg.Packet.Payloads {
-		cbs := k.Router.Route(pd.SourcePort)
 		var ack []byte
 		// if recv was successful, each payload should have its own acknowledgement so we send each individual acknowledgment to the application
-		// otherwise, the acknowledgement only contains the sentinel error acknowledgement which we send to the application. The application is responsible
-		// for knowing that this is an error acknowledgement and executing the appropriate logic.
+		// otherwise, the acknowledgement only contains the error acknowledgement which we send to every application. The error acknowledgement
+		// is either the sentinel error acknowledgement or a structured error acknowledgement prefixed by the sentinel error acknowledgement.
+		// The application is responsible for knowing that this is an error acknowledgement and executing the appropriate logic.
 		if recvSuccess {
-			ack = msg.Acknowledgement.AppAcknowledgements[i]
+			ack = acknowledgement.AppAcknowledgements[i]
 		} else {
-			ack = types.ErrorAcknowledgement[:]
+			ack = acknowledgement.AppAcknowledgements[0]
 		}
-		err := cbs.OnAcknowledgementPacket(ctx, msg.Packet.SourceClient, msg.Packet.DestinationClient, msg.Packet.Sequence, ack, pd, relayer)
+		err := cbs.OnAcknowledgementPacket(ctx, packet.SourceClient, packet.DestinationClient, packet.Sequence, ack, pd, relayer)
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed OnAcknowledgementPacket for source port %s, source client %s, destination client %s", pd.SourcePort, msg.Packet.SourceClient, msg.Packet.DestinationClient)
+			return errorsmod.Wrapf(err, "failed OnAcknowledgementPacket for source port %s, source client %s, destination client %s", pd.SourcePort, packet.SourceClient, packet.DestinationClient)
 		}
 	}
 
-	defer telemetry.ReportAcknowledgePacket(msg.Packet)
+	telemetry.ReportAcknowledgePacket(packet)
+	if !sendTime.IsZero() {
+		telemetry.ReportAcknowledgePacketLatency(packet, sdkCtx.BlockTime().Sub(sendTime))
+	}
 
-	return &types.MsgAcknowledgementResponse{Result: types.SUCCESS}, nil
+	return nil
 }
 
-// Timeout implements the PacketMsgServer Timeout method.
-func (k *Keeper) Timeout(ctx context.Context, timeout *types.MsgTimeout) (*types.MsgTimeoutResponse, error) {
+// onTimeoutPacket executes the application callbacks of a timed out packet.
+func (k *Keeper) onTimeoutPacket(ctx context.Context, packet types.Packet, signer sdk.AccAddress) error {
+	for _, pd := range packet.Payloads {
+		cbs, ok := k.Router.GetRoute(pd.SourcePort)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrRouteNotFound, "source port %s", pd.SourcePort)
+		}
+
+		err := cbs.OnTimeoutPacket(ctx, packet.SourceClient, packet.DestinationClient, packet.Sequence, pd, signer)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed OnTimeoutPacket for source port %s, source client %s, destination client %s", pd.SourcePort, packet.SourceClient, packet.DestinationClient)
+		}
+	}
+
+	telemetry.ReportTimeoutPacket(packet)
+
+	return nil
+}
+
+// PruneAcknowledgements implements the PacketMsgServer PruneAcknowledgements method.
+func (k *Keeper) PruneAcknowledgements(ctx context.Context, msg *types.MsgPruneAcknowledgements) (*types.MsgPruneAcknowledgementsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	signer, err := sdk.AccAddressFromBech32(timeout.Signer)
+	totalPruned, pruningSequenceStart, err := k.pruneAcknowledgements(ctx, msg.ClientId, msg.Limit, msg.ProofCommitmentAbsence, msg.ProofHeight)
 	if err != nil {
-		sdkCtx.Logger().Error("timeout packet failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		sdkCtx.Logger().Error("prune acknowledgements failed", "client-id", msg.ClientId, "error", errorsmod.Wrap(err, "prune acknowledgements failed"))
+		return nil, errorsmod.Wrapf(err, "prune acknowledgements failed for client: %s", msg.ClientId)
+	}
+
+	return &types.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences: totalPruned,
+		PruningSequenceStart: pruningSequenceStart,
+	}, nil
+}
+
+// RecvPackets implements the PacketMsgServer RecvPackets method.
+// Packets provided with individual proofs are each received as if they were submitted in their own MsgRecvPacket.
+// Packets provided with a single combined proof are checked and received in order, after which the combined proof
+// is verified once for the whole batch before the application callbacks are executed. The batch fails if any packet fails.
+func (k *Keeper) RecvPackets(ctx context.Context, msg *types.MsgRecvPackets) (*types.MsgRecvPacketsResponse, error) {
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	if len(msg.Packets) == 1 || len(msg.ProofsCommitment) != 1 {
+		for i, recvMsg := range msg.RecvPacketMsgs() {
+			res, err := k.RecvPacket(ctx, recvMsg)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "receive packet failed for packet at index %d", i)
+			}
+
+			results[i] = res.Result
+		}
+
+		return &types.MsgRecvPacketsResponse{Results: results}, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		sdkCtx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
 		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
 	}
 
+	// packets are received in order within a cached context, such that duplicate packets within the batch
+	// are treated as no-ops, and the state changes are only written once the combined proof is verified.
 	cacheCtx, writeFn := sdkCtx.CacheContext()
-	err = k.timeoutPacket(cacheCtx, timeout.Packet, timeout.ProofUnreceived, timeout.ProofHeight)
 
-	switch err {
-	case nil:
-		writeFn()
-	case types.ErrNoOpMsg:
-		sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", timeout.Packet.SourceClient)
-		return &types.MsgTimeoutResponse{Result: types.NOOP}, nil
-	default:
-		sdkCtx.Logger().Error("timeout failed", "source-client", timeout.Packet.SourceClient, "error", errorsmod.Wrap(err, "timeout packet verification failed"))
-		return nil, errorsmod.Wrap(err, "timeout packet verification failed")
+	var (
+		received      []int
+		verifications []packetVerification
+	)
+	for i, packet := range msg.Packets {
+		verification, err := k.checkRecvPacket(cacheCtx, packet)
+		switch {
+		case err == nil && verification.closeStream:
+			// the timed out packet is not received, the ordered stream is closed once the combined proof is verified
+			k.closeOrderedStream(cacheCtx, *verification.stream)
+
+			verifications = append(verifications, verification)
+			results[i] = types.FAILURE
+		case err == nil:
+			k.applyRecvPacket(cacheCtx, packet, msg.ProofHeight, verification)
+
+			received = append(received, i)
+			verifications = append(verifications, verification)
+		case err == types.ErrNoOpMsg:
+			sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", packet.SourceClient)
+			results[i] = types.NOOP
+		default:
+			sdkCtx.Logger().Error("receive packets failed", "source-client", packet.SourceClient, "error", errorsmod.Wrap(err, "receive packet verification failed"))
+			return nil, errorsmod.Wrapf(err, "receive packet verification failed for packet at index %d", i)
+		}
 	}
 
-	for _, pd := range timeout.Packet.Payloads {
-		cbs := k.Router.Route(pd.SourcePort)
-		err := cbs.OnTimeoutPacket(ctx, timeout.Packet.SourceClient, timeout.Packet.DestinationClient, timeout.Packet.Sequence, pd, signer)
-		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed OnTimeoutPacket for source port %s, source client %s, destination client %s", pd.SourcePort, timeout.Packet.SourceClient, timeout.Packet.DestinationClient)
+	if len(verifications) == 0 {
+		return &types.MsgRecvPacketsResponse{Results: results}, nil
+	}
+
+	if err := k.verifyMembershipBatch(cacheCtx, verifications, msg.ProofsCommitment[0], msg.ProofHeight); err != nil {
+		sdkCtx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "receive packets verification failed"))
+		return nil, errorsmod.Wrap(err, "failed packet commitments verification")
+	}
+
+	writeFn()
+
+	for _, i := range received {
+		if err := k.onRecvPacket(ctx, msg.Packets[i], signer); err != nil {
+			return nil, errorsmod.Wrapf(err, "receive packet failed for packet at index %d", i)
 		}
+
+		results[i] = types.SUCCESS
 	}
 
-	defer telemetry.ReportTimeoutPacket(timeout.Packet)
+	return &types.MsgRecvPacketsResponse{Results: results}, nil
+}
 
-	return &types.MsgTimeoutResponse{Result: types.SUCCESS}, nil
+// Timeouts implements the PacketMsgServer Timeouts method.
+// Packets provided with individual proofs are each timed out as if they were submitted in their own MsgTimeout.
+// Packets provided with a single combined proof are checked and timed out in order, after which the combined proof
+// is verified once for the whole batch before the application callbacks are executed. The batch fails if any packet fails.
+func (k *Keeper) Timeouts(ctx context.Context, msg *types.MsgTimeouts) (*types.MsgTimeoutsResponse, error) {
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	if len(msg.Packets) == 1 || len(msg.ProofsUnreceived) != 1 {
+		for i, timeoutMsg := range msg.TimeoutMsgs() {
+			res, err := k.Timeout(ctx, timeoutMsg)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "timeout failed for packet at index %d", i)
+			}
+
+			results[i] = res.Result
+		}
+
+		return &types.MsgTimeoutsResponse{Results: results}, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		sdkCtx.Logger().Error("timeout packets failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+
+	var (
+		timedOut      []int
+		verifications []packetVerification
+	)
+	for i, packet := range msg.Packets {
+		verification, err := k.checkTimeoutPacket(cacheCtx, packet, msg.ProofHeight)
+		switch err {
+		case nil:
+			k.applyTimeoutPacket(cacheCtx, packet)
+
+			timedOut = append(timedOut, i)
+			verifications = append(verifications, verification)
+		case types.ErrNoOpMsg:
+			sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", packet.SourceClient)
+			results[i] = types.NOOP
+		default:
+			sdkCtx.Logger().Error("timeout packets failed", "source-client", packet.SourceClient, "error", errorsmod.Wrap(err, "timeout packet verification failed"))
+			return nil, errorsmod.Wrapf(err, "timeout packet verification failed for packet at index %d", i)
+		}
+	}
+
+	if len(verifications) == 0 {
+		return &types.MsgTimeoutsResponse{Results: results}, nil
+	}
+
+	if err := k.verifyNonMembershipBatch(cacheCtx, verifications, msg.ProofsUnreceived[0], msg.ProofHeight); err != nil {
+		sdkCtx.Logger().Error("timeout packets failed", "error", errorsmod.Wrap(err, "timeout packets verification failed"))
+		return nil, errorsmod.Wrap(err, "failed packet receipts absence verification")
+	}
+
+	writeFn()
+
+	for _, i := range timedOut {
+		if err := k.onTimeoutPacket(ctx, msg.Packets[i], signer); err != nil {
+			return nil, errorsmod.Wrapf(err, "timeout failed for packet at index %d", i)
+		}
+
+		results[i] = types.SUCCESS
+	}
+
+	return &types.MsgTimeoutsResponse{Results: results}, nil
+}
+
+// Acknowledgements implements the PacketMsgServer Acknowledgements method.
+// Packets provided with individual proofs are each acknowledged as if they were submitted in their own MsgAcknowledgement.
+// Packets provided with a single combined proof are checked and acknowledged in order, after which the combined proof
+// is verified once for the whole batch before the application callbacks are executed. The batch fails if any packet fails.
+func (k *Keeper) Acknowledgements(ctx context.Context, msg *types.MsgAcknowledgements) (*types.MsgAcknowledgementsResponse, error) {
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements %d does not match number of packets %d", len(msg.Acknowledgements), len(msg.Packets))
+	}
+
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	if len(msg.Packets) == 1 || len(msg.ProofsAcked) != 1 {
+		for i, ackMsg := range msg.AcknowledgementMsgs() {
+			res, err := k.Acknowledgement(ctx, ackMsg)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "acknowledge packet failed for packet at index %d", i)
+			}
+
+			results[i] = res.Result
+		}
+
+		return &types.MsgAcknowledgementsResponse{Results: results}, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		sdkCtx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+
+	var (
+		acknowledged  []int
+		sendTimes     []time.Time
+		verifications []packetVerification
+	)
+	for i, packet := range msg.Packets {
+		sendTime := k.getPacketSendTime(cacheCtx, packet)
+
+		verification, err := k.checkAcknowledgePacket(cacheCtx, packet, msg.Acknowledgements[i])
+		switch err {
+		case nil:
+			k.applyAcknowledgePacket(cacheCtx, packet, msg.Acknowledgements[i], verification)
+
+			acknowledged = append(acknowledged, i)
+			sendTimes = append(sendTimes, sendTime)
+			verifications = append(verifications, verification)
+		case types.ErrNoOpMsg:
+			sdkCtx.Logger().Debug("no-op on redundant relay", "source-client", packet.SourceClient)
+			results[i] = types.NOOP
+		default:
+			sdkCtx.Logger().Error("acknowledgements failed", "source-client", packet.SourceClient, "error", errorsmod.Wrap(err, "acknowledge packet verification failed"))
+			return nil, errorsmod.Wrapf(err, "acknowledge packet verification failed for packet at index %d", i)
+		}
+	}
+
+	if len(verifications) == 0 {
+		return &types.MsgAcknowledgementsResponse{Results: results}, nil
+	}
+
+	if err := k.verifyMembershipBatch(cacheCtx, verifications, msg.ProofsAcked[0], msg.ProofHeight); err != nil {
+		sdkCtx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "acknowledge packets verification failed"))
+		return nil, errorsmod.Wrap(err, "failed packet acknowledgements verification")
+	}
+
+	writeFn()
+
+	for j, i := range acknowledged {
+		if err := k.onAcknowledgementPacket(ctx, msg.Packets[i], msg.Acknowledgements[i], relayer, sendTimes[j]); err != nil {
+			return nil, errorsmod.Wrapf(err, "acknowledge packet failed for packet at index %d", i)
+		}
+
+		results[i] = types.SUCCESS
+	}
+
+	return &types.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// ExpireAsyncPacket implements the PacketMsgServer ExpireAsyncPacket method.
+func (k *Keeper) ExpireAsyncPacket(ctx context.Context, msg *types.MsgExpireAsyncPacket) (*types.MsgExpireAsyncPacketResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.expireAsyncPacket(ctx, msg.ClientId, msg.Sequence); err != nil {
+		sdkCtx.Logger().Error("expire async packet failed", "client-id", msg.ClientId, "sequence", msg.Sequence, "error", errorsmod.Wrap(err, "expire async packet failed"))
+		return nil, errorsmod.Wrapf(err, "expire async packet failed for client: %s", msg.ClientId)
+	}
+
+	return &types.MsgExpireAsyncPacketResponse{}, nil
+}
+
+// getPacketSendTime returns the time at which a packet awaiting acknowledgement was sent, or the zero time
+// if it is not recorded as packet lifecycle tracking was disabled when the packet was sent. The send lifecycle
+// record is overwritten with the outcome of the packet once acknowledged, thus it must be read beforehand.
+func (k *Keeper) getPacketSendTime(ctx context.Context, packet types.Packet) time.Time {
+	sendLifecycle, found := k.getSendPacketLifecycle(ctx, packet.SourceClient, packet.Sequence)
+	if !found || sendLifecycle.Status != types.PacketLifecycleStatus_Committed {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(sendLifecycle.Timestamp), 0)
 }
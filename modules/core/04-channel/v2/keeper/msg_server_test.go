@@ -3,14 +3,17 @@ package keeper_test
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	hostv2 "github.com/cosmos/ibc-go/v9/modules/core/24-host/v2"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 	"github.com/cosmos/ibc-go/v9/testing/mock"
 	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
@@ -38,7 +41,7 @@ func (suite *KeeperTestSuite) TestMsgSendPacket() {
 			name: "success: valid timeout timestamp",
 			malleate: func() {
 				// ensure a message timeout.
-				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(types.MaxTimeoutDelta - 10*time.Second).Unix())
+				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(types.DefaultMaxTimeoutDelta - 10*time.Second).Unix())
 				expectedPacket = types.NewPacket(1, path.EndpointA.ClientID, path.EndpointB.ClientID, timeoutTimestamp, payload)
 			},
 			expError: nil,
@@ -55,10 +58,58 @@ func (suite *KeeperTestSuite) TestMsgSendPacket() {
 			name: "failure: timeout timestamp exceeds max allowed input",
 			malleate: func() {
 				// ensure message timeout exceeds max allowed input.
-				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(types.MaxTimeoutDelta + 10*time.Second).Unix())
+				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(types.DefaultMaxTimeoutDelta + 10*time.Second).Unix())
+			},
+			expError: types.ErrInvalidTimeout,
+		},
+		{
+			name: "failure: timeout timestamp exceeds max timeout delta set in params",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.MaxTimeoutDelta = time.Minute
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+
+				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute + 10*time.Second).Unix())
 			},
 			expError: types.ErrInvalidTimeout,
 		},
+		{
+			name: "success: source port allowed for client",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.ClientPortAllowlists = []types.ClientPortAllowlist{types.NewClientPortAllowlist(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+			},
+			expError: nil,
+		},
+		{
+			name: "failure: source port not allowed for client",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.ClientPortAllowlists = []types.ClientPortAllowlist{types.NewClientPortAllowlist(path.EndpointA.ClientID, mockv2.ModuleNameB)}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+			},
+			expError: types.ErrPortNotAllowed,
+		},
+		{
+			name: "failure: payload value exceeds max payload value bytes",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.MaxPayloadValueBytes = uint64(len(payload.Value)) - 1
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+			},
+			expError: types.ErrInvalidPayload,
+		},
+		{
+			name: "failure: packet exceeds max packet bytes",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.MaxPayloadValueBytes = uint64(len(payload.Value))
+				params.MaxPacketBytes = uint64(len(payload.Value))
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), params)
+			},
+			expError: types.ErrInvalidPacket,
+		},
 		{
 			name: "failure: timeout timestamp less than current block timestamp",
 			malleate: func() {
@@ -90,12 +141,19 @@ func (suite *KeeperTestSuite) TestMsgSendPacket() {
 			},
 			expError: clienttypes.ErrCounterpartyNotFound,
 		},
+		{
+			name: "failure: counterparty registration pending confirmation",
+			malleate: func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetCounterpartyPending(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			},
+			expError: clienttypes.ErrCounterpartyNotFound,
+		},
 		{
 			name: "failure: route to non existing app",
 			malleate: func() {
 				payload.SourcePort = "foo"
 			},
-			expError: fmt.Errorf("no route for foo"),
+			expError: types.ErrRouteNotFound,
 		},
 	}
 
@@ -171,6 +229,43 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 			expError:      nil,
 			expAckWritten: true,
 		},
+		{
+			name: "success: failed recv result with structured error acknowledgement",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status:          types.PacketStatus_Failure,
+					Acknowledgement: types.NewErrorAppAcknowledgement(ibcerrors.ErrInvalidRequest),
+				}
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
+		{
+			name: "success: failed recv result with structured error acknowledgement, counterparty opted in",
+			malleate: func() {
+				params := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainB.GetContext())
+				params.StructuredErrorAcknowledgementClients = []string{path.EndpointB.ClientID}
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainB.GetContext(), params)
+
+				expRecvRes = types.RecvPacketResult{
+					Status:          types.PacketStatus_Failure,
+					Acknowledgement: types.NewErrorAppAcknowledgement(ibcerrors.ErrInvalidRequest),
+				}
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
+		{
+			name: "success: failed recv result with non error acknowledgement",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status:          types.PacketStatus_Failure,
+					Acknowledgement: mockv2.MockRecvPacketResult.Acknowledgement,
+				}
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
 		{
 			name: "success: async recv result",
 			malleate: func() {
@@ -189,6 +284,18 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 			expError:      nil,
 			expAckWritten: false,
 		},
+		{
+			name: "success: NoOp, destination port no longer allowed for client after the packet was received",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+
+				params := types.DefaultParams()
+				params.ClientPortAllowlists = []types.ClientPortAllowlist{types.NewClientPortAllowlist(path.EndpointB.ClientID, mockv2.ModuleNameA)}
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainB.GetContext(), params)
+			},
+			expError:      nil,
+			expAckWritten: false,
+		},
 		{
 			name: "failure: counterparty not found",
 			malleate: func() {
@@ -197,6 +304,13 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 			},
 			expError: clienttypes.ErrCounterpartyNotFound,
 		},
+		{
+			name: "failure: counterparty registration pending confirmation",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ClientKeeper.SetCounterpartyPending(suite.chainB.GetContext(), path.EndpointB.ClientID)
+			},
+			expError: clienttypes.ErrCounterpartyNotFound,
+		},
 		{
 			name: "failure: invalid proof",
 			malleate: func() {
@@ -205,6 +319,24 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 			},
 			expError: commitmenttypes.ErrInvalidProof,
 		},
+		{
+			name: "failure: destination port not allowed for client",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.ClientPortAllowlists = []types.ClientPortAllowlist{types.NewClientPortAllowlist(path.EndpointB.ClientID, mockv2.ModuleNameA)}
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainB.GetContext(), params)
+			},
+			expError: types.ErrPortNotAllowed,
+		},
+		{
+			name: "failure: payload value exceeds max payload value bytes",
+			malleate: func() {
+				params := types.DefaultParams()
+				params.MaxPayloadValueBytes = uint64(len(packet.Payloads[0].Value)) - 1
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainB.GetContext(), params)
+			},
+			expError: types.ErrInvalidPayload,
+		},
 		{
 			name: "failure: invalid acknowledgement",
 			malleate: func() {
@@ -215,6 +347,16 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 			},
 			expError: types.ErrInvalidAcknowledgement,
 		},
+		{
+			name: "failure: successful recv result with error acknowledgement",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status:          types.PacketStatus_Success,
+					Acknowledgement: types.NewErrorAppAcknowledgement(ibcerrors.ErrInvalidRequest),
+				}
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -239,9 +381,13 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 
 			// expectedAck is derived from the expected recv result.
 			var expectedAck types.Acknowledgement
-			if expRecvRes.Status == types.PacketStatus_Success {
+			switch {
+			case expRecvRes.Status == types.PacketStatus_Success:
 				expectedAck = types.Acknowledgement{AppAcknowledgements: [][]byte{expRecvRes.Acknowledgement}}
-			} else {
+			case types.IsErrorAppAcknowledgement(expRecvRes.Acknowledgement) && suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainB.GetContext()).IsStructuredErrorAcknowledgementEnabled(packet.DestinationClient):
+				// structured error acknowledgements are only written if the counterparty has opted in to them
+				expectedAck = types.NewAcknowledgement(expRecvRes.Acknowledgement)
+			default:
 				expectedAck = types.Acknowledgement{AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]}}
 			}
 
@@ -285,6 +431,225 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgRecvPacketUnknownDestinationPort() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, "unknownport"))
+	suite.Require().NoError(err)
+
+	// the packet is received with an error acknowledgement rather than failing the transaction
+	err = path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	ck := path.EndpointB.Chain.GetSimApp().IBCKeeper.ChannelKeeperV2
+
+	_, ok := ck.GetPacketReceipt(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().True(ok)
+
+	// the sentinel error acknowledgement is written as the counterparty has not opted in to structured error acknowledgements
+	expectedAck := types.NewAcknowledgement(types.ErrorAcknowledgement[:])
+	actualAckBz := ck.GetPacketAcknowledgement(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().Equal(types.CommitAcknowledgement(expectedAck), actualAckBz)
+
+	params := ck.GetParams(suite.chainB.GetContext())
+	params.StructuredErrorAcknowledgementClients = []string{path.EndpointB.ClientID}
+	ck.SetParams(suite.chainB.GetContext(), params)
+
+	packet, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, "unknownport"))
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement contains the codespace and code of the route not found error once the counterparty has opted in
+	expectedAck = types.NewErrorAcknowledgement(types.ErrRouteNotFound)
+	actualAckBz = ck.GetPacketAcknowledgement(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().Equal(types.CommitAcknowledgement(expectedAck), actualAckBz)
+}
+
+func (suite *KeeperTestSuite) TestMsgRecvPacketAsyncDeadline() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	expiryDelta := time.Hour
+	params := types.DefaultParams()
+	params.AsyncAcknowledgementExpiryDelta = expiryDelta
+	suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainB.GetContext(), params)
+
+	path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+		return types.RecvPacketResult{Status: types.PacketStatus_Async}
+	}
+
+	packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	// the deadline is derived from the block time at which the packet was received
+	ctx := suite.chainB.GetContext()
+	deadline, found := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacketDeadline(ctx, packet.DestinationClient, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().LessOrEqual(deadline, uint64(ctx.BlockTime().Add(expiryDelta).Unix()))
+	suite.Require().Greater(deadline, uint64(ctx.BlockTime().Unix()))
+
+	// packets awaiting an asynchronous acknowledgement of an application which opted out of the expiry have no deadline
+	path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.ExemptFromAsyncAcknowledgementExpiry = func() bool {
+		return true
+	}
+
+	packet, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacket(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().True(found)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacketDeadline(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMsgRecvPacketMultiplePayloads() {
+	var (
+		path       *ibctesting.Path
+		packet     types.Packet
+		expRecvRes types.RecvPacketResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expAck   func() types.Acknowledgement
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+			expAck: func() types.Acknowledgement {
+				return types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement, mockv2.MockRecvPacketResult.Acknowledgement)
+			},
+		},
+		{
+			name: "success: failed recv result for second payload reverts state changes of all payloads",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status: types.PacketStatus_Failure,
+				}
+			},
+			expAck: func() types.Acknowledgement {
+				return types.NewAcknowledgement(types.ErrorAcknowledgement[:])
+			},
+		},
+		{
+			name: "failure: async recv result",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status: types.PacketStatus_Async,
+				}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+			var err error
+			packet, err = path.EndpointA.MsgSendPacket(
+				timeoutTimestamp,
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA),
+			)
+			suite.Require().NoError(err)
+
+			expRecvRes = mockv2.MockRecvPacketResult
+
+			tc.malleate()
+
+			ck := path.EndpointB.Chain.GetSimApp().IBCKeeper.ChannelKeeperV2
+
+			// the first payload writes to state, which must only be persisted if all payloads are received successfully.
+			path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+				ck.SetNextSequenceSend(ctx, path.EndpointB.ClientID, 100)
+				return mockv2.MockRecvPacketResult
+			}
+			path.EndpointB.Chain.GetSimApp().MockModuleV2A.IBCApp.OnRecvPacket = func(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+				return expRecvRes
+			}
+
+			err = path.EndpointB.MsgRecvPacket(packet)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				_, ok := ck.GetPacketReceipt(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+				suite.Require().True(ok)
+
+				expAck := tc.expAck()
+				actualAckBz := ck.GetPacketAcknowledgement(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+				suite.Require().Equal(types.CommitAcknowledgement(expAck), actualAckBz)
+
+				nextSequenceSend, ok := ck.GetNextSequenceSend(path.EndpointB.Chain.GetContext(), path.EndpointB.ClientID)
+				suite.Require().True(ok)
+				if expAck.Success() {
+					suite.Require().Equal(uint64(100), nextSequenceSend)
+				} else {
+					suite.Require().Equal(uint64(1), nextSequenceSend)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				_, ok := ck.GetPacketReceipt(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+				suite.Require().False(ok)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAcknowledgementMultiplePayloads() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	packet, err := path.EndpointA.MsgSendPacket(
+		suite.chainA.GetTimeoutTimestampSecs(),
+		mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+		mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA),
+	)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	// each payload must be acknowledged with its own app acknowledgement
+	var acknowledgedPorts []string
+	onAcknowledgementPacket := func(_ context.Context, _, _ string, _ uint64, payload types.Payload, acknowledgement []byte, _ sdk.AccAddress) error {
+		suite.Require().Equal(mockv2.MockRecvPacketResult.Acknowledgement, acknowledgement)
+		acknowledgedPorts = append(acknowledgedPorts, payload.SourcePort)
+		return nil
+	}
+	path.EndpointA.Chain.GetSimApp().MockModuleV2A.IBCApp.OnAcknowledgementPacket = onAcknowledgementPacket
+	path.EndpointA.Chain.GetSimApp().MockModuleV2B.IBCApp.OnAcknowledgementPacket = onAcknowledgementPacket
+
+	ack := types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement, mockv2.MockRecvPacketResult.Acknowledgement)
+	err = path.EndpointA.MsgAcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{mockv2.ModuleNameA, mockv2.ModuleNameB}, acknowledgedPorts)
+
+	commitment := path.EndpointA.Chain.GetSimApp().IBCKeeper.ChannelKeeperV2.GetPacketCommitment(path.EndpointA.Chain.GetContext(), packet.SourceClient, packet.Sequence)
+	suite.Require().Empty(commitment)
+}
+
 func (suite *KeeperTestSuite) TestMsgAcknowledgement() {
 	var (
 		path   *ibctesting.Path
@@ -484,3 +849,579 @@ func (suite *KeeperTestSuite) TestMsgTimeout() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgPruneAcknowledgements() {
+	var (
+		path                  *ibctesting.Path
+		msg                   *types.MsgPruneAcknowledgements
+		packet                types.Packet
+		recvProof             []byte
+		recvProofHeight       clienttypes.Height
+		proofBeforeSend       []byte
+		proofHeightBeforeSend clienttypes.Height
+		expPruningStart       uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "success: sequence without receipt is skipped",
+			malleate: func() {
+				// the packet sent after the received packet times out before being received
+				timedOutPacket, err := path.EndpointA.MsgSendPacket(uint64(suite.chainA.GetContext().BlockTime().Unix()), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.MsgTimeoutPacket(timedOutPacket))
+
+				packet, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				recvProof, recvProofHeight = path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+				suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}))
+
+				proof, proofHeight := path.EndpointA.QueryBatchProof(
+					hostv2.PacketCommitmentKey(packet.SourceClient, 1),
+					hostv2.PacketCommitmentKey(packet.SourceClient, timedOutPacket.Sequence),
+					hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence),
+				)
+				msg = types.NewMsgPruneAcknowledgements(path.EndpointB.ClientID, 3, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+				expPruningStart = packet.Sequence + 1
+			},
+		},
+		{
+			name: "failure: counterparty not found",
+			malleate: func() {
+				msg.ClientId = "07-tendermint-999"
+			},
+			expError: clienttypes.ErrCounterpartyNotFound,
+		},
+		{
+			name: "failure: packet commitment exists on counterparty",
+			malleate: func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence, []byte("commitment"))
+				suite.coordinator.CommitBlock(suite.chainA)
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+
+				msg.ProofCommitmentAbsence, msg.ProofHeight = path.EndpointA.QueryBatchProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: last sequence has not been received",
+			malleate: func() {
+				// the packet with the next sequence has not been sent, thus its commitment is absent on chainA
+				msg.Limit = 2
+				msg.ProofCommitmentAbsence, msg.ProofHeight = path.EndpointA.QueryBatchProof(
+					hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence),
+					hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence+1),
+				)
+			},
+			expError: types.ErrPacketNotReceived,
+		},
+		{
+			name: "failure: proof height is before the packet was received",
+			malleate: func() {
+				msg.ProofCommitmentAbsence = proofBeforeSend
+				msg.ProofHeight = proofHeightBeforeSend
+			},
+			expError: clienttypes.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+			// the packet commitment is absent on chainA before the packet is sent
+			proofBeforeSend, proofHeightBeforeSend = path.EndpointA.QueryBatchProof(hostv2.PacketCommitmentKey(path.EndpointA.ClientID, 1))
+
+			var err error
+			packet, err = path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+			suite.Require().NoError(err)
+
+			recvProof, recvProofHeight = path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+
+			err = path.EndpointB.MsgRecvPacket(packet)
+			suite.Require().NoError(err)
+
+			ack := types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+			err = path.EndpointA.MsgAcknowledgePacket(packet, ack)
+			suite.Require().NoError(err)
+
+			// the packet commitment has been deleted on chainA, proving its absence allows chainB to prune
+			proof, proofHeight := path.EndpointA.QueryBatchProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+			msg = types.NewMsgPruneAcknowledgements(path.EndpointB.ClientID, 1, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			expPruningStart = packet.Sequence + 1
+
+			tc.malleate()
+
+			res, err := path.EndpointB.Chain.SendMsgs(msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				channelKeeperV2 := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2
+				for sequence := uint64(1); sequence < expPruningStart; sequence++ {
+					suite.Require().False(channelKeeperV2.HasPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, sequence))
+					suite.Require().False(channelKeeperV2.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, sequence))
+				}
+
+				pruningSequenceStart, found := channelKeeperV2.GetPruningSequenceStart(suite.chainB.GetContext(), packet.DestinationClient)
+				suite.Require().True(found)
+				suite.Require().Equal(expPruningStart, pruningSequenceStart)
+
+				// the pruned packet cannot be received again using a proof of its commitment
+				recvRes, err := channelKeeperV2.RecvPacket(suite.chainB.GetContext(), types.NewMsgRecvPacket(packet, recvProof, recvProofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NOOP, recvRes.Result)
+				suite.Require().False(channelKeeperV2.HasPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, "expected error %q, got %q instead", tc.expError, err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRecvPackets() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+		msg     *types.MsgRecvPackets
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []types.ResponseResultType
+		expError   error
+	}{
+		{
+			name:       "success: proof for each packet",
+			malleate:   func() {},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.SUCCESS},
+		},
+		{
+			name: "success: combined proof",
+			malleate: func() {
+				proof, proofHeight := path.EndpointA.QueryBatchProof(
+					hostv2.PacketCommitmentKey(packets[0].SourceClient, packets[0].Sequence),
+					hostv2.PacketCommitmentKey(packets[1].SourceClient, packets[1].Sequence),
+				)
+				msg.ProofsCommitment = [][]byte{proof}
+				msg.ProofHeight = proofHeight
+			},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.SUCCESS},
+		},
+		{
+			name: "success: combined proof with packet already received",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packets[0].DestinationClient, packets[0].Sequence)
+
+				proof, proofHeight := path.EndpointA.QueryBatchProof(hostv2.PacketCommitmentKey(packets[1].SourceClient, packets[1].Sequence))
+				msg.ProofsCommitment = [][]byte{proof}
+				msg.ProofHeight = proofHeight
+			},
+			expResults: []types.ResponseResultType{types.NOOP, types.SUCCESS},
+		},
+		{
+			name: "success: combined proof with duplicate packet",
+			malleate: func() {
+				proof, proofHeight := path.EndpointA.QueryBatchProof(hostv2.PacketCommitmentKey(packets[0].SourceClient, packets[0].Sequence))
+				msg.Packets[1] = packets[0]
+				msg.ProofsCommitment = [][]byte{proof}
+				msg.ProofHeight = proofHeight
+				packets = packets[:1]
+			},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.NOOP},
+		},
+		{
+			name: "success: packet already received",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packets[0].DestinationClient, packets[0].Sequence)
+			},
+			expResults: []types.ResponseResultType{types.NOOP, types.SUCCESS},
+		},
+		{
+			name: "failure: invalid proof for a single packet",
+			malleate: func() {
+				msg.ProofsCommitment[1] = msg.ProofsCommitment[0]
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: combined proof does not contain packet commitment",
+			malleate: func() {
+				proof, proofHeight := path.EndpointA.QueryBatchProof(hostv2.PacketCommitmentKey(packets[0].SourceClient, packets[0].Sequence))
+				msg.ProofsCommitment = [][]byte{proof}
+				msg.ProofHeight = proofHeight
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+			packets = nil
+			var proofs [][]byte
+			for i := 0; i < 2; i++ {
+				packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+			}
+
+			var proofHeight clienttypes.Height
+			for _, packet := range packets {
+				var proof []byte
+				proof, proofHeight = path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+				proofs = append(proofs, proof)
+			}
+
+			msg = types.NewMsgRecvPackets(packets, proofs, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			res, err := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.RecvPackets(suite.chainB.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+
+				for _, packet := range packets {
+					suite.Require().True(suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.HasPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, "expected error %q, got %q instead", tc.expError, err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAcknowledgements() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+	ack := types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+
+	var (
+		packets []types.Packet
+		acks    []types.Acknowledgement
+		keys    [][]byte
+	)
+	for i := 0; i < 2; i++ {
+		packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+		suite.Require().NoError(err)
+
+		err = path.EndpointB.MsgRecvPacket(packet)
+		suite.Require().NoError(err)
+
+		packets = append(packets, packet)
+		acks = append(acks, ack)
+		keys = append(keys, hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence))
+	}
+
+	proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+	msg := types.NewMsgAcknowledgements(packets, acks, [][]byte{proof}, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+	res, err := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Acknowledgements(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ResponseResultType{types.SUCCESS, types.SUCCESS}, res.Results)
+
+	for _, packet := range packets {
+		suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence))
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgTimeouts() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	var (
+		packets []types.Packet
+		keys    [][]byte
+	)
+	for i := 0; i < 2; i++ {
+		timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Unix())
+		packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+		suite.Require().NoError(err)
+
+		packets = append(packets, packet)
+		keys = append(keys, hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence))
+	}
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+	msg := types.NewMsgTimeouts(packets, [][]byte{proof}, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+	res, err := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Timeouts(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ResponseResultType{types.SUCCESS, types.SUCCESS}, res.Results)
+
+	for _, packet := range packets {
+		suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence))
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgExpireAsyncPacket() {
+	var (
+		path     *ibctesting.Path
+		packet   types.Packet
+		deadline uint64
+		ctx      sdk.Context
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+			expError: nil,
+		},
+		{
+			name: "failure: deadline not elapsed",
+			malleate: func() {
+				ctx = ctx.WithBlockTime(time.Unix(int64(deadline)-1, 0))
+			},
+			expError: types.ErrAsyncPacketNotExpired,
+		},
+		{
+			name: "failure: async packet without deadline",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence+1, packet)
+				packet.Sequence++
+			},
+			expError: types.ErrAsyncPacketNotExpired,
+		},
+		{
+			name: "failure: async packet already acknowledged",
+			malleate: func() {
+				err := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.WriteAcknowledgement(ctx, packet.DestinationClient, packet.Sequence, types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement))
+				suite.Require().NoError(err)
+			},
+			expError: types.ErrAsyncPacketNotExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx context.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+				return types.RecvPacketResult{Status: types.PacketStatus_Async}
+			}
+
+			var err error
+			packet, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.MsgRecvPacket(packet)
+			suite.Require().NoError(err)
+
+			ctx = suite.chainB.GetContext()
+			deadline = uint64(ctx.BlockTime().Add(time.Minute).Unix())
+			suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacketDeadline(ctx, packet.DestinationClient, packet.Sequence, deadline)
+			ctx = ctx.WithBlockTime(time.Unix(int64(deadline), 0))
+
+			tc.malleate()
+
+			msg := types.NewMsgExpireAsyncPacket(packet.DestinationClient, packet.Sequence, suite.chainB.SenderAccount.GetAddress().String())
+			_, err = suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.ExpireAsyncPacket(ctx, msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				ck := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2
+				_, found := ck.GetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence)
+				suite.Require().False(found)
+
+				expAck := types.NewAcknowledgement(types.ErrorAcknowledgement[:])
+				suite.Require().Equal(types.CommitAcknowledgement(expAck), ck.GetPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOrderedStream() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	paramsA := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainA.GetContext())
+	paramsA.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointA.ClientID, mockv2.ModuleNameA)}
+	suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainA.GetContext(), paramsA)
+
+	paramsB := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainB.GetContext())
+	paramsB.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(path.EndpointB.ClientID, mockv2.ModuleNameB)}
+	suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetParams(suite.chainB.GetContext(), paramsB)
+
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	ack := types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+
+	packet1, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	packet2, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	// a client reserved for an ordered stream cannot be used by other ports
+	_, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+	ibctesting.RequireErrorIsOrContains(suite.T(), err, types.ErrPortNotAllowed)
+
+	// packets must be received in order
+	err = path.EndpointB.MsgRecvPacket(packet2)
+	ibctesting.RequireErrorIsOrContains(suite.T(), err, types.ErrPacketSequenceOutOfOrder)
+
+	suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet1))
+	suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet2))
+
+	stateB := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetOrderedStreamState(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB)
+	suite.Require().Equal(uint64(3), stateB.NextSequenceRecv)
+
+	// packets must be acknowledged in order
+	err = path.EndpointA.MsgAcknowledgePacket(packet2, ack)
+	ibctesting.RequireErrorIsOrContains(suite.T(), err, types.ErrPacketSequenceOutOfOrder)
+
+	suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet1, ack))
+	suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet2, ack))
+
+	stateA := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetOrderedStreamState(suite.chainA.GetContext(), path.EndpointA.ClientID, mockv2.ModuleNameA)
+	suite.Require().Equal(uint64(3), stateA.NextSequenceAck)
+	suite.Require().False(stateA.Closed)
+
+	// a timeout closes the ordered stream
+	packet3, err := path.EndpointA.MsgSendPacket(uint64(suite.chainA.GetContext().BlockTime().Unix()), payload)
+	suite.Require().NoError(err)
+
+	// the timed out packet closes the receiving side of the ordered stream instead of being received
+	suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet3))
+
+	stateB = suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetOrderedStreamState(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB)
+	suite.Require().True(stateB.Closed)
+	suite.Require().Equal(uint64(3), stateB.NextSequenceRecv)
+	suite.Require().False(suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.HasPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ClientID, packet3.Sequence))
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.MsgTimeoutPacket(packet3))
+
+	stateA = suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetOrderedStreamState(suite.chainA.GetContext(), path.EndpointA.ClientID, mockv2.ModuleNameA)
+	suite.Require().True(stateA.Closed)
+
+	_, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	ibctesting.RequireErrorIsOrContains(suite.T(), err, types.ErrOrderedStreamClosed)
+
+	// the ordering of the stream is kept once packets have been sent over it
+	paramsA.OrderedStreams = nil
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.ValidateParamsUpdate(suite.chainA.GetContext(), paramsA)
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+}
+
+func (suite *KeeperTestSuite) TestPacketArchive() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	keeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	ack := types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+
+	// packets are not archived by default
+	packet, err := path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	_, found := keeperA.GetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence)
+	suite.Require().False(found)
+
+	params := keeperA.GetParams(suite.chainA.GetContext())
+	params.PacketArchiveEnabled = true
+	keeperA.SetParams(suite.chainA.GetContext(), params)
+
+	// the archived packet is deleted once acknowledged
+	packet, err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	archivedPacket, found := keeperA.GetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(packet, archivedPacket)
+
+	suite.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	_, found = keeperA.GetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence)
+	suite.Require().False(found)
+
+	// the archived packet is deleted once timed out
+	packet, err = path.EndpointA.MsgSendPacket(uint64(suite.chainA.GetContext().BlockTime().Unix()), payload)
+	suite.Require().NoError(err)
+
+	_, found = keeperA.GetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence)
+	suite.Require().True(found)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.MsgTimeoutPacket(packet))
+
+	_, found = keeperA.GetArchivedPacket(suite.chainA.GetContext(), path.EndpointA.ClientID, packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMsgSendPacketTypedEvent() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	ctx := suite.chainA.GetContext()
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+	msg := types.NewMsgSendPacket(path.EndpointA.ClientID, timeoutTimestamp, suite.chainA.SenderAccount.GetAddress().String(), payload)
+	res, err := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SendPacket(ctx, msg)
+	suite.Require().NoError(err)
+
+	expEvent := types.NewEventSendPacket(types.NewPacket(res.Sequence, path.EndpointA.ClientID, path.EndpointB.ClientID, timeoutTimestamp, payload))
+
+	var found bool
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(expEvent) {
+			continue
+		}
+
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expEvent, typedEvent)
+		found = true
+	}
+	suite.Require().True(found)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"math"
 	"strconv"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	hostv2 "github.com/cosmos/ibc-go/v9/modules/core/24-host/v2"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -24,39 +26,92 @@ func (k *Keeper) sendPacket(
 	timeoutTimestamp uint64,
 	payloads []types.Payload,
 ) (uint64, string, error) {
-	// lookup counterparty from client identifiers
-	counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, sourceClient)
+	if len(payloads) == 0 {
+		return 0, "", errorsmod.Wrap(types.ErrInvalidPayload, "payloads must not be empty")
+	}
+
+	// lookup counterparty from client identifiers or aliased v1 channels
+	counterparty, ok := k.getCounterparty(ctx, payloads[0].SourcePort, sourceClient, false)
 	if !ok {
 		return 0, "", errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", sourceClient)
 	}
 
+	clientID := counterparty.clientID
+	aliased := clientID != sourceClient
+	if aliased {
+		for _, payload := range payloads {
+			if payload.SourcePort != payloads[0].SourcePort {
+				return 0, "", errorsmod.Wrapf(types.ErrInvalidPayload, "payload source port (%s) does not match aliased channel port (%s)", payload.SourcePort, payloads[0].SourcePort)
+			}
+		}
+	}
+
 	sequence, found := k.GetNextSequenceSend(ctx, sourceClient)
 	if !found {
-		return 0, "", errorsmod.Wrapf(types.ErrSequenceSendNotFound, "source client: %s", sourceClient)
+		if !aliased {
+			return 0, "", errorsmod.Wrapf(types.ErrSequenceSendNotFound, "source client: %s", sourceClient)
+		}
+
+		// the send sequence of an aliased v1 channel is initialised by the first IBC v2 packet sent
+		sequence = 1
 	}
 
 	// construct packet from given fields and channel state
-	packet := types.NewPacket(sequence, sourceClient, counterparty.ClientId, timeoutTimestamp, payloads...)
+	packet := types.NewPacket(sequence, sourceClient, counterparty.info.ClientId, timeoutTimestamp, payloads...)
 
 	if err := packet.ValidateBasic(); err != nil {
 		return 0, "", errorsmod.Wrapf(types.ErrInvalidPacket, "constructed packet failed basic validation: %v", err)
 	}
 
+	params := k.GetParams(ctx)
+
+	// timeoutTimestamp must be less than current block time + MaxTimeoutDelta
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	maxTimeout := sdkCtx.BlockTime().Add(params.MaxTimeoutDelta)
+	if time.Unix(int64(packet.TimeoutTimestamp), 0).After(maxTimeout) {
+		return 0, "", errorsmod.Wrapf(types.ErrInvalidTimeout, "timeout timestamp (%d) exceeds the maximum allowed timeout timestamp (%d)", packet.TimeoutTimestamp, maxTimeout.Unix())
+	}
+
+	if err := validatePacketSize(params, packet); err != nil {
+		return 0, "", err
+	}
+
+	sourcePorts := make([]string, len(packet.Payloads))
+	for i, payload := range packet.Payloads {
+		if !params.IsPortAllowed(sourceClient, payload.SourcePort) {
+			return 0, "", errorsmod.Wrapf(types.ErrPortNotAllowed, "source port (%s) is not allowed for client (%s)", payload.SourcePort, sourceClient)
+		}
+		sourcePorts[i] = payload.SourcePort
+	}
+
+	stream, ordered, err := getOrderedStream(params, sourceClient, sourcePorts)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var streamState types.OrderedStreamState
+	if ordered {
+		streamState = k.GetOrderedStreamState(ctx, sourceClient, stream.PortId)
+		if streamState.Closed {
+			return 0, "", errorsmod.Wrapf(types.ErrOrderedStreamClosed, "cannot send packet over closed ordered stream for client (%s) and port (%s)", sourceClient, stream.PortId)
+		}
+	}
+
 	// check that the client of counterparty chain is still active
-	if status := k.ClientKeeper.GetClientStatus(ctx, sourceClient); status != exported.Active {
-		return 0, "", errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", sourceClient, status)
+	if status := k.ClientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return 0, "", errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
 	// retrieve latest height and timestamp of the client of counterparty chain
-	latestHeight := k.ClientKeeper.GetClientLatestHeight(ctx, sourceClient)
+	latestHeight := k.ClientKeeper.GetClientLatestHeight(ctx, clientID)
 	if latestHeight.IsZero() {
-		return 0, "", errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "cannot send packet using client (%s) with zero height", sourceClient)
+		return 0, "", errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "cannot send packet using client (%s) with zero height", clientID)
 	}
 
 	// client timestamps are in nanoseconds while packet timeouts are in seconds
 	// thus to compare them, we convert the client timestamp to seconds in uint64
 	// to be consistent with IBC V2 specified timeout behaviour
-	latestTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, sourceClient, latestHeight)
+	latestTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, clientID, latestHeight)
 	if err != nil {
 		return 0, "", err
 	}
@@ -72,11 +127,26 @@ func (k *Keeper) sendPacket(
 	k.SetNextSequenceSend(ctx, sourceClient, sequence+1)
 	k.SetPacketCommitment(ctx, sourceClient, packet.GetSequence(), commitment)
 
+	// the ordering of the client is stored with the first packet sent over the stream, such that
+	// the packet is acknowledged or timed out as it was sent, regardless of later params updates
+	if ordered && !k.HasOrderedStreamState(ctx, sourceClient, stream.PortId) {
+		k.SetOrderedStreamState(ctx, streamState)
+	}
+
+	if params.PacketArchiveEnabled {
+		k.SetArchivedPacket(ctx, sourceClient, packet.GetSequence(), packet)
+	}
+
+	if params.PacketLifecycleTrackingEnabled {
+		lifecycle := types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.GetSelfHeight(ctx), uint64(sdkCtx.BlockTime().Unix()))
+		k.SetSendPacketLifecycle(ctx, sourceClient, packet.GetSequence(), lifecycle)
+	}
+
 	k.Logger(ctx).Info("packet sent", "sequence", strconv.FormatUint(packet.Sequence, 10), "dst_client_id", packet.DestinationClient, "src_client_id", packet.SourceClient)
 
 	emitSendPacketEvents(ctx, packet)
 
-	return sequence, counterparty.ClientId, nil
+	return sequence, counterparty.info.ClientId, nil
 }
 
 // recvPacket implements the packet receiving logic required by a packet handler.￼
@@ -92,58 +162,148 @@ func (k *Keeper) recvPacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	// lookup counterparty from client identifiers
-	counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, packet.DestinationClient)
+	verification, err := k.checkRecvPacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMembership(ctx, verification, proof, proofHeight); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", packet.DestinationClient)
+	}
+
+	if verification.closeStream {
+		k.closeOrderedStream(ctx, *verification.stream)
+		return types.ErrOrderedStreamTimedOut
+	}
+
+	k.applyRecvPacket(ctx, packet, proofHeight, verification)
+
+	return nil
+}
+
+// checkRecvPacket performs the checks required to receive the packet, returning the packet commitment
+// which must be proven to exist on the counterparty. If the packet has already been received a no-op
+// error is returned. A timed out packet which is next in the order of an ordered stream is not received,
+// instead the verification is marked to close the receiving side of the stream once the packet commitment
+// has been verified.
+func (k *Keeper) checkRecvPacket(ctx context.Context, packet types.Packet) (packetVerification, error) {
+	// lookup counterparty from client identifiers or aliased v1 channels
+	counterparty, ok := k.getCounterparty(ctx, packet.Payloads[0].DestinationPort, packet.DestinationClient, false)
 	if !ok {
-		return errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
+		return packetVerification{}, errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
 	}
 
-	if counterparty.ClientId != packet.SourceClient {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.ClientId, packet.SourceClient)
+	if counterparty.info.ClientId != packet.SourceClient {
+		return packetVerification{}, errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.info.ClientId, packet.SourceClient)
 	}
 
-	// check if packet timed out by comparing it with the latest height of the chain
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentTimestamp := uint64(sdkCtx.BlockTime().Unix())
-	if currentTimestamp >= packet.TimeoutTimestamp {
-		return errorsmod.Wrapf(types.ErrTimeoutElapsed, "current timestamp: %d, timeout timestamp: %d", currentTimestamp, packet.TimeoutTimestamp)
+	if counterparty.clientID != packet.DestinationClient {
+		for _, payload := range packet.Payloads {
+			if payload.DestinationPort != packet.Payloads[0].DestinationPort {
+				return packetVerification{}, errorsmod.Wrapf(types.ErrInvalidPayload, "payload destination port (%s) does not match aliased channel port (%s)", payload.DestinationPort, packet.Payloads[0].DestinationPort)
+			}
+		}
+	}
+
+	// REPLAY PROTECTION: The receipt checks are performed before the checks against the params, such that
+	// an already received packet remains a no-op if the params have been updated since it was received.
+	// Only the receipts and acknowledgements of received packets are pruned,
+	// thus a packet whose sequence is below the pruning sequence start has already been received.
+	if pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, packet.DestinationClient); found && packet.Sequence < pruningSequenceStart {
+		return packetVerification{}, types.ErrNoOpMsg
 	}
 
 	// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
 	// Packet receipts must not be pruned, unless it has been marked stale
-	// by the increase of the recvStartSequence.
+	// by the increase of the pruning sequence start.
 	if k.HasPacketReceipt(ctx, packet.DestinationClient, packet.Sequence) {
 		// This error indicates that the packet has already been relayed. Core IBC will
 		// treat this error as a no-op in order to prevent an entire relay transaction
 		// from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return packetVerification{}, types.ErrNoOpMsg
+	}
+
+	params := k.GetParams(ctx)
+	if err := validatePacketSize(params, packet); err != nil {
+		return packetVerification{}, err
+	}
+
+	destinationPorts := make([]string, len(packet.Payloads))
+	for i, payload := range packet.Payloads {
+		if !params.IsPortAllowed(packet.DestinationClient, payload.DestinationPort) {
+			return packetVerification{}, errorsmod.Wrapf(types.ErrPortNotAllowed, "destination port (%s) is not allowed for client (%s)", payload.DestinationPort, packet.DestinationClient)
+		}
+		destinationPorts[i] = payload.DestinationPort
+	}
+
+	stream, ordered, err := getOrderedStream(params, packet.DestinationClient, destinationPorts)
+	if err != nil {
+		return packetVerification{}, err
+	}
+
+	// packets sent over an ordered stream must be received in the order in which they were sent
+	var streamState types.OrderedStreamState
+	if ordered {
+		streamState = k.GetOrderedStreamState(ctx, packet.DestinationClient, stream.PortId)
+		if packet.Sequence < streamState.NextSequenceRecv {
+			// the packet has already been received, this is a no-op
+			return packetVerification{}, types.ErrNoOpMsg
+		}
+
+		if streamState.Closed {
+			return packetVerification{}, errorsmod.Wrapf(types.ErrOrderedStreamClosed, "cannot receive packet over closed ordered stream for client (%s) and port (%s)", packet.DestinationClient, stream.PortId)
+		}
+
+		if packet.Sequence > streamState.NextSequenceRecv {
+			return packetVerification{}, errorsmod.Wrapf(types.ErrPacketSequenceOutOfOrder, "packet sequence ≠ next receive sequence (%d ≠ %d)", packet.Sequence, streamState.NextSequenceRecv)
+		}
 	}
 
 	path := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
 
-	commitment := types.CommitPacket(packet)
+	verification := packetVerification{
+		counterparty: counterparty,
+		path:         types.BuildMerklePath(counterparty.info.MerklePrefix, path),
+		value:        types.CommitPacket(packet),
+	}
+	if ordered {
+		verification.stream = &streamState
+	}
 
-	if err := k.ClientKeeper.VerifyMembership(
-		ctx,
-		packet.DestinationClient,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-		commitment,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", packet.DestinationClient)
+	// check if packet timed out by comparing it with the latest height of the chain
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTimestamp := uint64(sdkCtx.BlockTime().Unix())
+	if currentTimestamp >= packet.TimeoutTimestamp {
+		if !ordered {
+			return packetVerification{}, errorsmod.Wrapf(types.ErrTimeoutElapsed, "current timestamp: %d, timeout timestamp: %d", currentTimestamp, packet.TimeoutTimestamp)
+		}
+
+		// as for ordered channels in IBC v1, the packets sent after a timed out packet can no longer
+		// be received in order, thus the receiving side of the ordered stream is closed.
+		verification.closeStream = true
 	}
 
+	return verification, nil
+}
+
+// applyRecvPacket writes the state changes of a received packet whose packet commitment has been verified.
+func (k *Keeper) applyRecvPacket(ctx context.Context, packet types.Packet, proofHeight exported.Height, verification packetVerification) {
 	// Set Packet Receipt to prevent timeout from occurring on counterparty
 	k.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
 
+	// record the proof height, the packet commitment can only be proven to be deleted at a later height
+	if recvProofHeight, found := k.GetRecvProofHeight(ctx, packet.DestinationClient); !found || proofHeight.GT(recvProofHeight) {
+		k.SetRecvProofHeight(ctx, packet.DestinationClient, clienttypes.NewHeight(proofHeight.GetRevisionNumber(), proofHeight.GetRevisionHeight()))
+	}
+
+	if verification.stream != nil {
+		verification.stream.NextSequenceRecv++
+		k.SetOrderedStreamState(ctx, *verification.stream)
+	}
+
 	k.Logger(ctx).Info("packet received", "sequence", strconv.FormatUint(packet.Sequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
 
 	emitRecvPacketEvents(ctx, packet)
-
-	return nil
 }
 
 // writeAcknowledgement writes the acknowledgement to the store and emits the packet and acknowledgement
@@ -153,14 +313,14 @@ func (k Keeper) writeAcknowledgement(
 	packet types.Packet,
 	ack types.Acknowledgement,
 ) error {
-	// lookup counterparty from client identifiers
-	counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, packet.DestinationClient)
+	// lookup counterparty from client identifiers or aliased v1 channels
+	counterparty, ok := k.getCounterparty(ctx, packet.Payloads[0].DestinationPort, packet.DestinationClient, false)
 	if !ok {
 		return errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
 	}
 
-	if counterparty.ClientId != packet.SourceClient {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.ClientId, packet.SourceClient)
+	if counterparty.info.ClientId != packet.SourceClient {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.info.ClientId, packet.SourceClient)
 	}
 
 	// NOTE: IBC app modules might have written the acknowledgement synchronously on
@@ -174,6 +334,13 @@ func (k Keeper) writeAcknowledgement(
 		return errorsmod.Wrap(types.ErrInvalidPacket, "receipt not found for packet")
 	}
 
+	// structured error acknowledgements are only written for counterparties which have opted in to them, as they
+	// would otherwise not be recognised as error acknowledgements. The error is emitted in events in either case.
+	ackErr, hasAckErr := ack.AcknowledgementError()
+	if hasAckErr && !k.GetParams(ctx).IsStructuredErrorAcknowledgementEnabled(packet.DestinationClient) {
+		ack = types.NewAcknowledgement(types.ErrorAcknowledgement[:])
+	}
+
 	// set the acknowledgement so that it can be verified on the other side
 	k.SetPacketAcknowledgement(
 		ctx, packet.DestinationClient, packet.Sequence,
@@ -182,7 +349,7 @@ func (k Keeper) writeAcknowledgement(
 
 	k.Logger(ctx).Info("acknowledgement written", "sequence", strconv.FormatUint(packet.Sequence, 10), "dst_client_id", packet.DestinationClient)
 
-	emitWriteAcknowledgementEvents(ctx, packet, ack)
+	emitWriteAcknowledgementEvents(ctx, packet, ack, ackErr, hasAckErr)
 
 	return nil
 }
@@ -215,15 +382,73 @@ func (k *Keeper) WriteAcknowledgement(ctx context.Context, clientID string, sequ
 	return nil
 }
 
+// expireAsyncPacket writes an error acknowledgement on behalf of the application for a packet
+// awaiting an asynchronous acknowledgement whose deadline has elapsed.
+func (k *Keeper) expireAsyncPacket(ctx context.Context, clientID string, sequence uint64) error {
+	deadline, found := k.GetAsyncPacketDeadline(ctx, clientID, sequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAsyncPacketNotExpired, "async packet with clientID (%s) and sequence (%d) has no deadline", clientID, sequence)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if currentTimestamp := uint64(sdkCtx.BlockTime().Unix()); currentTimestamp < deadline {
+		return errorsmod.Wrapf(types.ErrAsyncPacketNotExpired, "current timestamp: %d, deadline: %d", currentTimestamp, deadline)
+	}
+
+	if err := k.WriteAcknowledgement(ctx, clientID, sequence, types.NewErrorAcknowledgement(types.ErrAsyncPacketExpired)); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("async packet expired", "sequence", strconv.FormatUint(sequence, 10), "dst_client_id", clientID, "deadline", strconv.FormatUint(deadline, 10))
+
+	return nil
+}
+
+// ExpireAsyncPackets writes error acknowledgements for at most limit packets awaiting an asynchronous
+// acknowledgement whose deadline has elapsed. Packets which fail to be expired are removed from the
+// expiry queue so that they are not retried in every block.
+func (k *Keeper) ExpireAsyncPackets(ctx sdk.Context, limit int) {
+	expired := k.GetExpiredAsyncPackets(ctx, uint64(ctx.BlockTime().Unix()), limit)
+	for _, packet := range expired {
+		// use a cached context so that a failure to expire a packet does not leave partial state changes
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.expireAsyncPacket(cacheCtx, packet.ClientId, packet.Sequence); err != nil {
+			k.Logger(ctx).Error("failed to expire async packet", "client-id", packet.ClientId, "sequence", packet.Sequence, "error", err.Error())
+			k.deleteAsyncPacketDeadline(ctx, packet.ClientId, packet.Sequence)
+			continue
+		}
+
+		writeFn()
+	}
+}
+
 func (k *Keeper) acknowledgePacket(ctx context.Context, packet types.Packet, acknowledgement types.Acknowledgement, proof []byte, proofHeight exported.Height) error {
-	// lookup counterparty from client identifiers
-	counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, packet.SourceClient)
+	verification, err := k.checkAcknowledgePacket(ctx, packet, acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMembership(ctx, verification, proof, proofHeight); err != nil {
+		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", packet.SourceClient)
+	}
+
+	k.applyAcknowledgePacket(ctx, packet, acknowledgement, verification)
+
+	return nil
+}
+
+// checkAcknowledgePacket performs the checks required to acknowledge the packet, returning the
+// acknowledgement commitment which must be proven to exist on the counterparty. If the packet
+// commitment no longer exists a no-op error is returned.
+func (k *Keeper) checkAcknowledgePacket(ctx context.Context, packet types.Packet, acknowledgement types.Acknowledgement) (packetVerification, error) {
+	// lookup counterparty from client identifiers or aliased v1 channels
+	counterparty, ok := k.getCounterparty(ctx, packet.Payloads[0].SourcePort, packet.SourceClient, false)
 	if !ok {
-		return errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
+		return packetVerification{}, errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
 	}
 
-	if counterparty.ClientId != packet.DestinationClient {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
+	if counterparty.info.ClientId != packet.DestinationClient {
+		return packetVerification{}, errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.info.ClientId, packet.DestinationClient)
 	}
 
 	commitment := k.GetPacketCommitment(ctx, packet.SourceClient, packet.Sequence)
@@ -232,38 +457,55 @@ func (k *Keeper) acknowledgePacket(ctx context.Context, packet types.Packet, ack
 		// or there is a misconfigured relayer attempting to prove an acknowledgement
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
 		// prevent an entire relay transaction from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return packetVerification{}, types.ErrNoOpMsg
 	}
 
 	packetCommitment := types.CommitPacket(packet)
 
 	// verify we sent the packet and haven't cleared it out yet
 	if !bytes.Equal(commitment, packetCommitment) {
-		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
+		return packetVerification{}, errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
 	path := hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
-
-	if err := k.ClientKeeper.VerifyMembership(
-		ctx,
-		packet.SourceClient,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-		types.CommitAcknowledgement(acknowledgement),
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", packet.SourceClient)
+
+	verification := packetVerification{
+		counterparty: counterparty,
+		path:         types.BuildMerklePath(counterparty.info.MerklePrefix, path),
+		value:        types.CommitAcknowledgement(acknowledgement),
 	}
 
+	// packets sent over an ordered stream must be acknowledged in the order in which they were sent
+	if streamState, ordered := k.getPacketOrderedStream(ctx, packet); ordered {
+		if packet.Sequence != streamState.NextSequenceAck {
+			return packetVerification{}, errorsmod.Wrapf(types.ErrPacketSequenceOutOfOrder, "packet sequence ≠ next ack sequence (%d ≠ %d)", packet.Sequence, streamState.NextSequenceAck)
+		}
+
+		verification.stream = &streamState
+	}
+
+	return verification, nil
+}
+
+// applyAcknowledgePacket writes the state changes of an acknowledged packet whose acknowledgement has been verified.
+func (k *Keeper) applyAcknowledgePacket(ctx context.Context, packet types.Packet, acknowledgement types.Acknowledgement, verification packetVerification) {
 	k.DeletePacketCommitment(ctx, packet.SourceClient, packet.Sequence)
+	k.deleteArchivedPacket(ctx, packet.SourceClient, packet.Sequence)
 
-	k.Logger(ctx).Info("packet acknowledged", "sequence", strconv.FormatUint(packet.GetSequence(), 10), "src_client_id", packet.GetSourceClient(), "dst_client_id", packet.GetDestinationClient())
+	ackStatus := types.PacketLifecycleStatus_AcknowledgedSuccess
+	if !acknowledgement.Success() {
+		ackStatus = types.PacketLifecycleStatus_AcknowledgedError
+	}
+	k.recordSendPacketLifecycle(ctx, packet.SourceClient, packet.Sequence, ackStatus)
 
-	emitAcknowledgePacketEvents(ctx, packet)
+	if verification.stream != nil {
+		verification.stream.NextSequenceAck++
+		k.SetOrderedStreamState(ctx, *verification.stream)
+	}
 
-	return nil
+	k.Logger(ctx).Info("packet acknowledged", "sequence", strconv.FormatUint(packet.GetSequence(), 10), "src_client_id", packet.GetSourceClient(), "dst_client_id", packet.GetDestinationClient())
+
+	emitAcknowledgePacketEvents(ctx, packet, acknowledgement)
 }
 
 // timeoutPacket implements the timeout logic required by a packet handler.
@@ -279,28 +521,46 @@ func (k *Keeper) timeoutPacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	// lookup counterparty from client identifiers
-	counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, packet.SourceClient)
+	verification, err := k.checkTimeoutPacket(ctx, packet, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyNonMembership(ctx, verification, proof, proofHeight); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", packet.SourceClient)
+	}
+
+	k.applyTimeoutPacket(ctx, packet)
+
+	return nil
+}
+
+// checkTimeoutPacket performs the checks required to time out the packet, returning the packet receipt
+// path whose absence must be proven on the counterparty. If the packet commitment no longer exists a
+// no-op error is returned.
+func (k *Keeper) checkTimeoutPacket(ctx context.Context, packet types.Packet, proofHeight exported.Height) (packetVerification, error) {
+	// lookup counterparty from client identifiers or aliased v1 channels
+	counterparty, ok := k.getCounterparty(ctx, packet.Payloads[0].SourcePort, packet.SourceClient, true)
 	if !ok {
-		return errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
+		return packetVerification{}, errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
 	}
 
-	if counterparty.ClientId != packet.DestinationClient {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
+	if counterparty.info.ClientId != packet.DestinationClient {
+		return packetVerification{}, errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.info.ClientId, packet.DestinationClient)
 	}
 
 	// check that timeout timestamp has passed on the other end
 	// client timestamps are in nanoseconds while packet timeouts are in seconds
 	// so we convert client timestamp to seconds in uint64 to be consistent
 	// with IBC V2 timeout behaviour
-	proofTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, packet.SourceClient, proofHeight)
+	proofTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, counterparty.clientID, proofHeight)
 	if err != nil {
-		return err
+		return packetVerification{}, err
 	}
 	proofTimestamp := uint64(time.Unix(0, int64(proofTimestampNano)).Unix())
 
 	if proofTimestamp < packet.TimeoutTimestamp {
-		return errorsmod.Wrapf(types.ErrTimeoutNotReached, "proof timestamp: %d, timeout timestamp: %d", proofTimestamp, packet.TimeoutTimestamp)
+		return packetVerification{}, errorsmod.Wrapf(types.ErrTimeoutNotReached, "proof timestamp: %d, timeout timestamp: %d", proofTimestamp, packet.TimeoutTimestamp)
 	}
 
 	// check that the commitment has not been cleared and that it matches the packet sent by relayer
@@ -310,36 +570,158 @@ func (k *Keeper) timeoutPacket(
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
 		// prevent an entire relay transaction from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return packetVerification{}, types.ErrNoOpMsg
 	}
 
 	packetCommitment := types.CommitPacket(packet)
 	// verify we sent the packet and haven't cleared it out yet
 	if !bytes.Equal(commitment, packetCommitment) {
-		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
+		return packetVerification{}, errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	// verify packet receipt absence
 	path := hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
-
-	if err := k.ClientKeeper.VerifyNonMembership(
-		ctx,
-		packet.SourceClient,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", packet.SourceClient)
-	}
 
+	return packetVerification{
+		counterparty: counterparty,
+		path:         types.BuildMerklePath(counterparty.info.MerklePrefix, path),
+	}, nil
+}
+
+// applyTimeoutPacket writes the state changes of a timed out packet whose receipt absence has been verified.
+func (k *Keeper) applyTimeoutPacket(ctx context.Context, packet types.Packet) {
 	// delete packet commitment to prevent replay
 	k.DeletePacketCommitment(ctx, packet.SourceClient, packet.Sequence)
+	k.deleteArchivedPacket(ctx, packet.SourceClient, packet.Sequence)
+	k.recordSendPacketLifecycle(ctx, packet.SourceClient, packet.Sequence, types.PacketLifecycleStatus_TimedOut)
+
+	// as the packets sent after the timed out packet can no longer be received in order,
+	// the ordered stream is closed, preventing any further packets from being sent over it.
+	if streamState, ordered := k.getPacketOrderedStream(ctx, packet); ordered && !streamState.Closed {
+		k.closeOrderedStream(ctx, streamState)
+	}
 
 	k.Logger(ctx).Info("packet timed out", "sequence", strconv.FormatUint(packet.Sequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
 
 	emitTimeoutPacketEvents(ctx, packet)
+}
+
+// pruneAcknowledgements prunes the packet acknowledgements and receipts stored for the provided client,
+// for the limit sequences starting from its pruning sequence start. The combined proof must prove the absence
+// of the packet commitments of all these sequences on the counterparty. Once the packet commitment has been
+// deleted on the counterparty, the packet can no longer be received or acknowledged, thus the packet receipt
+// and acknowledgement are no longer required.
+//
+// Sequences without a packet receipt or acknowledgement are skipped, as their packets timed out before being
+// received. The last sequence to be pruned must have been received, and the proof height must be at or after
+// the latest proof height at which a packet was received over the client, such that all pruned sequences had
+// been sent at the proof height and the absence of their packet commitments cannot be proven for packets which
+// have yet to be sent or received.
+//
+// The total number of pruned sequences and the updated pruning sequence start are returned.
+func (k *Keeper) pruneAcknowledgements(ctx context.Context, clientID string, limit uint64, proof []byte, proofHeight exported.Height) (uint64, uint64, error) {
+	counterparty, ok := k.ClientKeeper.GetClientCounterparty(ctx, clientID)
+	if !ok {
+		return 0, 0, errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not found for client: %s", clientID)
+	}
+
+	pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, clientID)
+	if !found {
+		pruningSequenceStart = 1
+	}
+
+	recvProofHeight, found := k.GetRecvProofHeight(ctx, clientID)
+	if !found {
+		return 0, 0, errorsmod.Wrapf(types.ErrPacketNotReceived, "no packet has been received for client (%s)", clientID)
+	}
+
+	if proofHeight.LT(recvProofHeight) {
+		return 0, 0, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "proof height (%s) is before the latest proof height at which a packet was received (%s)", proofHeight, recvProofHeight)
+	}
+
+	if limit > math.MaxUint64-pruningSequenceStart {
+		return 0, 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "pruning limit (%d) overflows the pruning sequence start (%d)", limit, pruningSequenceStart)
+	}
+
+	pruningSequenceEnd := pruningSequenceStart + limit
+	lastSequence := pruningSequenceEnd - 1
+	if !k.HasPacketReceipt(ctx, clientID, lastSequence) && !k.HasPacketAcknowledgement(ctx, clientID, lastSequence) {
+		return 0, 0, errorsmod.Wrapf(types.ErrPacketNotReceived, "no packet receipt or acknowledgement found for the last sequence to be pruned for client (%s) and sequence (%d)", clientID, lastSequence)
+	}
+
+	var verifications []packetVerification
+	for sequence := pruningSequenceStart; sequence < pruningSequenceEnd; sequence++ {
+		path := hostv2.PacketCommitmentKey(counterparty.ClientId, sequence)
+		verifications = append(verifications, packetVerification{
+			counterparty: counterpartyEnd{clientID: clientID, info: counterparty},
+			path:         types.BuildMerklePath(counterparty.MerklePrefix, path),
+		})
+	}
+
+	if err := k.verifyNonMembershipBatch(ctx, verifications, proof, proofHeight); err != nil {
+		return 0, 0, errorsmod.Wrapf(err, "failed packet commitment absence verification for client (%s) and sequences [%d, %d)", clientID, pruningSequenceStart, pruningSequenceEnd)
+	}
+
+	for sequence := pruningSequenceStart; sequence < pruningSequenceEnd; sequence++ {
+		k.deletePacketAcknowledgement(ctx, clientID, sequence)
+		k.deletePacketReceipt(ctx, clientID, sequence)
+	}
+
+	k.SetPruningSequenceStart(ctx, clientID, pruningSequenceEnd)
+
+	k.Logger(ctx).Info("packet acknowledgements pruned", "client_id", clientID, "pruning_sequence_start", strconv.FormatUint(pruningSequenceEnd, 10))
+
+	return limit, pruningSequenceEnd, nil
+}
+
+// validatePacketSize checks that the size of each payload value and of the encoded packet
+// do not exceed the maximums configured in the channel v2 parameters.
+func validatePacketSize(params types.Params, packet types.Packet) error {
+	for i, payload := range packet.Payloads {
+		if size := uint64(len(payload.Value)); size > params.MaxPayloadValueBytes {
+			return errorsmod.Wrapf(types.ErrInvalidPayload, "payload %d value size (%d bytes) exceeds maximum (%d bytes)", i, size, params.MaxPayloadValueBytes)
+		}
+	}
+
+	if size := uint64(packet.Size()); size > params.MaxPacketBytes {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet size (%d bytes) exceeds maximum (%d bytes)", size, params.MaxPacketBytes)
+	}
 
 	return nil
 }
+
+// getOrderedStream returns the ordered stream configured for the given client, if any. As a client with an
+// ordered stream is reserved for the port of the stream, an error is returned if any of the provided ports
+// differs from the port of the stream.
+func getOrderedStream(params types.Params, clientID string, portIDs []string) (types.OrderedStream, bool, error) {
+	stream, found := params.GetOrderedStream(clientID)
+	if !found {
+		return types.OrderedStream{}, false, nil
+	}
+
+	for _, portID := range portIDs {
+		if portID != stream.PortId {
+			return types.OrderedStream{}, false, errorsmod.Wrapf(types.ErrPortNotAllowed, "client (%s) is reserved for the ordered stream of port (%s), got port (%s)", clientID, stream.PortId, portID)
+		}
+	}
+
+	return stream, true, nil
+}
+
+// getPacketOrderedStream returns the state of the ordered stream over which the given packet was sent, if any.
+// The ordering is read from the stream state stored when the first packet was sent over the stream, rather
+// than from the current params.
+func (k *Keeper) getPacketOrderedStream(ctx context.Context, packet types.Packet) (types.OrderedStreamState, bool) {
+	if !k.HasOrderedStreamState(ctx, packet.SourceClient, packet.Payloads[0].SourcePort) {
+		return types.OrderedStreamState{}, false
+	}
+
+	return k.GetOrderedStreamState(ctx, packet.SourceClient, packet.Payloads[0].SourcePort), true
+}
+
+// closeOrderedStream closes the given ordered stream, such that no further packets can be sent or received over it.
+func (k *Keeper) closeOrderedStream(ctx context.Context, streamState types.OrderedStreamState) {
+	streamState.Closed = true
+	k.SetOrderedStreamState(ctx, streamState)
+
+	emitCloseOrderedStreamEvents(ctx, streamState.ClientId, streamState.PortId)
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// packetVerification holds the counterparty state which must be proven in order to receive,
+// acknowledge or time out a packet. The value is left empty for absence proofs.
+type packetVerification struct {
+	counterparty counterpartyEnd
+	path         commitmenttypesv2.MerklePath
+	value        []byte
+	// stream is the state of the ordered stream the packet was sent over, if any.
+	stream *types.OrderedStreamState
+	// closeStream is set if the packet timed out before being received over an ordered stream, in which
+	// case the stream is closed rather than the packet being received.
+	closeStream bool
+}
+
+// verifyMembership verifies the proof of the existence of the packet verification value on the counterparty.
+func (k *Keeper) verifyMembership(ctx context.Context, verification packetVerification, proof []byte, proofHeight exported.Height) error {
+	return k.ClientKeeper.VerifyMembership(
+		ctx,
+		verification.counterparty.clientID,
+		proofHeight,
+		verification.counterparty.delayTimePeriod, verification.counterparty.delayBlockPeriod,
+		proof,
+		verification.path,
+		verification.value,
+	)
+}
+
+// verifyNonMembership verifies the proof of the absence of the packet verification path on the counterparty.
+func (k *Keeper) verifyNonMembership(ctx context.Context, verification packetVerification, proof []byte, proofHeight exported.Height) error {
+	return k.ClientKeeper.VerifyNonMembership(
+		ctx,
+		verification.counterparty.clientID,
+		proofHeight,
+		verification.counterparty.delayTimePeriod, verification.counterparty.delayBlockPeriod,
+		proof,
+		verification.path,
+	)
+}
+
+// verifyMembershipBatch verifies a single combined proof of the existence of the values of all packet verifications.
+// All packet verifications must be performed against the same client.
+func (k *Keeper) verifyMembershipBatch(ctx context.Context, verifications []packetVerification, proof []byte, proofHeight exported.Height) error {
+	counterparty, err := batchCounterparty(verifications)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]exported.Path, len(verifications))
+	values := make([][]byte, len(verifications))
+	for i, verification := range verifications {
+		paths[i] = verification.path
+		values[i] = verification.value
+	}
+
+	return k.ClientKeeper.VerifyMembershipBatch(
+		ctx,
+		counterparty.clientID,
+		proofHeight,
+		counterparty.delayTimePeriod, counterparty.delayBlockPeriod,
+		proof,
+		paths,
+		values,
+	)
+}
+
+// verifyNonMembershipBatch verifies a single combined proof of the absence of the paths of all packet verifications.
+// All packet verifications must be performed against the same client.
+func (k *Keeper) verifyNonMembershipBatch(ctx context.Context, verifications []packetVerification, proof []byte, proofHeight exported.Height) error {
+	counterparty, err := batchCounterparty(verifications)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]exported.Path, len(verifications))
+	for i, verification := range verifications {
+		paths[i] = verification.path
+	}
+
+	return k.ClientKeeper.VerifyNonMembershipBatch(
+		ctx,
+		counterparty.clientID,
+		proofHeight,
+		counterparty.delayTimePeriod, counterparty.delayBlockPeriod,
+		proof,
+		paths,
+	)
+}
+
+// batchCounterparty returns the counterparty shared by all packet verifications. A combined proof can only
+// be verified by a single client, thus an error is returned if the packets were relayed over different clients.
+// The strictest delay period of the packet verifications is enforced for the whole batch.
+func batchCounterparty(verifications []packetVerification) (counterpartyEnd, error) {
+	counterparty := verifications[0].counterparty
+	for _, verification := range verifications[1:] {
+		if verification.counterparty.clientID != counterparty.clientID {
+			return counterpartyEnd{}, errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "packets with a combined proof must be verified by the same client: expected %s, got %s", counterparty.clientID, verification.counterparty.clientID)
+		}
+
+		counterparty.delayTimePeriod = max(counterparty.delayTimePeriod, verification.counterparty.delayTimePeriod)
+		counterparty.delayBlockPeriod = max(counterparty.delayBlockPeriod, verification.counterparty.delayBlockPeriod)
+	}
+
+	return counterparty, nil
+}
@@ -0,0 +1,70 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding channel v2 type.
+func NewDecodeStore(cdc codec.BinaryCodec, kvA, kvB kv.Pair) (string, bool) {
+	switch {
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyAsyncPacketDeadline)):
+		deadlineA := sdk.BigEndianToUint64(kvA.Value)
+		deadlineB := sdk.BigEndianToUint64(kvB.Value)
+		return fmt.Sprintf("AsyncPacketDeadline A: %d\nAsyncPacketDeadline B: %d", deadlineA, deadlineB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyAsyncPacketExpiryQueue)):
+		return fmt.Sprintf("AsyncPacketExpiryQueue A: %X\nAsyncPacketExpiryQueue B: %X", kvA.Value, kvB.Value), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyAsyncPacket+"/")):
+		var packetA, packetB types.Packet
+		cdc.MustUnmarshal(kvA.Value, &packetA)
+		cdc.MustUnmarshal(kvB.Value, &packetB)
+		return fmt.Sprintf("AsyncPacket A: %v\nAsyncPacket B: %v", packetA, packetB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyOrderedStream+"/")):
+		var stateA, stateB types.OrderedStreamState
+		cdc.MustUnmarshal(kvA.Value, &stateA)
+		cdc.MustUnmarshal(kvB.Value, &stateB)
+		return fmt.Sprintf("OrderedStreamState A: %v\nOrderedStreamState B: %v", stateA, stateB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyPacketArchive)):
+		var packetA, packetB types.Packet
+		cdc.MustUnmarshal(kvA.Value, &packetA)
+		cdc.MustUnmarshal(kvB.Value, &packetB)
+		return fmt.Sprintf("ArchivedPacket A: %v\nArchivedPacket B: %v", packetA, packetB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyPacketLifecycle)):
+		var lifecycleA, lifecycleB types.PacketLifecycle
+		cdc.MustUnmarshal(kvA.Value, &lifecycleA)
+		cdc.MustUnmarshal(kvB.Value, &lifecycleB)
+		return fmt.Sprintf("PacketLifecycle A: %v\nPacketLifecycle B: %v", lifecycleA, lifecycleB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyPendingCommitmentCount)):
+		countA := sdk.BigEndianToUint64(kvA.Value)
+		countB := sdk.BigEndianToUint64(kvB.Value)
+		return fmt.Sprintf("PendingCommitmentCount A: %d\nPendingCommitmentCount B: %d", countA, countB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyPruningSequenceStart)):
+		seqA := sdk.BigEndianToUint64(kvA.Value)
+		seqB := sdk.BigEndianToUint64(kvB.Value)
+		return fmt.Sprintf("PruningSequenceStart A: %d\nPruningSequenceStart B: %d", seqA, seqB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyRecvProofHeight)):
+		var heightA, heightB clienttypes.Height
+		cdc.MustUnmarshal(kvA.Value, &heightA)
+		cdc.MustUnmarshal(kvB.Value, &heightB)
+		return fmt.Sprintf("RecvProofHeight A: %v\nRecvProofHeight B: %v", heightA, heightB), true
+
+	default:
+		return "", false
+	}
+}
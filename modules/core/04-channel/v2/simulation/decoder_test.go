@@ -0,0 +1,91 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/simulation"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
+	"github.com/cosmos/ibc-go/v9/testing/simapp"
+)
+
+func TestDecodeStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	cdc := app.AppCodec()
+
+	packet := types.NewPacket(1, ibctesting.FirstClientID, ibctesting.SecondClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	orderedStreamState := types.NewOrderedStreamState(ibctesting.FirstClientID, mockv2.ModuleNameA, 2, 1, false)
+	lifecycle := types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{
+				Key:   types.AsyncPacketKey(ibctesting.SecondClientID, 1),
+				Value: cdc.MustMarshal(&packet),
+			},
+			{
+				Key:   types.AsyncPacketDeadlineKey(ibctesting.SecondClientID, 1),
+				Value: sdk.Uint64ToBigEndian(100),
+			},
+			{
+				Key:   types.OrderedStreamKey(ibctesting.FirstClientID, mockv2.ModuleNameA),
+				Value: cdc.MustMarshal(&orderedStreamState),
+			},
+			{
+				Key:   types.PacketArchiveKey(ibctesting.FirstClientID, 1),
+				Value: cdc.MustMarshal(&packet),
+			},
+			{
+				Key:   types.SendPacketLifecycleKey(ibctesting.FirstClientID, 1),
+				Value: cdc.MustMarshal(&lifecycle),
+			},
+			{
+				Key:   types.PendingCommitmentCountKey(ibctesting.FirstClientID),
+				Value: sdk.Uint64ToBigEndian(2),
+			},
+			{
+				Key:   types.PruningSequenceStartKey(ibctesting.FirstClientID),
+				Value: sdk.Uint64ToBigEndian(1),
+			},
+			{
+				Key:   []byte{0x99},
+				Value: []byte{0x99},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"AsyncPacket", fmt.Sprintf("AsyncPacket A: %v\nAsyncPacket B: %v", packet, packet)},
+		{"AsyncPacketDeadline", "AsyncPacketDeadline A: 100\nAsyncPacketDeadline B: 100"},
+		{"OrderedStreamState", fmt.Sprintf("OrderedStreamState A: %v\nOrderedStreamState B: %v", orderedStreamState, orderedStreamState)},
+		{"ArchivedPacket", fmt.Sprintf("ArchivedPacket A: %v\nArchivedPacket B: %v", packet, packet)},
+		{"PacketLifecycle", fmt.Sprintf("PacketLifecycle A: %v\nPacketLifecycle B: %v", lifecycle, lifecycle)},
+		{"PendingCommitmentCount", "PendingCommitmentCount A: 2\nPendingCommitmentCount B: 2"},
+		{"PruningSequenceStart", "PruningSequenceStart A: 1\nPruningSequenceStart B: 1"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			res, found := simulation.NewDecodeStore(cdc, kvPairs.Pairs[i], kvPairs.Pairs[i])
+			if i == len(tests)-1 {
+				require.False(t, found, string(kvPairs.Pairs[i].Key))
+				require.Empty(t, res, string(kvPairs.Pairs[i].Key))
+			} else {
+				require.True(t, found, string(kvPairs.Pairs[i].Key))
+				require.Equal(t, tt.expectedLog, res, string(kvPairs.Pairs[i].Key))
+			}
+		})
+	}
+}
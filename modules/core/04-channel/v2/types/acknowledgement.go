@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
+	"strings"
 
 	proto "github.com/cosmos/gogoproto/proto"
 
@@ -21,16 +22,77 @@ func NewAcknowledgement(appAcknowledgements ...[]byte) Acknowledgement {
 	return Acknowledgement{AppAcknowledgements: appAcknowledgements}
 }
 
-// Validate performs a basic validation of the acknowledgement
+// NewErrorAcknowledgement creates a new Acknowledgement for a failed packet receive containing a single
+// structured error app acknowledgement for the provided error. See NewErrorAppAcknowledgement.
+func NewErrorAcknowledgement(err error) Acknowledgement {
+	return NewAcknowledgement(NewErrorAppAcknowledgement(err))
+}
+
+// NewErrorAppAcknowledgement returns a structured error app acknowledgement for the provided error.
+// The app acknowledgement is the sentinel error acknowledgement followed by the protobuf encoded
+// AcknowledgementError containing the ABCI codespace and code of the error. The error message is
+// omitted as it may be non-deterministic.
+func NewErrorAppAcknowledgement(err error) []byte {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	ackErr := AcknowledgementError{
+		Codespace: codespace,
+		Code:      code,
+	}
+
+	bz, err := proto.Marshal(&ackErr)
+	if err != nil {
+		panic(err)
+	}
+
+	appAck := make([]byte, 0, len(ErrorAcknowledgement)+len(bz))
+	appAck = append(appAck, ErrorAcknowledgement[:]...)
+	return append(appAck, bz...)
+}
+
+// IsErrorAppAcknowledgement returns true if the app acknowledgement is the sentinel error acknowledgement
+// or a structured error app acknowledgement.
+func IsErrorAppAcknowledgement(appAck []byte) bool {
+	return bytes.HasPrefix(appAck, ErrorAcknowledgement[:])
+}
+
+// ParseAcknowledgementError returns the AcknowledgementError contained in a structured error app acknowledgement.
+// It returns false if the app acknowledgement is not an error acknowledgement or if it is the sentinel error
+// acknowledgement without any error information.
+func ParseAcknowledgementError(appAck []byte) (AcknowledgementError, bool) {
+	if !IsErrorAppAcknowledgement(appAck) || len(appAck) == len(ErrorAcknowledgement) {
+		return AcknowledgementError{}, false
+	}
+
+	var ackErr AcknowledgementError
+	if err := proto.Unmarshal(appAck[len(ErrorAcknowledgement):], &ackErr); err != nil {
+		return AcknowledgementError{}, false
+	}
+
+	return ackErr, true
+}
+
+// Validate performs a basic validation of the acknowledgement. An acknowledgement must contain
+// at least one app acknowledgement. If an error acknowledgement is present, it must be the only
+// app acknowledgement as a failed receive is atomic across all payloads of a packet.
 func (ack Acknowledgement) Validate() error {
-	if len(ack.AppAcknowledgements) != 1 {
-		return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgements must be of length one")
+	if len(ack.AppAcknowledgements) == 0 {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgements cannot be empty")
 	}
 
-	for _, ack := range ack.AppAcknowledgements {
-		if len(ack) == 0 {
+	for _, appAck := range ack.AppAcknowledgements {
+		if len(appAck) == 0 {
 			return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgement cannot be empty")
 		}
+
+		if IsErrorAppAcknowledgement(appAck) {
+			if len(ack.AppAcknowledgements) != 1 {
+				return errorsmod.Wrap(ErrInvalidAcknowledgement, "error acknowledgement must be the only app acknowledgement")
+			}
+
+			if err := validateErrorAppAcknowledgement(appAck); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -39,7 +101,7 @@ func (ack Acknowledgement) Validate() error {
 // Success returns true if the acknowledgement is successful
 // it implements the exported.Acknowledgement interface
 func (ack Acknowledgement) Success() bool {
-	return !bytes.Equal(ack.AppAcknowledgements[0], ErrorAcknowledgement[:])
+	return !IsErrorAppAcknowledgement(ack.AppAcknowledgements[0])
 }
 
 // Acknowledgement returns the acknowledgement bytes to implement the acknowledgement interface
@@ -50,3 +112,55 @@ func (ack Acknowledgement) Acknowledgement() []byte {
 	}
 	return bz
 }
+
+// AcknowledgementError returns the AcknowledgementError of a failed acknowledgement. It returns false if the
+// acknowledgement is successful or does not contain any error information.
+func (ack Acknowledgement) AcknowledgementError() (AcknowledgementError, bool) {
+	if len(ack.AppAcknowledgements) == 0 {
+		return AcknowledgementError{}, false
+	}
+
+	return ParseAcknowledgementError(ack.AppAcknowledgements[0])
+}
+
+// Validate performs a basic validation of the AcknowledgementError.
+func (ae AcknowledgementError) Validate() error {
+	if strings.TrimSpace(ae.Codespace) == "" {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "acknowledgement error codespace cannot be empty")
+	}
+
+	if ae.Code == 0 {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "acknowledgement error code cannot be zero")
+	}
+
+	return nil
+}
+
+// validateErrorAppAcknowledgement validates an error app acknowledgement. The sentinel error acknowledgement
+// may only be followed by a valid and canonically encoded AcknowledgementError.
+func validateErrorAppAcknowledgement(appAck []byte) error {
+	if len(appAck) == len(ErrorAcknowledgement) {
+		return nil
+	}
+
+	ackErr, ok := ParseAcknowledgementError(appAck)
+	if !ok {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "failed to unmarshal acknowledgement error")
+	}
+
+	if err := ackErr.Validate(); err != nil {
+		return err
+	}
+
+	// the acknowledgement error must be canonically encoded to ensure a unique acknowledgement commitment
+	bz, err := proto.Marshal(&ackErr)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "failed to marshal acknowledgement error: %v", err)
+	}
+
+	if !bytes.Equal(bz, appAck[len(ErrorAcknowledgement):]) {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "acknowledgement error is not canonically encoded")
+	}
+
+	return nil
+}
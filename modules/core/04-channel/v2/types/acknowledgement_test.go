@@ -1,7 +1,10 @@
 package types_test
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 // Test_ValidateAcknowledgement tests the acknowledgements Validate method
@@ -22,8 +25,48 @@ func (s *TypesTestSuite) Test_ValidateAcknowledgement() {
 			nil,
 		},
 		{
-			"failure: more than one app acknowledgements",
+			"success: valid successful ack with multiple app acknowledgements",
 			types.NewAcknowledgement([]byte("appAck1"), []byte("appAck2")),
+			nil,
+		},
+		{
+			"success: valid structured error ack",
+			types.NewErrorAcknowledgement(types.ErrRouteNotFound),
+			nil,
+		},
+		{
+			"failure: no app acknowledgements",
+			types.NewAcknowledgement(),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: error acknowledgement with other app acknowledgements",
+			types.NewAcknowledgement([]byte("appAck1"), types.ErrorAcknowledgement[:]),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: structured error acknowledgement with other app acknowledgements",
+			types.NewAcknowledgement(types.NewErrorAppAcknowledgement(types.ErrRouteNotFound), []byte("appAck1")),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: structured error acknowledgement cannot be unmarshalled",
+			types.NewAcknowledgement(append(types.ErrorAcknowledgement[:], []byte("invalid")...)),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: structured error acknowledgement with empty codespace",
+			types.NewAcknowledgement(mustMarshalErrorAppAck(s, types.AcknowledgementError{Code: 1})),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: structured error acknowledgement with zero code",
+			types.NewAcknowledgement(mustMarshalErrorAppAck(s, types.AcknowledgementError{Codespace: types.SubModuleName})),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: structured error acknowledgement is not canonically encoded",
+			types.NewAcknowledgement(append(mustMarshalErrorAppAck(s, types.AcknowledgementError{Codespace: types.SubModuleName, Code: 1}), 0x10, 0x01)),
 			types.ErrInvalidAcknowledgement,
 		},
 		{
@@ -50,3 +93,69 @@ func (s *TypesTestSuite) Test_ValidateAcknowledgement() {
 		})
 	}
 }
+
+// Test_AcknowledgementError tests the construction and parsing of structured error acknowledgements
+func (s *TypesTestSuite) Test_AcknowledgementError() {
+	testCases := []struct {
+		name       string
+		ack        types.Acknowledgement
+		expSuccess bool
+		expFound   bool
+		expAckErr  types.AcknowledgementError
+	}{
+		{
+			"successful ack",
+			types.NewAcknowledgement([]byte("appAck1")),
+			true,
+			false,
+			types.AcknowledgementError{},
+		},
+		{
+			"sentinel error ack",
+			types.NewAcknowledgement(types.ErrorAcknowledgement[:]),
+			false,
+			false,
+			types.AcknowledgementError{},
+		},
+		{
+			"structured error ack",
+			types.NewErrorAcknowledgement(types.ErrRouteNotFound),
+			false,
+			true,
+			types.AcknowledgementError{Codespace: types.SubModuleName, Code: types.ErrRouteNotFound.ABCICode()},
+		},
+		{
+			"structured error ack with wrapped error",
+			types.NewErrorAcknowledgement(errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "wrapped")),
+			false,
+			true,
+			types.AcknowledgementError{Codespace: ibcerrors.ErrInvalidRequest.Codespace(), Code: ibcerrors.ErrInvalidRequest.ABCICode()},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expSuccess, tc.ack.Success())
+			s.Require().Equal(!tc.expSuccess, types.IsErrorAppAcknowledgement(tc.ack.AppAcknowledgements[0]))
+
+			ackErr, found := tc.ack.AcknowledgementError()
+			s.Require().Equal(tc.expFound, found)
+			s.Require().Equal(tc.expAckErr, ackErr)
+
+			if found {
+				s.Require().NoError(ackErr.Validate())
+			}
+		})
+	}
+}
+
+func mustMarshalErrorAppAck(s *TypesTestSuite, ackErr types.AcknowledgementError) []byte {
+	s.T().Helper()
+
+	bz, err := ackErr.Marshal()
+	s.Require().NoError(err)
+
+	return append(types.ErrorAcknowledgement[:], bz...)
+}
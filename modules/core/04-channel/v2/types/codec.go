@@ -15,7 +15,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRecvPacket{},
 		&MsgTimeout{},
 		&MsgAcknowledgement{},
+		&MsgPruneAcknowledgements{},
+		&MsgRecvPackets{},
+		&MsgTimeouts{},
+		&MsgAcknowledgements{},
+		&MsgExpireAsyncPacket{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+	msgservice.RegisterMsgServiceDesc(registry, &_ParamsMsg_serviceDesc)
 }
@@ -16,4 +16,13 @@ var (
 	ErrTimeoutNotReached        = errorsmod.Register(SubModuleName, 10, "timeout not reached")
 	ErrAcknowledgementExists    = errorsmod.Register(SubModuleName, 11, "acknowledgement for packet already exists")
 	ErrNoOpMsg                  = errorsmod.Register(SubModuleName, 12, "message is redundant, no-op will be performed")
+	ErrRouteNotFound            = errorsmod.Register(SubModuleName, 13, "route not found")
+	ErrInvalidParams            = errorsmod.Register(SubModuleName, 14, "invalid channel v2 params")
+	ErrPortNotAllowed           = errorsmod.Register(SubModuleName, 15, "port not allowed for client")
+	ErrAsyncPacketExpired       = errorsmod.Register(SubModuleName, 16, "async packet acknowledgement deadline elapsed")
+	ErrAsyncPacketNotExpired    = errorsmod.Register(SubModuleName, 17, "async packet acknowledgement deadline not elapsed")
+	ErrPacketSequenceOutOfOrder = errorsmod.Register(SubModuleName, 18, "packet sequence is out of order")
+	ErrOrderedStreamClosed      = errorsmod.Register(SubModuleName, 19, "ordered stream is closed")
+	ErrPacketNotReceived        = errorsmod.Register(SubModuleName, 20, "packet has not been received")
+	ErrOrderedStreamTimedOut    = errorsmod.Register(SubModuleName, 21, "packet timed out, the ordered stream has been closed")
 )
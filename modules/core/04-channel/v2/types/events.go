@@ -7,22 +7,100 @@ import (
 )
 
 // IBC Eureka core events
+//
+// NOTE: the untyped packet events are deprecated and emitted alongside the typed events defined in
+// ibc/core/channel/v2/events.proto, and will be removed in a future release.
 const (
-	EventTypeSendPacket        = "send_packet"
-	EventTypeRecvPacket        = "recv_packet"
-	EventTypeTimeoutPacket     = "timeout_packet"
-	EventTypeAcknowledgePacket = "acknowledge_packet"
-	EventTypeWriteAck          = "write_acknowledgement"
-
-	AttributeKeySrcClient        = "packet_source_client"
-	AttributeKeyDstClient        = "packet_dest_client"
-	AttributeKeySequence         = "packet_sequence"
-	AttributeKeyTimeoutTimestamp = "packet_timeout_timestamp"
-	AttributeKeyEncodedPacketHex = "encoded_packet_hex"
-	AttributeKeyEncodedAckHex    = "encoded_acknowledgement_hex"
+	EventTypeSendPacket         = "send_packet"
+	EventTypeRecvPacket         = "recv_packet"
+	EventTypeTimeoutPacket      = "timeout_packet"
+	EventTypeAcknowledgePacket  = "acknowledge_packet"
+	EventTypeWriteAck           = "write_acknowledgement"
+	EventTypeCloseOrderedStream = "close_ordered_stream"
+
+	AttributeKeySrcClient         = "packet_source_client"
+	AttributeKeyDstClient         = "packet_dest_client"
+	AttributeKeySequence          = "packet_sequence"
+	AttributeKeyTimeoutTimestamp  = "packet_timeout_timestamp"
+	AttributeKeyEncodedPacketHex  = "encoded_packet_hex"
+	AttributeKeyEncodedAckHex     = "encoded_acknowledgement_hex"
+	AttributeKeyAckErrorCodespace = "acknowledgement_error_codespace"
+	AttributeKeyAckErrorCode      = "acknowledgement_error_code"
+	AttributeKeyClientID          = "client_id"
+	AttributeKeyPortID            = "port_id"
 )
 
 // IBC Eureka core events vars
 var (
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
+
+// NewEventSendPacket creates a new EventSendPacket instance for the given packet.
+func NewEventSendPacket(packet Packet) *EventSendPacket {
+	return &EventSendPacket{
+		SourceClient:      packet.SourceClient,
+		DestinationClient: packet.DestinationClient,
+		Sequence:          packet.Sequence,
+		TimeoutTimestamp:  packet.TimeoutTimestamp,
+		Payloads:          packet.Payloads,
+	}
+}
+
+// NewEventRecvPacket creates a new EventRecvPacket instance for the given packet.
+func NewEventRecvPacket(packet Packet) *EventRecvPacket {
+	return &EventRecvPacket{
+		SourceClient:      packet.SourceClient,
+		DestinationClient: packet.DestinationClient,
+		Sequence:          packet.Sequence,
+		TimeoutTimestamp:  packet.TimeoutTimestamp,
+		Payloads:          packet.Payloads,
+	}
+}
+
+// NewEventWriteAck creates a new EventWriteAck instance for the given packet and acknowledgement.
+func NewEventWriteAck(packet Packet, ack Acknowledgement) *EventWriteAck {
+	return &EventWriteAck{
+		SourceClient:         packet.SourceClient,
+		DestinationClient:    packet.DestinationClient,
+		Sequence:             packet.Sequence,
+		TimeoutTimestamp:     packet.TimeoutTimestamp,
+		Payloads:             packet.Payloads,
+		Acknowledgement:      ack,
+		AcknowledgementError: acknowledgementErrorOrNil(ack),
+	}
+}
+
+// NewEventAcknowledgePacket creates a new EventAcknowledgePacket instance for the given packet and acknowledgement.
+func NewEventAcknowledgePacket(packet Packet, ack Acknowledgement) *EventAcknowledgePacket {
+	return &EventAcknowledgePacket{
+		SourceClient:         packet.SourceClient,
+		DestinationClient:    packet.DestinationClient,
+		Sequence:             packet.Sequence,
+		TimeoutTimestamp:     packet.TimeoutTimestamp,
+		Payloads:             packet.Payloads,
+		Acknowledgement:      ack,
+		AcknowledgementError: acknowledgementErrorOrNil(ack),
+	}
+}
+
+// NewEventTimeoutPacket creates a new EventTimeoutPacket instance for the given packet.
+func NewEventTimeoutPacket(packet Packet) *EventTimeoutPacket {
+	return &EventTimeoutPacket{
+		SourceClient:      packet.SourceClient,
+		DestinationClient: packet.DestinationClient,
+		Sequence:          packet.Sequence,
+		TimeoutTimestamp:  packet.TimeoutTimestamp,
+		Payloads:          packet.Payloads,
+	}
+}
+
+// acknowledgementErrorOrNil returns the error information of a structured error acknowledgement,
+// or nil if the acknowledgement does not contain any error information.
+func acknowledgementErrorOrNil(ack Acknowledgement) *AcknowledgementError {
+	ackErr, ok := ack.AcknowledgementError()
+	if !ok {
+		return nil
+	}
+
+	return &ackErr
+}
@@ -0,0 +1,22 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
+)
+
+// TestNewEventWriteAck tests that the error information of structured error acknowledgements is set on the typed event.
+func (s *TypesTestSuite) TestNewEventWriteAck() {
+	packet := types.NewPacket(1, ibctesting.FirstClientID, ibctesting.SecondClientID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+
+	ack := types.NewAcknowledgement([]byte("appAck1"))
+	event := types.NewEventWriteAck(packet, ack)
+	s.Require().Equal(packet.Payloads, event.Payloads)
+	s.Require().Equal(ack, event.Acknowledgement)
+	s.Require().Nil(event.AcknowledgementError)
+
+	ack = types.NewErrorAcknowledgement(types.ErrRouteNotFound)
+	event = types.NewEventWriteAck(packet, ack)
+	s.Require().Equal(&types.AcknowledgementError{Codespace: types.SubModuleName, Code: types.ErrRouteNotFound.ABCICode()}, event.AcknowledgementError)
+}
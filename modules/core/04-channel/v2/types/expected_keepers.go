@@ -12,6 +12,10 @@ type ClientKeeper interface {
 	VerifyMembership(ctx context.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error
 	// VerifyNonMembership retrieves the light client module for the clientID and verifies the absence of a given key at a specified height.
 	VerifyNonMembership(ctx context.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path) error
+	// VerifyMembershipBatch retrieves the light client module for the clientID and verifies a single proof of the existence of each key-value pair at a specified height.
+	VerifyMembershipBatch(ctx context.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error
+	// VerifyNonMembershipBatch retrieves the light client module for the clientID and verifies a single proof of the absence of each of the given keys at a specified height.
+	VerifyNonMembershipBatch(ctx context.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path) error
 	// GetClientStatus returns the status of a client given the client ID
 	GetClientStatus(ctx context.Context, clientID string) exported.Status
 	// GetClientLatestHeight returns the latest height of a client given the client ID
@@ -25,6 +29,8 @@ type ClientKeeper interface {
 	GetClientConsensusState(ctx context.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	// GetClientCounterparty returns the counterpartyInfo given a clientID
 	GetClientCounterparty(ctx context.Context, clientID string) (clienttypes.CounterpartyInfo, bool)
+	// IsCounterpartyPending returns true if the counterparty registration of the client is pending confirmation
+	IsCounterpartyPending(ctx context.Context, clientID string) bool
 	// GetAllGenesisClients returns all the clients in state with their client ids returned as IdentifiedClientState
 	GetAllGenesisClients(ctx context.Context) clienttypes.IdentifiedClientStates
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
@@ -37,26 +38,131 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.ClientId, ps.Sequence)
 }
 
+// NewAsyncPacket creates a new AsyncPacket instance.
+func NewAsyncPacket(clientID string, sequence uint64, packet Packet) AsyncPacket {
+	return AsyncPacket{
+		ClientId: clientID,
+		Sequence: sequence,
+		Packet:   packet,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (ap AsyncPacket) Validate() error {
+	if err := validateGenFields(ap.ClientId, ap.Sequence); err != nil {
+		return err
+	}
+	if ap.Packet.DestinationClient != ap.ClientId || ap.Packet.Sequence != ap.Sequence {
+		return fmt.Errorf("packet destination client (%s) and sequence (%d) do not match async packet client (%s) and sequence (%d)",
+			ap.Packet.DestinationClient, ap.Packet.Sequence, ap.ClientId, ap.Sequence)
+	}
+	return ap.Packet.ValidateBasic()
+}
+
+// NewSendPacketLifecycle creates a new SendPacketLifecycle instance.
+func NewSendPacketLifecycle(clientID string, sequence uint64, lifecycle PacketLifecycle) SendPacketLifecycle {
+	return SendPacketLifecycle{
+		ClientId:  clientID,
+		Sequence:  sequence,
+		Lifecycle: lifecycle,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (spl SendPacketLifecycle) Validate() error {
+	if err := validateGenFields(spl.ClientId, spl.Sequence); err != nil {
+		return err
+	}
+	if spl.Lifecycle.Status == PacketLifecycleStatus_Unknown {
+		return errors.New("packet lifecycle status cannot be unspecified")
+	}
+	return nil
+}
+
+// NewOrderedStreamState creates a new OrderedStreamState instance.
+func NewOrderedStreamState(clientID, portID string, nextSequenceRecv, nextSequenceAck uint64, closed bool) OrderedStreamState {
+	return OrderedStreamState{
+		ClientId:         clientID,
+		PortId:           portID,
+		NextSequenceRecv: nextSequenceRecv,
+		NextSequenceAck:  nextSequenceAck,
+		Closed:           closed,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (oss OrderedStreamState) Validate() error {
+	if err := validateGenFields(oss.ClientId, oss.NextSequenceRecv); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(oss.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if oss.NextSequenceAck == 0 {
+		return errors.New("next sequence ack cannot be 0")
+	}
+	return nil
+}
+
+// NewClientProofHeight creates a new ClientProofHeight instance.
+func NewClientProofHeight(clientID string, proofHeight clienttypes.Height) ClientProofHeight {
+	return ClientProofHeight{
+		ClientId:    clientID,
+		ProofHeight: proofHeight,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (cph ClientProofHeight) Validate() error {
+	if err := host.ClientIdentifierValidator(cph.ClientId); err != nil {
+		return fmt.Errorf("invalid client Id: %w", err)
+	}
+	if cph.ProofHeight.IsZero() {
+		return errors.New("proof height cannot be zero")
+	}
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	acks, receipts, commitments []PacketState,
-	sendSeqs []PacketSequence,
+	sendSeqs, pruningSeqs []PacketSequence,
+	asyncPackets []AsyncPacket,
+	orderedStreamStates []OrderedStreamState,
+	archivedPackets []Packet,
+	recvProofHeights []ClientProofHeight,
+	sendPacketLifecycles []SendPacketLifecycle,
+	params Params,
 ) GenesisState {
 	return GenesisState{
-		Acknowledgements: acks,
-		Receipts:         receipts,
-		Commitments:      commitments,
-		SendSequences:    sendSeqs,
+		Acknowledgements:     acks,
+		Receipts:             receipts,
+		Commitments:          commitments,
+		SendSequences:        sendSeqs,
+		PruningSequences:     pruningSeqs,
+		AsyncPackets:         asyncPackets,
+		OrderedStreamStates:  orderedStreamStates,
+		ArchivedPackets:      archivedPackets,
+		RecvProofHeights:     recvProofHeights,
+		SendPacketLifecycles: sendPacketLifecycles,
+		Params:               params,
 	}
 }
 
 // DefaultGenesisState returns the ibc channel v2 submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Acknowledgements: []PacketState{},
-		Receipts:         []PacketState{},
-		Commitments:      []PacketState{},
-		SendSequences:    []PacketSequence{},
+		Acknowledgements:     []PacketState{},
+		Receipts:             []PacketState{},
+		Commitments:          []PacketState{},
+		SendSequences:        []PacketSequence{},
+		PruningSequences:     []PacketSequence{},
+		AsyncPackets:         []AsyncPacket{},
+		OrderedStreamStates:  []OrderedStreamState{},
+		ArchivedPackets:      []Packet{},
+		RecvProofHeights:     []ClientProofHeight{},
+		SendPacketLifecycles: []SendPacketLifecycle{},
+		Params:               DefaultParams(),
 	}
 }
 
@@ -92,7 +198,43 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	for i, ps := range gs.PruningSequences {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence %v index %d: %w", ps, i, err)
+		}
+	}
+
+	for i, ap := range gs.AsyncPackets {
+		if err := ap.Validate(); err != nil {
+			return fmt.Errorf("invalid async packet %v index %d: %w", ap, i, err)
+		}
+	}
+
+	for i, oss := range gs.OrderedStreamStates {
+		if err := oss.Validate(); err != nil {
+			return fmt.Errorf("invalid ordered stream state %v index %d: %w", oss, i, err)
+		}
+	}
+
+	for i, packet := range gs.ArchivedPackets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid archived packet %v index %d: %w", packet, i, err)
+		}
+	}
+
+	for i, rph := range gs.RecvProofHeights {
+		if err := rph.Validate(); err != nil {
+			return fmt.Errorf("invalid recv proof height %v index %d: %w", rph, i, err)
+		}
+	}
+
+	for i, spl := range gs.SendPacketLifecycles {
+		if err := spl.Validate(); err != nil {
+			return fmt.Errorf("invalid send packet lifecycle %v index %d: %w", spl, i, err)
+		}
+	}
+
+	return gs.Params.Validate()
 }
 
 func validateGenFields(clientID string, sequence uint64) error {
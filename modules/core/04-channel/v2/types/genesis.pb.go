@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +30,21 @@ type GenesisState struct {
 	Commitments      []PacketState    `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments"`
 	Receipts         []PacketState    `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts"`
 	SendSequences    []PacketSequence `protobuf:"bytes,5,rep,name=send_sequences,json=sendSequences,proto3" json:"send_sequences"`
+	// the pruning sequence start of each client, i.e. the next sequence of packet acknowledgements
+	// and receipts to be pruned.
+	PruningSequences []PacketSequence `protobuf:"bytes,6,rep,name=pruning_sequences,json=pruningSequences,proto3" json:"pruning_sequences"`
+	// the packets awaiting an asynchronous acknowledgement to be written by the application.
+	AsyncPackets []AsyncPacket `protobuf:"bytes,7,rep,name=async_packets,json=asyncPackets,proto3" json:"async_packets"`
+	// the channel v2 parameters.
+	Params Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	// the state of the ordered streams.
+	OrderedStreamStates []OrderedStreamState `protobuf:"bytes,9,rep,name=ordered_stream_states,json=orderedStreamStates,proto3" json:"ordered_stream_states"`
+	// the archived packets awaiting their acknowledgement or timeout.
+	ArchivedPackets []Packet `protobuf:"bytes,10,rep,name=archived_packets,json=archivedPackets,proto3" json:"archived_packets"`
+	// the latest proof height at which a packet commitment was verified when receiving a packet over each client.
+	RecvProofHeights []ClientProofHeight `protobuf:"bytes,11,rep,name=recv_proof_heights,json=recvProofHeights,proto3" json:"recv_proof_heights"`
+	// the lifecycle records of the packets sent by this chain.
+	SendPacketLifecycles []SendPacketLifecycle `protobuf:"bytes,12,rep,name=send_packet_lifecycles,json=sendPacketLifecycles,proto3" json:"send_packet_lifecycles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +108,55 @@ func (m *GenesisState) GetSendSequences() []PacketSequence {
 	return nil
 }
 
+func (m *GenesisState) GetPruningSequences() []PacketSequence {
+	if m != nil {
+		return m.PruningSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAsyncPackets() []AsyncPacket {
+	if m != nil {
+		return m.AsyncPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetOrderedStreamStates() []OrderedStreamState {
+	if m != nil {
+		return m.OrderedStreamStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedPackets() []Packet {
+	if m != nil {
+		return m.ArchivedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecvProofHeights() []ClientProofHeight {
+	if m != nil {
+		return m.RecvProofHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendPacketLifecycles() []SendPacketLifecycle {
+	if m != nil {
+		return m.SendPacketLifecycles
+	}
+	return nil
+}
+
 // PacketState defines the generic type necessary to retrieve and store
 // packet commitments, acknowledgements, and receipts.
 // Caller is responsible for knowing the context necessary to interpret this
@@ -193,39 +258,346 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// ClientProofHeight defines the genesis type necessary to retrieve and store the latest proof height
+// at which a packet commitment was verified when receiving a packet over a client.
+type ClientProofHeight struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the latest proof height.
+	ProofHeight types.Height `protobuf:"bytes,2,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *ClientProofHeight) Reset()         { *m = ClientProofHeight{} }
+func (m *ClientProofHeight) String() string { return proto.CompactTextString(m) }
+func (*ClientProofHeight) ProtoMessage()    {}
+func (*ClientProofHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d374f126f051c3, []int{3}
+}
+func (m *ClientProofHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientProofHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientProofHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientProofHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientProofHeight.Merge(m, src)
+}
+func (m *ClientProofHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientProofHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientProofHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientProofHeight proto.InternalMessageInfo
+
+func (m *ClientProofHeight) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientProofHeight) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
+// SendPacketLifecycle defines the genesis type necessary to retrieve and store the lifecycle
+// record of a packet sent over a client.
+type SendPacketLifecycle struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// packet sequence.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the lifecycle record of the packet.
+	Lifecycle PacketLifecycle `protobuf:"bytes,3,opt,name=lifecycle,proto3" json:"lifecycle"`
+}
+
+func (m *SendPacketLifecycle) Reset()         { *m = SendPacketLifecycle{} }
+func (m *SendPacketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*SendPacketLifecycle) ProtoMessage()    {}
+func (*SendPacketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d374f126f051c3, []int{4}
+}
+func (m *SendPacketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendPacketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendPacketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendPacketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendPacketLifecycle.Merge(m, src)
+}
+func (m *SendPacketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendPacketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendPacketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendPacketLifecycle proto.InternalMessageInfo
+
+func (m *SendPacketLifecycle) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *SendPacketLifecycle) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SendPacketLifecycle) GetLifecycle() PacketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return PacketLifecycle{}
+}
+
+// AsyncPacket defines the genesis type necessary to retrieve and store packets for which
+// the receiving application has not yet written an asynchronous acknowledgement.
+type AsyncPacket struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// packet sequence.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// packet awaiting its asynchronous acknowledgement.
+	Packet Packet `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet"`
+	// unix timestamp in seconds after which the packet expires and an error acknowledgement is written.
+	// A zero deadline means the packet does not expire.
+	Deadline uint64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *AsyncPacket) Reset()         { *m = AsyncPacket{} }
+func (m *AsyncPacket) String() string { return proto.CompactTextString(m) }
+func (*AsyncPacket) ProtoMessage()    {}
+func (*AsyncPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d374f126f051c3, []int{5}
+}
+func (m *AsyncPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AsyncPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AsyncPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AsyncPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AsyncPacket.Merge(m, src)
+}
+func (m *AsyncPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *AsyncPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_AsyncPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AsyncPacket proto.InternalMessageInfo
+
+func (m *AsyncPacket) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *AsyncPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AsyncPacket) GetPacket() Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return Packet{}
+}
+
+func (m *AsyncPacket) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+// OrderedStreamState defines the state of an ordered stream, identified by a (client, port) pair.
+type OrderedStreamState struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// port unique identifier.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the sequence of the next packet to be received over the stream.
+	NextSequenceRecv uint64 `protobuf:"varint,3,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	// the sequence of the next packet sent over the stream to be acknowledged.
+	NextSequenceAck uint64 `protobuf:"varint,4,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty"`
+	// whether the stream was closed following the timeout of a packet sent over it.
+	Closed bool `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+}
+
+func (m *OrderedStreamState) Reset()         { *m = OrderedStreamState{} }
+func (m *OrderedStreamState) String() string { return proto.CompactTextString(m) }
+func (*OrderedStreamState) ProtoMessage()    {}
+func (*OrderedStreamState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d374f126f051c3, []int{6}
+}
+func (m *OrderedStreamState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderedStreamState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderedStreamState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderedStreamState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderedStreamState.Merge(m, src)
+}
+func (m *OrderedStreamState) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderedStreamState) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderedStreamState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderedStreamState proto.InternalMessageInfo
+
+func (m *OrderedStreamState) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OrderedStreamState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *OrderedStreamState) GetNextSequenceRecv() uint64 {
+	if m != nil {
+		return m.NextSequenceRecv
+	}
+	return 0
+}
+
+func (m *OrderedStreamState) GetNextSequenceAck() uint64 {
+	if m != nil {
+		return m.NextSequenceAck
+	}
+	return 0
+}
+
+func (m *OrderedStreamState) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v2.GenesisState")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v2.PacketState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v2.PacketSequence")
+	proto.RegisterType((*ClientProofHeight)(nil), "ibc.core.channel.v2.ClientProofHeight")
+	proto.RegisterType((*SendPacketLifecycle)(nil), "ibc.core.channel.v2.SendPacketLifecycle")
+	proto.RegisterType((*AsyncPacket)(nil), "ibc.core.channel.v2.AsyncPacket")
+	proto.RegisterType((*OrderedStreamState)(nil), "ibc.core.channel.v2.OrderedStreamState")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/genesis.proto", fileDescriptor_b5d374f126f051c3) }
 
 var fileDescriptor_b5d374f126f051c3 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x4f, 0xfa, 0x40,
-	0x18, 0xc6, 0x5b, 0xe8, 0xff, 0x1f, 0x38, 0x90, 0x98, 0xd3, 0xa1, 0xc1, 0xa4, 0x54, 0x5c, 0xba,
-	0xd0, 0x33, 0xe8, 0xa2, 0x71, 0x62, 0x51, 0x36, 0x52, 0x13, 0x07, 0x17, 0x6c, 0xaf, 0x6f, 0xca,
-	0x85, 0xf6, 0x0e, 0xb9, 0x03, 0xe3, 0x37, 0x70, 0xf4, 0x23, 0xf8, 0x59, 0x9c, 0x18, 0x19, 0x9d,
-	0x8c, 0x81, 0x2f, 0x62, 0x68, 0x81, 0x60, 0x34, 0x26, 0xb8, 0xbd, 0xf7, 0xde, 0xf3, 0xfc, 0x9e,
-	0x77, 0x78, 0xd0, 0x21, 0x0b, 0x28, 0xa1, 0x62, 0x08, 0x84, 0xf6, 0x7c, 0xce, 0x21, 0x26, 0xe3,
-	0x26, 0x89, 0x80, 0x83, 0x64, 0xd2, 0x1d, 0x0c, 0x85, 0x12, 0x78, 0x8f, 0x05, 0xd4, 0x5d, 0x48,
-	0xdc, 0xa5, 0xc4, 0x1d, 0x37, 0xab, 0xfb, 0x91, 0x88, 0x44, 0xfa, 0x4f, 0x16, 0x53, 0x26, 0xad,
-	0xbf, 0xe6, 0x50, 0xf9, 0x32, 0x33, 0x5f, 0x2b, 0x5f, 0x01, 0xf6, 0xd0, 0xae, 0x4f, 0xfb, 0x5c,
-	0x3c, 0xc4, 0x10, 0x46, 0x90, 0x00, 0x57, 0xd2, 0xcc, 0xd9, 0x79, 0xa7, 0xd4, 0xb4, 0xdd, 0x1f,
-	0xb0, 0x6e, 0xc7, 0xa7, 0x7d, 0x50, 0xa9, 0xb7, 0x65, 0x4c, 0xde, 0x6b, 0x9a, 0xf7, 0xcd, 0x8f,
-	0xaf, 0x50, 0x89, 0x8a, 0x24, 0x61, 0x2a, 0xc3, 0xe5, 0xb7, 0xc2, 0x6d, 0x5a, 0x71, 0x0b, 0x15,
-	0x86, 0x40, 0x81, 0x0d, 0x94, 0x34, 0x8d, 0xad, 0x30, 0x6b, 0x1f, 0xee, 0xa0, 0x8a, 0x04, 0x1e,
-	0x76, 0x25, 0xdc, 0x8f, 0x80, 0x53, 0x90, 0xe6, 0xbf, 0x94, 0x74, 0xf4, 0x1b, 0x69, 0xa9, 0x5d,
-	0xc2, 0x76, 0x16, 0x80, 0xd5, 0x4e, 0xd6, 0xef, 0x50, 0x69, 0x23, 0x10, 0x1f, 0xa0, 0x22, 0x8d,
-	0x19, 0x70, 0xd5, 0x65, 0xa1, 0xa9, 0xdb, 0xba, 0x53, 0xf4, 0x0a, 0xd9, 0xa2, 0x1d, 0xe2, 0x2a,
-	0x2a, 0xac, 0x82, 0xcd, 0x9c, 0xad, 0x3b, 0x86, 0xb7, 0x7e, 0x63, 0x8c, 0x8c, 0xd0, 0x57, 0xbe,
-	0x99, 0xb7, 0x75, 0xa7, 0xec, 0xa5, 0xf3, 0xb9, 0xf1, 0xf4, 0x52, 0xd3, 0xea, 0x6d, 0x54, 0xf9,
-	0x7a, 0xc8, 0x9f, 0x43, 0x5a, 0x37, 0x93, 0x99, 0xa5, 0x4f, 0x67, 0x96, 0xfe, 0x31, 0xb3, 0xf4,
-	0xe7, 0xb9, 0xa5, 0x4d, 0xe7, 0x96, 0xf6, 0x36, 0xb7, 0xb4, 0xdb, 0x8b, 0x88, 0xa9, 0xde, 0x28,
-	0x70, 0xa9, 0x48, 0x08, 0x15, 0x32, 0x11, 0x92, 0xb0, 0x80, 0x36, 0x22, 0x41, 0xc6, 0x67, 0x24,
-	0x11, 0xe1, 0x28, 0x06, 0x99, 0x35, 0xef, 0xf8, 0xb4, 0xb1, 0x51, 0x3e, 0xf5, 0x38, 0x00, 0x19,
-	0xfc, 0x4f, 0x0b, 0x75, 0xf2, 0x19, 0x00, 0x00, 0xff, 0xff, 0xee, 0xb6, 0xa0, 0xcd, 0xa0, 0x02,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x12, 0x42, 0x32, 0x0e, 0x10, 0x06, 0x96, 0xb5, 0x82, 0x14, 0xbc, 0xd9, 0xd5,
+	0x6e, 0xb4, 0x5a, 0xec, 0x25, 0xbb, 0x17, 0x56, 0x7b, 0x01, 0x0e, 0x05, 0x15, 0xa9, 0xc8, 0x48,
+	0x1c, 0xb8, 0xb8, 0xce, 0xf8, 0xe1, 0x58, 0xb1, 0x3d, 0xae, 0xc7, 0x71, 0xcb, 0x37, 0xe8, 0xb1,
+	0x97, 0x4a, 0xbd, 0x54, 0xea, 0x07, 0xe9, 0x07, 0xe0, 0xc8, 0xb1, 0xa7, 0xaa, 0x82, 0x2f, 0x52,
+	0x79, 0x3c, 0x4e, 0x4c, 0x93, 0x46, 0x85, 0x9b, 0x67, 0xe6, 0xff, 0x7e, 0xf3, 0x9f, 0x79, 0xef,
+	0x79, 0xd0, 0x2f, 0x6e, 0x9f, 0xe8, 0x84, 0x46, 0xa0, 0x93, 0x81, 0x15, 0x04, 0xe0, 0xe9, 0x49,
+	0x4f, 0x77, 0x20, 0x00, 0xe6, 0x32, 0x2d, 0x8c, 0x68, 0x4c, 0xf1, 0xba, 0xdb, 0x27, 0x5a, 0x2a,
+	0xd1, 0x84, 0x44, 0x4b, 0x7a, 0xad, 0x0d, 0x87, 0x3a, 0x94, 0xaf, 0xeb, 0xe9, 0x57, 0x26, 0x6d,
+	0xa9, 0xb3, 0x68, 0xa1, 0x45, 0x86, 0x10, 0xcf, 0x57, 0x44, 0x96, 0x2f, 0xb6, 0x6b, 0x6d, 0x4f,
+	0x14, 0x9e, 0x0b, 0x41, 0xac, 0x27, 0xbb, 0xe2, 0x2b, 0x13, 0x74, 0xde, 0x2e, 0xa1, 0xc6, 0x93,
+	0xcc, 0xe1, 0x59, 0x6c, 0xc5, 0x80, 0x0d, 0xd4, 0xb4, 0xc8, 0x30, 0xa0, 0x2f, 0x3d, 0xb0, 0x1d,
+	0xf0, 0x21, 0x88, 0x99, 0xb2, 0xa0, 0x96, 0xbb, 0x72, 0x4f, 0xd5, 0x66, 0x78, 0xd7, 0x4e, 0xb9,
+	0x21, 0x1e, 0x7b, 0x50, 0xb9, 0xfe, 0xbc, 0x5d, 0x32, 0xa6, 0xe2, 0xf1, 0x11, 0x92, 0x09, 0xf5,
+	0x7d, 0x37, 0xce, 0x70, 0xe5, 0x07, 0xe1, 0x8a, 0xa1, 0xf8, 0x00, 0xd5, 0x22, 0x20, 0xe0, 0x86,
+	0x31, 0x53, 0x2a, 0x0f, 0xc2, 0x8c, 0xe3, 0xf0, 0x29, 0x5a, 0x61, 0x10, 0xd8, 0x26, 0x83, 0x17,
+	0x23, 0x08, 0x08, 0x30, 0x65, 0x91, 0x93, 0x7e, 0x9d, 0x47, 0x12, 0x5a, 0x01, 0x5b, 0x4e, 0x01,
+	0xf9, 0x1c, 0xc3, 0xe7, 0x68, 0x2d, 0x8c, 0x46, 0x81, 0x1b, 0x38, 0x05, 0x68, 0xf5, 0xa1, 0xd0,
+	0xa6, 0x60, 0x4c, 0xb8, 0x4f, 0xd1, 0xb2, 0xc5, 0xae, 0x02, 0x62, 0x66, 0x59, 0x67, 0xca, 0xd2,
+	0x9c, 0x23, 0xef, 0xa7, 0xca, 0x0c, 0x2c, 0x80, 0x0d, 0x6b, 0x32, 0xc5, 0xf0, 0x1e, 0xaa, 0x66,
+	0xa5, 0xa1, 0xd4, 0x54, 0xa9, 0x2b, 0xf7, 0xb6, 0xbe, 0xe3, 0x2c, 0x95, 0x08, 0x80, 0x08, 0xc0,
+	0x16, 0xfa, 0x89, 0x46, 0x36, 0x44, 0x60, 0x9b, 0x2c, 0x8e, 0xc0, 0xf2, 0x4d, 0x96, 0xde, 0x2c,
+	0x53, 0xea, 0xdc, 0xcf, 0x1f, 0x33, 0x49, 0xcf, 0xb2, 0x88, 0x33, 0x1e, 0x50, 0xcc, 0xc4, 0x3a,
+	0x9d, 0x5a, 0x61, 0xf8, 0x04, 0x35, 0xad, 0x88, 0x0c, 0xdc, 0x04, 0xec, 0xf1, 0x69, 0x91, 0x5a,
+	0x9e, 0xe3, 0xb3, 0x70, 0xd0, 0xd5, 0x3c, 0x34, 0x3f, 0xeb, 0x05, 0xc2, 0x11, 0x90, 0xc4, 0x0c,
+	0x23, 0x4a, 0x2f, 0xcd, 0x01, 0xb8, 0xce, 0x20, 0x66, 0x8a, 0xcc, 0x79, 0xbf, 0xcf, 0xe4, 0x1d,
+	0xf2, 0xa6, 0x38, 0x4d, 0xf5, 0x47, 0x5c, 0x9e, 0x27, 0x25, 0xe5, 0x14, 0xa6, 0x19, 0xb6, 0xd1,
+	0x26, 0x2f, 0x9f, 0xcc, 0xa5, 0xe9, 0xb9, 0x97, 0x40, 0xae, 0x88, 0x07, 0x4c, 0x69, 0x70, 0x7e,
+	0x77, 0x26, 0xff, 0x0c, 0x02, 0xe1, 0xee, 0x24, 0x0f, 0x10, 0x3b, 0x6c, 0xb0, 0xe9, 0x25, 0xd6,
+	0x79, 0x8e, 0xe4, 0x42, 0x0d, 0xe3, 0x2d, 0x54, 0xcf, 0xda, 0xd6, 0x74, 0x6d, 0x45, 0x52, 0xa5,
+	0x6e, 0xdd, 0xa8, 0x65, 0x13, 0xc7, 0x36, 0x6e, 0xa1, 0x5a, 0x5e, 0x76, 0xca, 0x82, 0x2a, 0x75,
+	0x2b, 0xc6, 0x78, 0x8c, 0x31, 0xaa, 0xd8, 0x56, 0x6c, 0x29, 0x65, 0x55, 0xea, 0x36, 0x0c, 0xfe,
+	0xfd, 0x5f, 0xe5, 0xf5, 0x87, 0xed, 0x52, 0xe7, 0x18, 0xad, 0xdc, 0x2f, 0xc3, 0x47, 0x6f, 0xd2,
+	0x19, 0xa1, 0xb5, 0xa9, 0xfb, 0x9b, 0x4f, 0x3b, 0x44, 0x8d, 0x62, 0x6e, 0x38, 0x51, 0xee, 0xb5,
+	0x0a, 0x57, 0xc7, 0x95, 0x5a, 0xb2, 0xab, 0xdd, 0x4b, 0x87, 0x1c, 0x4e, 0x76, 0xe8, 0xbc, 0x93,
+	0xd0, 0xfa, 0x8c, 0x7b, 0x7d, 0xfc, 0x65, 0x1d, 0xa1, 0xfa, 0x38, 0x9d, 0xfc, 0xc6, 0xe4, 0xde,
+	0x6f, 0x73, 0xaa, 0xef, 0xdb, 0x4c, 0x4e, 0x82, 0x3b, 0xef, 0x25, 0x24, 0x17, 0x1a, 0xf2, 0xf1,
+	0x96, 0x78, 0xd7, 0xa6, 0x08, 0xe1, 0xe7, 0x07, 0xba, 0x41, 0x04, 0xa4, 0x58, 0x1b, 0x2c, 0xdb,
+	0x73, 0x03, 0x50, 0x2a, 0x19, 0x36, 0x1f, 0x77, 0x3e, 0x4a, 0x08, 0x4f, 0x37, 0xe8, 0x7c, 0x9b,
+	0x3f, 0xa3, 0xa5, 0x90, 0x46, 0x7c, 0x69, 0x81, 0x2f, 0x55, 0xd3, 0xe1, 0xb1, 0x8d, 0xff, 0x42,
+	0x38, 0x80, 0x57, 0xf1, 0xf8, 0xdf, 0x67, 0xa6, 0x3d, 0xc3, 0xfd, 0x56, 0x8c, 0x66, 0xba, 0x92,
+	0x57, 0x98, 0x01, 0x24, 0xc1, 0x7f, 0xa2, 0xb5, 0xfb, 0x6a, 0x8b, 0x0c, 0x85, 0xbf, 0xd5, 0xa2,
+	0x78, 0x9f, 0x0c, 0xf1, 0x26, 0xaa, 0x12, 0x8f, 0x32, 0xb0, 0x95, 0x45, 0x55, 0xea, 0xd6, 0x0c,
+	0x31, 0x3a, 0x38, 0xbf, 0xbe, 0x6d, 0x4b, 0x37, 0xb7, 0x6d, 0xe9, 0xcb, 0x6d, 0x5b, 0x7a, 0x73,
+	0xd7, 0x2e, 0xdd, 0xdc, 0xb5, 0x4b, 0x9f, 0xee, 0xda, 0xa5, 0x8b, 0xff, 0x1d, 0x37, 0x1e, 0x8c,
+	0xfa, 0x1a, 0xa1, 0xbe, 0x4e, 0x28, 0xf3, 0x29, 0xd3, 0xdd, 0x3e, 0xd9, 0x71, 0xa8, 0x9e, 0xec,
+	0xe9, 0x3e, 0xb5, 0x47, 0x1e, 0xb0, 0xec, 0x41, 0xfc, 0xfb, 0xdf, 0x9d, 0xc2, 0xab, 0x19, 0x5f,
+	0x85, 0xc0, 0xfa, 0x55, 0xfe, 0x28, 0xfe, 0xf3, 0x75, 0x00, 0x46, 0x34, 0x9c, 0xb3, 0xc9, 0x07,
 	0x00, 0x00,
 }
 
@@ -249,10 +621,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.SendSequences) > 0 {
-		for iNdEx := len(m.SendSequences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SendPacketLifecycles) > 0 {
+		for iNdEx := len(m.SendPacketLifecycles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SendSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SendPacketLifecycles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -260,13 +632,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.Receipts) > 0 {
-		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RecvProofHeights) > 0 {
+		for iNdEx := len(m.RecvProofHeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecvProofHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -274,13 +646,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ArchivedPackets) > 0 {
+		for iNdEx := len(m.ArchivedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ArchivedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -288,13 +660,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Acknowledgements) > 0 {
-		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OrderedStreamStates) > 0 {
+		for iNdEx := len(m.OrderedStreamStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OrderedStreamStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -302,46 +674,140 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x4a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PacketState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PacketState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
+	i--
+	dAtA[i] = 0x42
+	if len(m.AsyncPackets) > 0 {
+		for iNdEx := len(m.AsyncPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AsyncPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PruningSequences) > 0 {
+		for iNdEx := len(m.PruningSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SendSequences) > 0 {
+		for iNdEx := len(m.SendSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
 		copy(dAtA[i:], m.ClientId)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
 		i--
@@ -385,6 +851,198 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientProofHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientProofHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientProofHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendPacketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendPacketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendPacketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AsyncPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AsyncPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AsyncPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderedStreamState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderedStreamState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderedStreamState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -426,10 +1084,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *PacketState) Size() (n int) {
+	if len(m.PruningSequences) > 0 {
+		for _, e := range m.PruningSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AsyncPackets) > 0 {
+		for _, e := range m.AsyncPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OrderedStreamStates) > 0 {
+		for _, e := range m.OrderedStreamStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedPackets) > 0 {
+		for _, e := range m.ArchivedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecvProofHeights) > 0 {
+		for _, e := range m.RecvProofHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendPacketLifecycles) > 0 {
+		for _, e := range m.SendPacketLifecycles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -446,32 +1142,771 @@ func (m *PacketState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *PacketSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ClientProofHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SendPacketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Lifecycle.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AsyncPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovGenesis(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *OrderedStreamState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSequenceRecv))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSequenceAck))
+	}
+	if m.Closed {
+		n += 2
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, PacketState{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendSequences = append(m.SendSequences, PacketSequence{})
+			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequences = append(m.PruningSequences, PacketSequence{})
+			if err := m.PruningSequences[len(m.PruningSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsyncPackets = append(m.AsyncPackets, AsyncPacket{})
+			if err := m.AsyncPackets[len(m.AsyncPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderedStreamStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderedStreamStates = append(m.OrderedStreamStates, OrderedStreamState{})
+			if err := m.OrderedStreamStates[len(m.OrderedStreamStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedPackets = append(m.ArchivedPackets, Packet{})
+			if err := m.ArchivedPackets[len(m.ArchivedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvProofHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvProofHeights = append(m.RecvProofHeights, ClientProofHeight{})
+			if err := m.RecvProofHeights[len(m.RecvProofHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPacketLifecycles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPacketLifecycles = append(m.SendPacketLifecycles, SendPacketLifecycle{})
+			if err := m.SendPacketLifecycles[len(m.SendPacketLifecycles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *PacketSequence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovGenesis(uint64(m.Sequence))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ClientProofHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -494,17 +1929,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientProofHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientProofHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -514,29 +1949,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
-			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -563,16 +1996,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, PacketState{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendPacketLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendPacketLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendPacketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -582,29 +2064,46 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, PacketState{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -631,8 +2130,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendSequences = append(m.SendSequences, PacketSequence{})
-			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -657,7 +2155,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketState) Unmarshal(dAtA []byte) error {
+func (m *AsyncPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -680,10 +2178,10 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketState: wiretype end group for non-group")
+			return fmt.Errorf("proto: AsyncPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AsyncPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -739,9 +2237,9 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -751,26 +2249,44 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -792,7 +2308,7 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketSequence) Unmarshal(dAtA []byte) error {
+func (m *OrderedStreamState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,10 +2331,10 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderedStreamState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderedStreamState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -854,10 +2370,42 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
 			}
-			m.Sequence = 0
+			m.NextSequenceRecv = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -867,11 +2415,50 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
+			}
+			m.NextSequenceAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
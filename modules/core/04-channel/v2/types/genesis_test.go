@@ -6,8 +6,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -28,6 +30,13 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.PacketState{types.NewPacketState(ibctesting.SecondChannelID, 1, []byte(""))},
 				[]types.PacketState{types.NewPacketState(ibctesting.FirstChannelID, 1, []byte("commit_hash"))},
 				[]types.PacketSequence{types.NewPacketSequence(ibctesting.SecondChannelID, 1)},
+				[]types.PacketSequence{types.NewPacketSequence(ibctesting.FirstChannelID, 1)},
+				[]types.AsyncPacket{types.NewAsyncPacket(ibctesting.SecondChannelID, 1, types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)))},
+				[]types.OrderedStreamState{types.NewOrderedStreamState(ibctesting.SecondChannelID, mockv2.ModuleNameB, 2, 1, false)},
+				[]types.Packet{types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))},
+				[]types.ClientProofHeight{types.NewClientProofHeight(ibctesting.SecondChannelID, clienttypes.NewHeight(0, 10))},
+				[]types.SendPacketLifecycle{types.NewSendPacketLifecycle(ibctesting.FirstChannelID, 1, types.NewPacketLifecycle(types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100))},
+				types.DefaultParams(),
 			),
 			nil,
 		},
@@ -58,6 +67,76 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			errors.New("sequence cannot be 0"),
 		},
+		{
+			"invalid async packet: packet does not match",
+			types.GenesisState{
+				AsyncPackets: []types.AsyncPacket{
+					types.NewAsyncPacket(ibctesting.FirstChannelID, 1, types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))),
+				},
+			},
+			errors.New("do not match async packet client"),
+		},
+		{
+			"invalid async packet: invalid packet",
+			types.GenesisState{
+				AsyncPackets: []types.AsyncPacket{
+					types.NewAsyncPacket(ibctesting.SecondChannelID, 1, types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, 100)),
+				},
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"invalid pruning seq",
+			types.GenesisState{
+				PruningSequences: []types.PacketSequence{
+					types.NewPacketSequence(ibctesting.FirstChannelID, 0),
+				},
+			},
+			errors.New("sequence cannot be 0"),
+		},
+		{
+			"invalid ordered stream state",
+			types.GenesisState{
+				OrderedStreamStates: []types.OrderedStreamState{
+					types.NewOrderedStreamState(ibctesting.FirstChannelID, mockv2.ModuleNameB, 1, 0, false),
+				},
+			},
+			errors.New("next sequence ack cannot be 0"),
+		},
+		{
+			"invalid archived packet",
+			types.GenesisState{
+				ArchivedPackets: []types.Packet{
+					types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, 100),
+				},
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"invalid recv proof height",
+			types.GenesisState{
+				RecvProofHeights: []types.ClientProofHeight{
+					types.NewClientProofHeight(ibctesting.FirstChannelID, clienttypes.ZeroHeight()),
+				},
+			},
+			errors.New("proof height cannot be zero"),
+		},
+		{
+			"invalid send packet lifecycle",
+			types.GenesisState{
+				SendPacketLifecycles: []types.SendPacketLifecycle{
+					types.NewSendPacketLifecycle(ibctesting.FirstChannelID, 1, types.PacketLifecycle{}),
+				},
+			},
+			errors.New("packet lifecycle status cannot be unspecified"),
+		},
+		{
+			"invalid params",
+			types.GenesisState{
+				Params: types.NewParams(0, types.DefaultMaxPayloadValueBytes, types.DefaultMaxPacketBytes),
+			},
+			types.ErrInvalidParams,
+		},
 	}
 
 	for _, tc := range testCases {
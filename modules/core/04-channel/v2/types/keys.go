@@ -1,6 +1,13 @@
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// SubModuleName defines the channelv2 module name.
@@ -8,6 +15,35 @@ const (
 
 	// KeyAsyncPacket defines the key to store the async packet.
 	KeyAsyncPacket = "async_packet"
+
+	// KeyAsyncPacketDeadline defines the key to store the deadline of an async packet.
+	KeyAsyncPacketDeadline = "async_packet_deadline"
+
+	// KeyAsyncPacketExpiryQueue defines the key prefix of the async packets ordered by deadline.
+	KeyAsyncPacketExpiryQueue = "async_packet_expiry_queue"
+
+	// KeyOrderedStream defines the key to store the state of an ordered stream.
+	KeyOrderedStream = "ordered_stream"
+
+	// KeyPacketArchive defines the key prefix to archive the packets awaiting their acknowledgement or timeout.
+	KeyPacketArchive = "packet_archive"
+
+	// KeyPacketLifecycle defines the key prefix to record the height and time at which the packets awaiting
+	// their acknowledgement or timeout were sent.
+	KeyPacketLifecycle = "packet_lifecycle"
+
+	// KeyPendingCommitmentCount defines the key prefix to store the number of packets sent over a client
+	// which await their acknowledgement or timeout.
+	KeyPendingCommitmentCount = "pending_commitment_count"
+
+	// KeyPruningSequenceStart defines the key to store the pruning sequence start of a client.
+	KeyPruningSequenceStart = "pruning_sequence_start"
+
+	// KeyRecvProofHeight defines the key to store the latest proof height at which a packet was received over a client.
+	KeyRecvProofHeight = "recv_proof_height"
+
+	// ParamsKey defines the key to store the params in the keeper.
+	ParamsKey = "channelV2Params"
 )
 
 // AsyncPacketKey returns the key under which the packet is stored
@@ -15,3 +51,96 @@ const (
 func AsyncPacketKey(clientID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%d", KeyAsyncPacket, clientID, sequence))
 }
+
+// AsyncPacketPrefixKey returns the key prefix under which the packets awaiting an asynchronous
+// acknowledgement are stored for the given client.
+func AsyncPacketPrefixKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyAsyncPacket, clientID))
+}
+
+// AsyncPacketDeadlineKey returns the key under which the deadline of a packet awaiting an
+// asynchronous acknowledgement is stored.
+func AsyncPacketDeadlineKey(clientID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", KeyAsyncPacketDeadline, clientID, sequence))
+}
+
+// AsyncPacketExpiryQueuePrefixKey returns the key prefix under which async packets whose deadline
+// is the given unix timestamp are queued. Deadlines are big endian encoded such that iterating the
+// queue yields packets in the order in which they expire.
+func AsyncPacketExpiryQueuePrefixKey(deadline uint64) []byte {
+	return append([]byte(KeyAsyncPacketExpiryQueue+"/"), sdk.Uint64ToBigEndian(deadline)...)
+}
+
+// AsyncPacketExpiryQueueKey returns the key under which an async packet is queued for expiry.
+func AsyncPacketExpiryQueueKey(deadline uint64, clientID string, sequence uint64) []byte {
+	return append(AsyncPacketExpiryQueuePrefixKey(deadline), []byte(fmt.Sprintf("/%s/%d", clientID, sequence))...)
+}
+
+// ParseAsyncPacketExpiryQueueKey parses an async packet expiry queue key, returning the deadline,
+// client identifier and sequence of the queued packet.
+func ParseAsyncPacketExpiryQueueKey(key []byte) (uint64, string, uint64, error) {
+	prefix := []byte(KeyAsyncPacketExpiryQueue + "/")
+	if !bytes.HasPrefix(key, prefix) || len(key) < len(prefix)+8 {
+		return 0, "", 0, fmt.Errorf("invalid async packet expiry queue key: %x", key)
+	}
+
+	deadline := sdk.BigEndianToUint64(key[len(prefix) : len(prefix)+8])
+
+	parts := strings.Split(string(key[len(prefix)+8:]), "/")
+	if len(parts) != 3 || parts[0] != "" {
+		return 0, "", 0, fmt.Errorf("invalid async packet expiry queue key: %x", key)
+	}
+
+	sequence, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return 0, "", 0, fmt.Errorf("invalid async packet expiry queue key sequence: %w", err)
+	}
+
+	return deadline, parts[1], sequence, nil
+}
+
+// OrderedStreamKey returns the key under which the state of the ordered stream
+// identified by the given client and port is stored.
+func OrderedStreamKey(clientID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", KeyOrderedStream, clientID, portID))
+}
+
+// PacketArchiveKey returns the key under which a packet sent over the given client is archived.
+func PacketArchiveKey(clientID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", KeyPacketArchive, clientID, sequence))
+}
+
+// PacketArchivePrefixKey returns the key prefix under which the packets sent over the given client are archived.
+func PacketArchivePrefixKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPacketArchive, clientID))
+}
+
+// SendPacketLifecycleKey returns the key under which the lifecycle of a packet sent over the given client is recorded
+// until the packet is acknowledged or timed out.
+func SendPacketLifecycleKey(clientID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/send/%s/%d", KeyPacketLifecycle, clientID, sequence))
+}
+
+// SendPacketLifecyclePrefixKey returns the key prefix under which the lifecycles of the packets sent over the given
+// client are recorded.
+func SendPacketLifecyclePrefixKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/send/%s/", KeyPacketLifecycle, clientID))
+}
+
+// PendingCommitmentCountKey returns the key under which the number of packets sent over the given client
+// which await their acknowledgement or timeout is stored.
+func PendingCommitmentCountKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPendingCommitmentCount, clientID))
+}
+
+// PruningSequenceStartKey returns the key under which the next sequence of packet
+// acknowledgements and receipts to be pruned is stored for the given client.
+func PruningSequenceStartKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPruningSequenceStart, clientID))
+}
+
+// RecvProofHeightKey returns the key under which the latest proof height at which a packet
+// commitment was verified when receiving a packet is stored for the given client.
+func RecvProofHeightKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyRecvProofHeight, clientID))
+}
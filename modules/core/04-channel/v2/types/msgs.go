@@ -1,8 +1,6 @@
 package types
 
 import (
-	"time"
-
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,8 +11,6 @@ import (
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
-const MaxTimeoutDelta time.Duration = 24 * time.Hour
-
 var (
 	_ sdk.Msg              = (*MsgSendPacket)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendPacket)(nil)
@@ -27,6 +23,24 @@ var (
 
 	_ sdk.Msg              = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
+
+	_ sdk.Msg              = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+
+	_ sdk.Msg              = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+
+	_ sdk.Msg              = (*MsgTimeouts)(nil)
+	_ sdk.HasValidateBasic = (*MsgTimeouts)(nil)
+
+	_ sdk.Msg              = (*MsgAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
+
+	_ sdk.Msg              = (*MsgExpireAsyncPacket)(nil)
+	_ sdk.HasValidateBasic = (*MsgExpireAsyncPacket)(nil)
+
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
 // NewMsgSendPacket creates a new MsgSendPacket instance.
@@ -49,8 +63,8 @@ func (msg *MsgSendPacket) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidTimeout, "timeout must not be 0")
 	}
 
-	if len(msg.Payloads) != 1 {
-		return errorsmod.Wrapf(ErrInvalidPayload, "payloads must be of length 1, got %d instead", len(msg.Payloads))
+	if len(msg.Payloads) == 0 {
+		return errorsmod.Wrap(ErrInvalidPayload, "payloads must not be empty")
 	}
 
 	for _, pd := range msg.Payloads {
@@ -112,6 +126,12 @@ func (msg *MsgAcknowledgement) ValidateBasic() error {
 		return err
 	}
 
+	// a successful acknowledgement must contain one app acknowledgement for each payload in the packet,
+	// while an error acknowledgement only contains the sentinel error acknowledgement.
+	if msg.Acknowledgement.Success() && len(msg.Acknowledgement.AppAcknowledgements) != len(msg.Packet.Payloads) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "length of app acknowledgements %d does not match length of packet payloads %d", len(msg.Acknowledgement.AppAcknowledgements), len(msg.Packet.Payloads))
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
@@ -143,3 +163,211 @@ func (msg *MsgTimeout) ValidateBasic() error {
 
 	return msg.Packet.ValidateBasic()
 }
+
+// NewMsgPruneAcknowledgements creates a new MsgPruneAcknowledgements instance.
+func NewMsgPruneAcknowledgements(clientID string, limit uint64, proofCommitmentAbsence []byte, proofHeight clienttypes.Height, signer string) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		ClientId:               clientID,
+		Limit:                  limit,
+		ProofCommitmentAbsence: proofCommitmentAbsence,
+		ProofHeight:            proofHeight,
+		Signer:                 signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneAcknowledgements.
+func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.Limit == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "number of sequences to prune must be greater than 0")
+	}
+
+	if len(msg.ProofCommitmentAbsence) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "proof of commitment absence can not be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgRecvPackets creates a new MsgRecvPackets instance.
+func NewMsgRecvPackets(packets []Packet, proofsCommitment [][]byte, proofHeight clienttypes.Height, signer string) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofsCommitment: proofsCommitment,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRecvPackets.
+func (msg *MsgRecvPackets) ValidateBasic() error {
+	if err := validateBatchProofs(len(msg.Packets), len(msg.ProofsCommitment)); err != nil {
+		return err
+	}
+
+	for i, recvMsg := range msg.RecvPacketMsgs() {
+		if err := recvMsg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// RecvPacketMsgs returns a MsgRecvPacket for each packet in the batch. If a single combined proof was
+// provided, it is used as the proof of every packet.
+func (msg *MsgRecvPackets) RecvPacketMsgs() []*MsgRecvPacket {
+	msgs := make([]*MsgRecvPacket, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		msgs[i] = NewMsgRecvPacket(packet, batchProof(msg.ProofsCommitment, i), msg.ProofHeight, msg.Signer)
+	}
+	return msgs
+}
+
+// NewMsgTimeouts creates a new MsgTimeouts instance.
+func NewMsgTimeouts(packets []Packet, proofsUnreceived [][]byte, proofHeight clienttypes.Height, signer string) *MsgTimeouts {
+	return &MsgTimeouts{
+		Packets:          packets,
+		ProofsUnreceived: proofsUnreceived,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgTimeouts.
+func (msg *MsgTimeouts) ValidateBasic() error {
+	if err := validateBatchProofs(len(msg.Packets), len(msg.ProofsUnreceived)); err != nil {
+		return err
+	}
+
+	for i, timeoutMsg := range msg.TimeoutMsgs() {
+		if err := timeoutMsg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// TimeoutMsgs returns a MsgTimeout for each packet in the batch. If a single combined proof was
+// provided, it is used as the proof of every packet.
+func (msg *MsgTimeouts) TimeoutMsgs() []*MsgTimeout {
+	msgs := make([]*MsgTimeout, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		msgs[i] = NewMsgTimeout(packet, batchProof(msg.ProofsUnreceived, i), msg.ProofHeight, msg.Signer)
+	}
+	return msgs
+}
+
+// NewMsgAcknowledgements creates a new MsgAcknowledgements instance.
+func NewMsgAcknowledgements(packets []Packet, acknowledgements []Acknowledgement, proofsAcked [][]byte, proofHeight clienttypes.Height, signer string) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acknowledgements,
+		ProofsAcked:      proofsAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgAcknowledgements.
+func (msg *MsgAcknowledgements) ValidateBasic() error {
+	if err := validateBatchProofs(len(msg.Packets), len(msg.ProofsAcked)); err != nil {
+		return err
+	}
+
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements %d does not match number of packets %d", len(msg.Acknowledgements), len(msg.Packets))
+	}
+
+	for i, ackMsg := range msg.AcknowledgementMsgs() {
+		if err := ackMsg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// AcknowledgementMsgs returns a MsgAcknowledgement for each packet in the batch. If a single combined
+// proof was provided, it is used as the proof of every packet.
+func (msg *MsgAcknowledgements) AcknowledgementMsgs() []*MsgAcknowledgement {
+	msgs := make([]*MsgAcknowledgement, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		msgs[i] = NewMsgAcknowledgement(packet, msg.Acknowledgements[i], batchProof(msg.ProofsAcked, i), msg.ProofHeight, msg.Signer)
+	}
+	return msgs
+}
+
+// validateBatchProofs ensures that a batch of packets is provided together with either a single
+// combined proof or one proof for each packet.
+func validateBatchProofs(numPackets, numProofs int) error {
+	if numPackets == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets must not be empty")
+	}
+
+	if numProofs != 1 && numProofs != numPackets {
+		return errorsmod.Wrapf(commitmenttypesv1.ErrInvalidProof, "expected either a single combined proof or %d proofs, got %d", numPackets, numProofs)
+	}
+
+	return nil
+}
+
+// batchProof returns the proof to be used for the packet at the provided index of a batch.
+func batchProof(proofs [][]byte, index int) []byte {
+	if len(proofs) == 1 {
+		return proofs[0]
+	}
+	return proofs[index]
+}
+
+// NewMsgExpireAsyncPacket creates a new MsgExpireAsyncPacket instance.
+func NewMsgExpireAsyncPacket(clientID string, sequence uint64, signer string) *MsgExpireAsyncPacket {
+	return &MsgExpireAsyncPacket{
+		ClientId: clientID,
+		Sequence: sequence,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgExpireAsyncPacket.
+func (msg *MsgExpireAsyncPacket) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateParams.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.Params.Validate()
+}
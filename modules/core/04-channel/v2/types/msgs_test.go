@@ -61,11 +61,10 @@ func (s *TypesTestSuite) TestMsgSendPacketValidateBasic() {
 			expError: types.ErrInvalidTimeout,
 		},
 		{
-			name: "failure: invalid length for payload",
+			name: "success: multiple payloads",
 			malleate: func() {
-				msg.Payloads = []types.Payload{{}, {}}
+				msg.Payloads = append(msg.Payloads, msg.Payloads[0])
 			},
-			expError: types.ErrInvalidPayload,
 		},
 		{
 			name: "failure: invalid packetdata",
@@ -81,6 +80,13 @@ func (s *TypesTestSuite) TestMsgSendPacketValidateBasic() {
 			},
 			expError: host.ErrInvalidID,
 		},
+		{
+			name: "failure: invalid second payload",
+			malleate: func() {
+				msg.Payloads = append(msg.Payloads, types.Payload{})
+			},
+			expError: host.ErrInvalidID,
+		},
 		{
 			name: "failure: invalid signer",
 			malleate: func() {
@@ -136,11 +142,17 @@ func (s *TypesTestSuite) TestMsgRecvPacketValidateBasic() {
 			expError: commitmenttypes.ErrInvalidProof,
 		},
 		{
-			name: "failure: invalid length for packet payloads",
+			name: "success: multiple payloads",
 			malleate: func() {
-				msg.Packet.Payloads = []types.Payload{{}, {}}
+				msg.Packet.Payloads = append(msg.Packet.Payloads, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
 			},
-			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid second packet payload",
+			malleate: func() {
+				msg.Packet.Payloads = append(msg.Packet.Payloads, types.Payload{})
+			},
+			expError: host.ErrInvalidID,
 		},
 		{
 			name: "failure: invalid signer",
@@ -190,11 +202,25 @@ func (s *TypesTestSuite) TestMsgAcknowledge_ValidateBasic() {
 			expError: commitmenttypes.ErrInvalidProof,
 		},
 		{
-			name: "failure: invalid length for packet payloads",
+			name: "success: multiple payloads with app acknowledgement for each payload",
 			malleate: func() {
-				msg.Packet.Payloads = []types.Payload{{}, {}}
+				msg.Packet.Payloads = append(msg.Packet.Payloads, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+				msg.Acknowledgement = types.NewAcknowledgement([]byte("appAck1"), []byte("appAck2"))
+			},
+		},
+		{
+			name: "success: multiple payloads with error acknowledgement",
+			malleate: func() {
+				msg.Packet.Payloads = append(msg.Packet.Payloads, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+				msg.Acknowledgement = types.NewAcknowledgement(types.ErrorAcknowledgement[:])
 			},
-			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: app acknowledgements length does not match packet payloads length",
+			malleate: func() {
+				msg.Packet.Payloads = append(msg.Packet.Payloads, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
+			},
+			expError: types.ErrInvalidAcknowledgement,
 		},
 		{
 			name: "failure: invalid signer",
@@ -261,11 +287,17 @@ func (s *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 			expError: ibcerrors.ErrInvalidAddress,
 		},
 		{
-			name: "failure: invalid length for packet payloads",
+			name: "success: multiple payloads",
 			malleate: func() {
-				msg.Packet.Payloads = []types.Payload{{}, {}}
+				msg.Packet.Payloads = append(msg.Packet.Payloads, mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA))
 			},
-			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid second packet payload",
+			malleate: func() {
+				msg.Packet.Payloads = append(msg.Packet.Payloads, types.Payload{})
+			},
+			expError: host.ErrInvalidID,
 		},
 		{
 			name: "failure: invalid packet",
@@ -303,3 +335,296 @@ func (s *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 		})
 	}
 }
+
+func (s *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	var msg *types.MsgPruneAcknowledgements
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: invalid client ID",
+			malleate: func() {
+				msg.ClientId = ""
+			},
+			expError: host.ErrInvalidID,
+		},
+		{
+			name: "failure: zero limit",
+			malleate: func() {
+				msg.Limit = 0
+			},
+			expError: ibcerrors.ErrInvalidRequest,
+		},
+		{
+			name: "failure: empty proof",
+			malleate: func() {
+				msg.ProofCommitmentAbsence = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgPruneAcknowledgements(
+				ibctesting.FirstClientID,
+				1,
+				testProof,
+				clienttypes.ZeroHeight(),
+				s.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	var msg *types.MsgRecvPackets
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success: proof for each packet",
+			malleate: func() {},
+		},
+		{
+			name: "success: combined proof",
+			malleate: func() {
+				msg.ProofsCommitment = [][]byte{testProof}
+			},
+		},
+		{
+			name: "failure: no packets",
+			malleate: func() {
+				msg.Packets = nil
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: number of proofs does not match number of packets",
+			malleate: func() {
+				msg.ProofsCommitment = append(msg.ProofsCommitment, testProof)
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: empty proof",
+			malleate: func() {
+				msg.ProofsCommitment[1] = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid packet",
+			malleate: func() {
+				msg.Packets[1].Sequence = 0
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packets := []types.Packet{
+				types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+			}
+			msg = types.NewMsgRecvPackets(packets, [][]byte{testProof, testProof}, s.chainA.GetTimeoutHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	var msg *types.MsgAcknowledgements
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: number of acknowledgements does not match number of packets",
+			malleate: func() {
+				msg.Acknowledgements = msg.Acknowledgements[:1]
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
+		{
+			name: "failure: invalid acknowledgement",
+			malleate: func() {
+				msg.Acknowledgements[1] = types.Acknowledgement{}
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
+		{
+			name: "failure: no proofs",
+			malleate: func() {
+				msg.ProofsAcked = nil
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packets := []types.Packet{
+				types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+			}
+			ack := types.Acknowledgement{AppAcknowledgements: [][]byte{[]byte("appAck1")}}
+			msg = types.NewMsgAcknowledgements(packets, []types.Acknowledgement{ack, ack}, [][]byte{testProof}, s.chainA.GetTimeoutHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgTimeoutsValidateBasic() {
+	var msg *types.MsgTimeouts
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: no packets",
+			malleate: func() {
+				msg.Packets = []types.Packet{}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid packet",
+			malleate: func() {
+				msg.Packets[0].Payloads = nil
+			},
+			expError: types.ErrInvalidPacket,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packets := []types.Packet{
+				types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+			}
+			msg = types.NewMsgTimeouts(packets, [][]byte{testProof}, clienttypes.ZeroHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgExpireAsyncPacketValidateBasic() {
+	var msg *types.MsgExpireAsyncPacket
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: invalid client ID",
+			malleate: func() {
+				msg.ClientId = ""
+			},
+			expError: host.ErrInvalidID,
+		},
+		{
+			name: "failure: zero sequence",
+			malleate: func() {
+				msg.Sequence = 0
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgExpireAsyncPacket(ibctesting.FirstClientID, 1, s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypesv1 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
@@ -33,8 +34,8 @@ func NewPayload(sourcePort, destPort, version, encoding string, value []byte) Pa
 
 // ValidateBasic validates that a Packet satisfies the basic requirements.
 func (p Packet) ValidateBasic() error {
-	if len(p.Payloads) != 1 {
-		return errorsmod.Wrap(ErrInvalidPacket, "payloads must contain exactly one payload")
+	if len(p.Payloads) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "payloads must not be empty")
 	}
 
 	totalPayloadsSize := 0
@@ -85,3 +86,12 @@ func (p Payload) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPacketLifecycle constructs a new packet lifecycle record.
+func NewPacketLifecycle(status PacketLifecycleStatus, height clienttypes.Height, timestamp uint64) PacketLifecycle {
+	return PacketLifecycle{
+		Status:    status,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -59,6 +60,65 @@ func (PacketStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2f814aba9ca97169, []int{0}
 }
 
+// PacketLifecycleStatus defines the stage of its lifecycle that a packet has reached on a chain.
+type PacketLifecycleStatus int32
+
+const (
+	// PACKET_LIFECYCLE_STATUS_UNSPECIFIED indicates that no trace of the packet was found.
+	PacketLifecycleStatus_Unknown PacketLifecycleStatus = 0
+	// PACKET_LIFECYCLE_STATUS_COMMITTED indicates that the packet was sent and awaits its acknowledgement or timeout.
+	PacketLifecycleStatus_Committed PacketLifecycleStatus = 1
+	// PACKET_LIFECYCLE_STATUS_ASYNC_PENDING indicates that the packet was received and awaits the asynchronous
+	// acknowledgement of the receiving application.
+	PacketLifecycleStatus_AsyncPending PacketLifecycleStatus = 2
+	// PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_SUCCESS indicates that the sent packet was acknowledged successfully.
+	PacketLifecycleStatus_AcknowledgedSuccess PacketLifecycleStatus = 3
+	// PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_ERROR indicates that the sent packet was acknowledged with an error.
+	PacketLifecycleStatus_AcknowledgedError PacketLifecycleStatus = 4
+	// PACKET_LIFECYCLE_STATUS_TIMED_OUT indicates that the sent packet timed out.
+	PacketLifecycleStatus_TimedOut PacketLifecycleStatus = 5
+	// PACKET_LIFECYCLE_STATUS_PRUNED indicates that the receipt and acknowledgement of the received packet were pruned.
+	PacketLifecycleStatus_Pruned PacketLifecycleStatus = 6
+	// PACKET_LIFECYCLE_STATUS_RECEIVED indicates that the packet was received and its acknowledgement was written.
+	// The outcome of the acknowledgement is recorded by the sending chain once the acknowledgement is relayed.
+	PacketLifecycleStatus_Received PacketLifecycleStatus = 7
+	// PACKET_LIFECYCLE_STATUS_COMPLETED indicates that the sent packet was acknowledged or timed out, but that its
+	// outcome was not recorded as packet lifecycle tracking was disabled.
+	PacketLifecycleStatus_Completed PacketLifecycleStatus = 8
+)
+
+var PacketLifecycleStatus_name = map[int32]string{
+	0: "PACKET_LIFECYCLE_STATUS_UNSPECIFIED",
+	1: "PACKET_LIFECYCLE_STATUS_COMMITTED",
+	2: "PACKET_LIFECYCLE_STATUS_ASYNC_PENDING",
+	3: "PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_SUCCESS",
+	4: "PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_ERROR",
+	5: "PACKET_LIFECYCLE_STATUS_TIMED_OUT",
+	6: "PACKET_LIFECYCLE_STATUS_PRUNED",
+	7: "PACKET_LIFECYCLE_STATUS_RECEIVED",
+	8: "PACKET_LIFECYCLE_STATUS_COMPLETED",
+}
+
+var PacketLifecycleStatus_value = map[string]int32{
+	"PACKET_LIFECYCLE_STATUS_UNSPECIFIED":          0,
+	"PACKET_LIFECYCLE_STATUS_COMMITTED":            1,
+	"PACKET_LIFECYCLE_STATUS_ASYNC_PENDING":        2,
+	"PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_SUCCESS": 3,
+	"PACKET_LIFECYCLE_STATUS_ACKNOWLEDGED_ERROR":   4,
+	"PACKET_LIFECYCLE_STATUS_TIMED_OUT":            5,
+	"PACKET_LIFECYCLE_STATUS_PRUNED":               6,
+	"PACKET_LIFECYCLE_STATUS_RECEIVED":             7,
+	"PACKET_LIFECYCLE_STATUS_COMPLETED":            8,
+}
+
+func (x PacketLifecycleStatus) String() string {
+	return proto.EnumName(PacketLifecycleStatus_name, int32(x))
+}
+
+func (PacketLifecycleStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2f814aba9ca97169, []int{1}
+}
+
 // Packet defines a type that carries data across different chains through IBC
 type Packet struct {
 	// number corresponds to the order of sends and receives, where a Packet
@@ -230,7 +290,8 @@ func (m *Payload) GetValue() []byte {
 // for each application that received a payload in the same order that the payloads were sent
 // in the packet.
 // If the receive is not successful, the acknowledgement will contain a single app acknowledgment
-// which will be a constant error acknowledgment as defined by the IBC v2 protocol.
+// which will be a constant error acknowledgment as defined by the IBC v2 protocol, optionally
+// followed by the protobuf encoded AcknowledgementError describing the failure.
 type Acknowledgement struct {
 	AppAcknowledgements [][]byte `protobuf:"bytes,1,rep,name=app_acknowledgements,json=appAcknowledgements,proto3" json:"app_acknowledgements,omitempty"`
 }
@@ -275,11 +336,68 @@ func (m *Acknowledgement) GetAppAcknowledgements() [][]byte {
 	return nil
 }
 
+// AcknowledgementError contains the deterministic information of the error which caused a packet receive to fail.
+// The error message is not included as it may be non-deterministic.
+type AcknowledgementError struct {
+	// the ABCI codespace of the error
+	Codespace string `protobuf:"bytes,1,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// the ABCI code of the error
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *AcknowledgementError) Reset()         { *m = AcknowledgementError{} }
+func (m *AcknowledgementError) String() string { return proto.CompactTextString(m) }
+func (*AcknowledgementError) ProtoMessage()    {}
+func (*AcknowledgementError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f814aba9ca97169, []int{3}
+}
+func (m *AcknowledgementError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcknowledgementError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcknowledgementError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcknowledgementError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgementError.Merge(m, src)
+}
+func (m *AcknowledgementError) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcknowledgementError) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgementError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcknowledgementError proto.InternalMessageInfo
+
+func (m *AcknowledgementError) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *AcknowledgementError) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
 // RecvPacketResult speecifies the status of a packet as well as the acknowledgement bytes.
 type RecvPacketResult struct {
 	// status of the packet
 	Status PacketStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ibc.core.channel.v2.PacketStatus" json:"status,omitempty"`
-	// acknowledgement of the packet
+	// acknowledgement of the packet. For failed packet receipts, the acknowledgement may be set to a
+	// structured error acknowledgement to indicate the reason of the failure to the sender.
 	Acknowledgement []byte `protobuf:"bytes,2,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
 }
 
@@ -287,7 +405,7 @@ func (m *RecvPacketResult) Reset()         { *m = RecvPacketResult{} }
 func (m *RecvPacketResult) String() string { return proto.CompactTextString(m) }
 func (*RecvPacketResult) ProtoMessage()    {}
 func (*RecvPacketResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f814aba9ca97169, []int{3}
+	return fileDescriptor_2f814aba9ca97169, []int{4}
 }
 func (m *RecvPacketResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,55 +448,146 @@ func (m *RecvPacketResult) GetAcknowledgement() []byte {
 	return nil
 }
 
+// PacketLifecycle records the latest stage of its lifecycle that a packet has reached on a chain, together with the
+// height and time at which it was reached. The stages reached by the packets sent by a chain are only recorded if
+// packet lifecycle tracking is enabled in the params, otherwise the stage is derived from the packet commitment,
+// receipt and acknowledgement stored by the chain and the height and timestamp are unset.
+type PacketLifecycle struct {
+	// the stage of the lifecycle reached by the packet
+	Status PacketLifecycleStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ibc.core.channel.v2.PacketLifecycleStatus" json:"status,omitempty"`
+	// the height at which the stage was reached
+	Height types.Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+	// the block timestamp (in seconds) at which the stage was reached
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *PacketLifecycle) Reset()         { *m = PacketLifecycle{} }
+func (m *PacketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*PacketLifecycle) ProtoMessage()    {}
+func (*PacketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f814aba9ca97169, []int{5}
+}
+func (m *PacketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketLifecycle.Merge(m, src)
+}
+func (m *PacketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketLifecycle proto.InternalMessageInfo
+
+func (m *PacketLifecycle) GetStatus() PacketLifecycleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketLifecycleStatus_Unknown
+}
+
+func (m *PacketLifecycle) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+func (m *PacketLifecycle) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v2.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterEnum("ibc.core.channel.v2.PacketLifecycleStatus", PacketLifecycleStatus_name, PacketLifecycleStatus_value)
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v2.Packet")
 	proto.RegisterType((*Payload)(nil), "ibc.core.channel.v2.Payload")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v2.Acknowledgement")
+	proto.RegisterType((*AcknowledgementError)(nil), "ibc.core.channel.v2.AcknowledgementError")
 	proto.RegisterType((*RecvPacketResult)(nil), "ibc.core.channel.v2.RecvPacketResult")
+	proto.RegisterType((*PacketLifecycle)(nil), "ibc.core.channel.v2.PacketLifecycle")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/packet.proto", fileDescriptor_2f814aba9ca97169) }
 
 var fileDescriptor_2f814aba9ca97169 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x4d, 0xd2, 0x36, 0xdb, 0x40, 0xdd, 0x6d, 0x91, 0x4c, 0x84, 0x52, 0x13, 0x24,
-	0x08, 0xa0, 0xda, 0x10, 0xb8, 0x54, 0x42, 0x48, 0xa9, 0xeb, 0x4a, 0x15, 0x28, 0x44, 0xeb, 0x04,
-	0x09, 0x2e, 0xd1, 0x66, 0xb3, 0x72, 0xad, 0xda, 0x5e, 0xe3, 0x5d, 0xbb, 0xea, 0x2b, 0xf4, 0xc4,
-	0x0b, 0xf4, 0xc0, 0x99, 0x17, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0xb5, 0xe2, 0x3d, 0x90, 0xd7, 0x6e,
-	0x95, 0x16, 0x38, 0xd9, 0xf3, 0xcf, 0xf7, 0x7b, 0xf4, 0x8f, 0x35, 0xd0, 0xf0, 0xa7, 0xd4, 0xa2,
-	0x3c, 0x61, 0x16, 0x3d, 0x20, 0x51, 0xc4, 0x02, 0x2b, 0xeb, 0x59, 0x31, 0xa1, 0x87, 0x4c, 0x9a,
-	0x71, 0xc2, 0x25, 0x47, 0xeb, 0xfe, 0x94, 0x9a, 0x39, 0x61, 0x96, 0x84, 0x99, 0xf5, 0x5a, 0x1b,
-	0x1e, 0xf7, 0xb8, 0xea, 0x5b, 0xf9, 0x5b, 0x81, 0x76, 0x7e, 0x03, 0xb8, 0x38, 0x54, 0x5e, 0xd4,
-	0x82, 0xcb, 0x82, 0x7d, 0x49, 0x59, 0x44, 0x99, 0x0e, 0x0c, 0xd0, 0xad, 0xe1, 0xeb, 0x1a, 0x3d,
-	0x82, 0x77, 0x04, 0x4f, 0x13, 0xca, 0x26, 0x34, 0xf0, 0x59, 0x24, 0xf5, 0x05, 0x03, 0x74, 0x1b,
-	0xb8, 0x59, 0x88, 0xb6, 0xd2, 0xd0, 0x16, 0x44, 0x33, 0x26, 0xa4, 0x1f, 0x11, 0xe9, 0xf3, 0xe8,
-	0x8a, 0xac, 0x2a, 0x72, 0x6d, 0xae, 0x53, 0xe2, 0xcf, 0xe1, 0x9a, 0xf4, 0x43, 0xc6, 0x53, 0x39,
-	0xc9, 0x9f, 0x42, 0x92, 0x30, 0xd6, 0x6b, 0x6a, 0xb0, 0x56, 0x36, 0x46, 0x57, 0x3a, 0x7a, 0x0b,
-	0x97, 0x63, 0x72, 0x1c, 0x70, 0x32, 0x13, 0x7a, 0xdd, 0xa8, 0x76, 0x57, 0x7a, 0x0f, 0xcc, 0x7f,
-	0xa4, 0x34, 0x87, 0x05, 0xb4, 0x53, 0x3b, 0xfb, 0xb9, 0x59, 0xc1, 0xd7, 0x9e, 0xce, 0x37, 0x00,
-	0x97, 0xca, 0x1e, 0xda, 0x84, 0x2b, 0x65, 0x98, 0x98, 0x27, 0x52, 0x65, 0x6d, 0x60, 0x58, 0x48,
-	0x43, 0x9e, 0x48, 0xf4, 0x14, 0x6a, 0xf3, 0x41, 0x14, 0x55, 0x04, 0x5e, 0x9d, 0xd3, 0x15, 0xaa,
-	0xc3, 0xa5, 0x8c, 0x25, 0xc2, 0xe7, 0x51, 0x19, 0xf4, 0xaa, 0xcc, 0xd7, 0xc9, 0x22, 0xca, 0x67,
-	0x7e, 0xe4, 0xa9, 0x54, 0x0d, 0x7c, 0x5d, 0xa3, 0x0d, 0x58, 0xcf, 0x48, 0x90, 0x32, 0xbd, 0x6e,
-	0x80, 0x6e, 0x13, 0x17, 0x45, 0x67, 0x17, 0xae, 0xf6, 0xe9, 0x61, 0xc4, 0x8f, 0x02, 0x36, 0xf3,
-	0x58, 0x98, 0xef, 0xe8, 0x25, 0xdc, 0x20, 0x71, 0x3c, 0x21, 0x37, 0x65, 0xa1, 0x03, 0xa3, 0xda,
-	0x6d, 0xe2, 0x75, 0x12, 0xc7, 0xb7, 0x1c, 0xa2, 0x73, 0x04, 0x35, 0xcc, 0x68, 0x56, 0xfc, 0x54,
-	0xcc, 0x44, 0x1a, 0x48, 0xb4, 0x0d, 0x17, 0x85, 0x24, 0x32, 0x15, 0x2a, 0xec, 0xdd, 0xde, 0xc3,
-	0xff, 0xec, 0x2e, 0xb7, 0xb8, 0x0a, 0xc4, 0xa5, 0x01, 0x75, 0xe1, 0xea, 0xad, 0xe9, 0x6a, 0x15,
-	0x4d, 0x7c, 0x5b, 0x7e, 0xf6, 0x1d, 0xc0, 0xe6, 0xfc, 0x27, 0xd0, 0x13, 0x78, 0x7f, 0xd8, 0xb7,
-	0xdf, 0x39, 0xa3, 0x89, 0x3b, 0xea, 0x8f, 0xc6, 0xee, 0x64, 0x3c, 0x70, 0x87, 0x8e, 0xbd, 0xbf,
-	0xb7, 0xef, 0xec, 0x6a, 0x95, 0xd6, 0xf2, 0xc9, 0xa9, 0x51, 0x1b, 0x7c, 0x18, 0x38, 0xe8, 0x31,
-	0xbc, 0x77, 0x13, 0x74, 0xc7, 0xb6, 0xed, 0xb8, 0xae, 0x06, 0x5a, 0x2b, 0x27, 0xa7, 0xc6, 0x92,
-	0x9b, 0x52, 0xca, 0x84, 0xf8, 0x9b, 0xdb, 0xeb, 0xef, 0xbf, 0x1f, 0x63, 0x47, 0x5b, 0x28, 0xb8,
-	0x3d, 0xe2, 0x07, 0x69, 0xc2, 0x50, 0x07, 0xae, 0xdf, 0xe4, 0xfa, 0xee, 0xa7, 0x81, 0xad, 0x55,
-	0x5b, 0x8d, 0x93, 0x53, 0xa3, 0xde, 0x17, 0xc7, 0x11, 0xdd, 0xf9, 0x78, 0x76, 0xd1, 0x06, 0xe7,
-	0x17, 0x6d, 0xf0, 0xeb, 0xa2, 0x0d, 0xbe, 0x5e, 0xb6, 0x2b, 0xe7, 0x97, 0xed, 0xca, 0x8f, 0xcb,
-	0x76, 0xe5, 0xf3, 0x1b, 0xcf, 0x97, 0x07, 0xe9, 0xd4, 0xa4, 0x3c, 0xb4, 0x28, 0x17, 0x21, 0x17,
-	0x96, 0x3f, 0xa5, 0x5b, 0x1e, 0xb7, 0xb2, 0x6d, 0x2b, 0xe4, 0xb3, 0x34, 0x60, 0xa2, 0xb8, 0xbf,
-	0x17, 0xaf, 0xb7, 0xe6, 0x4e, 0x50, 0x1e, 0xc7, 0x4c, 0x4c, 0x17, 0xd5, 0x5d, 0xbd, 0xfa, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x4f, 0x60, 0x44, 0x2c, 0xa6, 0x03, 0x00, 0x00,
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xe2, 0x46,
+	0x14, 0xc7, 0xe1, 0x4f, 0x60, 0x42, 0x1a, 0x67, 0x92, 0xa8, 0xd4, 0x5a, 0x11, 0x2f, 0xab, 0xb6,
+	0x34, 0x6d, 0x70, 0xc3, 0xe6, 0xd0, 0x55, 0xab, 0x4a, 0xc4, 0x4c, 0x76, 0xd1, 0x12, 0xb0, 0x06,
+	0xd8, 0x6a, 0x7b, 0x41, 0x66, 0x3c, 0x25, 0xd6, 0x1a, 0x8f, 0x6b, 0x8f, 0x59, 0xe5, 0x2b, 0x70,
+	0xea, 0x17, 0xe0, 0xb0, 0xe7, 0xaa, 0xdf, 0x63, 0x8f, 0x7b, 0xec, 0xa9, 0xaa, 0x12, 0xf5, 0x7b,
+	0x54, 0x1e, 0x3b, 0x84, 0xd0, 0xd0, 0xf6, 0x84, 0xdf, 0x6f, 0x7e, 0xbf, 0xf7, 0xe6, 0xf7, 0xde,
+	0x0c, 0x03, 0x54, 0x7b, 0x44, 0x34, 0xc2, 0x7c, 0xaa, 0x91, 0x4b, 0xd3, 0x75, 0xa9, 0xa3, 0x4d,
+	0xeb, 0x9a, 0x67, 0x92, 0x37, 0x94, 0xd7, 0x3c, 0x9f, 0x71, 0x06, 0xf7, 0xec, 0x11, 0xa9, 0x45,
+	0x8c, 0x5a, 0xc2, 0xa8, 0x4d, 0xeb, 0xca, 0xfe, 0x98, 0x8d, 0x99, 0x58, 0xd7, 0xa2, 0xaf, 0x98,
+	0xaa, 0x1c, 0xde, 0x25, 0x73, 0x6c, 0xea, 0x72, 0x6d, 0x7a, 0x92, 0x7c, 0xc5, 0x84, 0xca, 0x5f,
+	0x12, 0xc8, 0x19, 0x22, 0x39, 0x54, 0x40, 0x3e, 0xa0, 0x3f, 0x87, 0xd4, 0x25, 0xb4, 0x24, 0xa9,
+	0x52, 0x35, 0x83, 0x17, 0x31, 0x7c, 0x02, 0xb6, 0x03, 0x16, 0xfa, 0x84, 0x0e, 0x63, 0x75, 0x69,
+	0x43, 0x95, 0xaa, 0x05, 0x5c, 0x8c, 0x41, 0x5d, 0x60, 0xf0, 0x18, 0x40, 0x8b, 0x06, 0xdc, 0x76,
+	0x4d, 0x6e, 0x33, 0xf7, 0x96, 0x99, 0x16, 0xcc, 0xdd, 0xa5, 0x95, 0x84, 0xfe, 0x25, 0xd8, 0xe5,
+	0xf6, 0x84, 0xb2, 0x90, 0x0f, 0xa3, 0xdf, 0x80, 0x9b, 0x13, 0xaf, 0x94, 0x11, 0x85, 0xe5, 0x64,
+	0xa1, 0x7f, 0x8b, 0xc3, 0xef, 0x41, 0xde, 0x33, 0xaf, 0x1c, 0x66, 0x5a, 0x41, 0x29, 0xab, 0xa6,
+	0xab, 0x5b, 0xf5, 0x47, 0xb5, 0x07, 0xda, 0x50, 0x33, 0x62, 0xd2, 0x59, 0xe6, 0xfd, 0x1f, 0x87,
+	0x29, 0xbc, 0xd0, 0x54, 0xde, 0x49, 0x60, 0x33, 0x59, 0x83, 0x87, 0x60, 0x2b, 0x31, 0xe3, 0x31,
+	0x9f, 0x0b, 0xaf, 0x05, 0x0c, 0x62, 0xc8, 0x60, 0x3e, 0x87, 0x5f, 0x00, 0x79, 0xd9, 0x88, 0x60,
+	0xc5, 0x86, 0x77, 0x96, 0x70, 0x41, 0x2d, 0x81, 0xcd, 0x29, 0xf5, 0x03, 0x9b, 0xb9, 0x89, 0xd1,
+	0xdb, 0x30, 0x6a, 0x27, 0x75, 0x09, 0xb3, 0x6c, 0x77, 0x2c, 0x5c, 0x15, 0xf0, 0x22, 0x86, 0xfb,
+	0x20, 0x3b, 0x35, 0x9d, 0x90, 0x96, 0xb2, 0xaa, 0x54, 0x2d, 0xe2, 0x38, 0xa8, 0x34, 0xc1, 0x4e,
+	0x83, 0xbc, 0x71, 0xd9, 0x5b, 0x87, 0x5a, 0x63, 0x3a, 0x89, 0x7a, 0x74, 0x02, 0xf6, 0x4d, 0xcf,
+	0x1b, 0x9a, 0xf7, 0xe1, 0xa0, 0x24, 0xa9, 0xe9, 0x6a, 0x11, 0xef, 0x99, 0x9e, 0xb7, 0xa2, 0x08,
+	0x2a, 0x2f, 0xc0, 0xfe, 0x0a, 0x86, 0x7c, 0x9f, 0xf9, 0xf0, 0x11, 0x28, 0x10, 0x66, 0xd1, 0xc0,
+	0x33, 0x93, 0xf9, 0x16, 0xf0, 0x1d, 0x00, 0x21, 0xc8, 0x44, 0x81, 0xb0, 0xb9, 0x8d, 0xc5, 0x77,
+	0xe5, 0x2d, 0x90, 0x31, 0x25, 0xd3, 0xf8, 0x78, 0x60, 0x1a, 0x84, 0x0e, 0x87, 0xcf, 0x40, 0x2e,
+	0xe0, 0x26, 0x0f, 0x03, 0x91, 0xe2, 0xa3, 0xfa, 0xe3, 0x35, 0x53, 0x88, 0x24, 0x3d, 0x41, 0xc4,
+	0x89, 0x00, 0x56, 0xc1, 0xce, 0x8a, 0x0f, 0x51, 0xad, 0x88, 0x57, 0xe1, 0xca, 0x6f, 0x12, 0xd8,
+	0x89, 0x53, 0xb4, 0xed, 0x9f, 0x28, 0xb9, 0x22, 0x0e, 0x85, 0x67, 0x2b, 0x85, 0x8f, 0xfe, 0xa5,
+	0xf0, 0x42, 0xb5, 0xb2, 0x83, 0x6f, 0x40, 0xee, 0x92, 0xda, 0xe3, 0xcb, 0xb8, 0xf0, 0x56, 0x5d,
+	0x59, 0xca, 0x11, 0x5f, 0x8a, 0xe9, 0x49, 0xed, 0x85, 0x60, 0x24, 0x07, 0x28, 0xe1, 0x47, 0xcd,
+	0xbb, 0x3b, 0xa3, 0x69, 0x71, 0x46, 0xef, 0x80, 0xa3, 0x5f, 0x25, 0x50, 0x5c, 0xb6, 0x0c, 0x3f,
+	0x07, 0x9f, 0x18, 0x0d, 0xfd, 0x25, 0xea, 0x0f, 0x7b, 0xfd, 0x46, 0x7f, 0xd0, 0x1b, 0x0e, 0x3a,
+	0x3d, 0x03, 0xe9, 0xad, 0xf3, 0x16, 0x6a, 0xca, 0x29, 0x25, 0x3f, 0x9b, 0xab, 0x99, 0x4e, 0xb7,
+	0x83, 0xe0, 0x67, 0xe0, 0xe0, 0x3e, 0xb1, 0x37, 0xd0, 0x75, 0xd4, 0xeb, 0xc9, 0x92, 0xb2, 0x35,
+	0x9b, 0xab, 0x9b, 0xbd, 0x90, 0x10, 0x1a, 0x04, 0xff, 0xe4, 0x9d, 0x37, 0x5a, 0xed, 0x01, 0x46,
+	0xf2, 0x46, 0xcc, 0x3b, 0x37, 0x6d, 0x27, 0xf4, 0x29, 0xac, 0x80, 0xbd, 0xfb, 0xbc, 0x46, 0xef,
+	0x75, 0x47, 0x97, 0xd3, 0x4a, 0x61, 0x36, 0x57, 0xb3, 0x8d, 0xe0, 0xca, 0x25, 0x47, 0xef, 0x32,
+	0xe0, 0xe0, 0xc1, 0x3e, 0xc1, 0x53, 0xf0, 0x24, 0x51, 0xb7, 0x5b, 0xe7, 0x48, 0x7f, 0xad, 0xb7,
+	0xd1, 0xc3, 0x06, 0x44, 0xcd, 0x81, 0x1b, 0x4d, 0xcd, 0x85, 0xa7, 0xe0, 0xf1, 0x3a, 0x95, 0xde,
+	0xbd, 0xb8, 0x68, 0xf5, 0xfb, 0xa8, 0x29, 0x4b, 0xca, 0xf6, 0x6c, 0xae, 0x16, 0x74, 0x36, 0x99,
+	0xd8, 0x9c, 0x53, 0x0b, 0x7e, 0x0b, 0x3e, 0x5d, 0xa7, 0x12, 0x7b, 0x1e, 0x1a, 0xa8, 0xd3, 0x6c,
+	0x75, 0x9e, 0xcb, 0x1b, 0x8a, 0x3c, 0x9b, 0xab, 0x45, 0xb1, 0x77, 0x83, 0xba, 0xe2, 0xfe, 0xb4,
+	0xc0, 0x57, 0x6b, 0xc5, 0xfa, 0xcb, 0x4e, 0xf7, 0x87, 0x36, 0x6a, 0x3e, 0x47, 0xcd, 0x45, 0x37,
+	0xd3, 0xca, 0xc7, 0xb3, 0xb9, 0xba, 0xb7, 0x74, 0x2f, 0xac, 0xdb, 0xce, 0x22, 0x70, 0xf4, 0xbf,
+	0x52, 0x21, 0x8c, 0xbb, 0x58, 0xce, 0x28, 0x07, 0xb3, 0xb9, 0xba, 0xbb, 0x9c, 0x28, 0xbe, 0x5d,
+	0x4f, 0xd7, 0x37, 0xa1, 0xdf, 0xba, 0x40, 0xcd, 0x61, 0x77, 0xd0, 0x97, 0xb3, 0x4a, 0x71, 0x36,
+	0x57, 0xf3, 0xd1, 0xbf, 0x9a, 0xd5, 0x0d, 0x39, 0xac, 0x81, 0xf2, 0x3a, 0x91, 0x81, 0x07, 0x1d,
+	0xd4, 0x94, 0x73, 0x0a, 0x98, 0xcd, 0xd5, 0x9c, 0xe1, 0x87, 0x2e, 0xb5, 0x60, 0x1d, 0xa8, 0xeb,
+	0xf8, 0x18, 0xe9, 0xa8, 0xf5, 0x0a, 0x35, 0xe5, 0xcd, 0xb8, 0x06, 0xa6, 0x84, 0xda, 0x53, 0x6a,
+	0xfd, 0xc7, 0x74, 0x8c, 0x36, 0x8a, 0xa6, 0x93, 0x5f, 0x4c, 0xc7, 0x73, 0x28, 0xa7, 0xd6, 0xd9,
+	0xab, 0xf7, 0xd7, 0x65, 0xe9, 0xc3, 0x75, 0x59, 0xfa, 0xf3, 0xba, 0x2c, 0xfd, 0x72, 0x53, 0x4e,
+	0x7d, 0xb8, 0x29, 0xa7, 0x7e, 0xbf, 0x29, 0xa7, 0x7e, 0xfc, 0x6e, 0x6c, 0xf3, 0xcb, 0x70, 0x54,
+	0x23, 0x6c, 0xa2, 0x11, 0x16, 0x4c, 0x58, 0xa0, 0xd9, 0x23, 0x72, 0x3c, 0x66, 0xda, 0xf4, 0x99,
+	0x36, 0x61, 0x56, 0xe8, 0xd0, 0x20, 0x7e, 0x71, 0xbe, 0x3e, 0x3d, 0x5e, 0x7a, 0xc1, 0xf8, 0x95,
+	0x47, 0x83, 0x51, 0x4e, 0xbc, 0x3a, 0x4f, 0xff, 0x1e, 0x00, 0x5c, 0xe8, 0xd0, 0x1e, 0xe5, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Packet) Marshal() (dAtA []byte, err error) {
@@ -532,6 +741,41 @@ func (m *Acknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AcknowledgementError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcknowledgementError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcknowledgementError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RecvPacketResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -567,6 +811,49 @@ func (m *RecvPacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -651,6 +938,22 @@ func (m *Acknowledgement) Size() (n int) {
 	return n
 }
 
+func (m *AcknowledgementError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	return n
+}
+
 func (m *RecvPacketResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -667,6 +970,23 @@ func (m *RecvPacketResult) Size() (n int) {
 	return n
 }
 
+func (m *PacketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovPacket(uint64(m.Timestamp))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1153,6 +1473,107 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AcknowledgementError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcknowledgementError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcknowledgementError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RecvPacketResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1256,6 +1677,127 @@ func (m *RecvPacketResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketLifecycleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -42,7 +42,29 @@ func TestValidateBasic(t *testing.T) {
 			},
 			types.ErrInvalidPacket,
 		},
-		// TODO: add test cases for multiple payloads when enabled (#7008)
+		{
+			"success, multiple payloads",
+			func() {
+				packet.Payloads = append(packet.Payloads, packet.Payloads[0])
+			},
+			nil,
+		},
+		{
+			"failure: invalid multiple payloads size",
+			func() {
+				// total bytes of all payloads are larger than MaxPayloadsSize
+				packet.Payloads[0].Value = make([]byte, channeltypesv1.MaximumPayloadsSize/2+1)
+				packet.Payloads = append(packet.Payloads, packet.Payloads[0])
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: invalid second payload",
+			func() {
+				packet.Payloads = append(packet.Payloads, types.Payload{})
+			},
+			host.ErrInvalidID,
+		},
 		{
 			"failure: payloads is nil",
 			func() {
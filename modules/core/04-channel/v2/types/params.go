@@ -0,0 +1,195 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+const (
+	// DefaultMaxTimeoutDelta defines the default maximum duration into the future, relative to the
+	// current block time, that a packet timeout timestamp may be set to.
+	DefaultMaxTimeoutDelta = 24 * time.Hour
+
+	// DefaultMaxPayloadValueBytes defines the default maximum size in bytes of a single payload value.
+	DefaultMaxPayloadValueBytes uint64 = 512 * 1024
+
+	// DefaultMaxPacketBytes defines the default maximum size in bytes of an encoded packet.
+	DefaultMaxPacketBytes uint64 = 1024 * 1024
+)
+
+// NewParams creates a new parameter configuration for the channel v2 submodule.
+func NewParams(maxTimeoutDelta time.Duration, maxPayloadValueBytes, maxPacketBytes uint64, clientPortAllowlists ...ClientPortAllowlist) Params {
+	return Params{
+		MaxTimeoutDelta:      maxTimeoutDelta,
+		MaxPayloadValueBytes: maxPayloadValueBytes,
+		MaxPacketBytes:       maxPacketBytes,
+		ClientPortAllowlists: clientPortAllowlists,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the channel v2 submodule.
+// By default no port allowlists are configured, thus every client may be used with any port,
+// and packets awaiting an asynchronous acknowledgement do not expire.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxTimeoutDelta, DefaultMaxPayloadValueBytes, DefaultMaxPacketBytes)
+}
+
+// NewClientPortAllowlist creates a new ClientPortAllowlist instance.
+func NewClientPortAllowlist(clientID string, portIDs ...string) ClientPortAllowlist {
+	return ClientPortAllowlist{
+		ClientId: clientID,
+		PortIds:  portIDs,
+	}
+}
+
+// Validate the params.
+func (p Params) Validate() error {
+	if p.MaxTimeoutDelta <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "max timeout delta must be positive: %s", p.MaxTimeoutDelta)
+	}
+
+	if p.MaxPayloadValueBytes == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max payload value bytes cannot be zero")
+	}
+
+	if p.MaxPacketBytes == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max packet bytes cannot be zero")
+	}
+
+	if p.AsyncAcknowledgementExpiryDelta < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "async acknowledgement expiry delta cannot be negative: %s", p.AsyncAcknowledgementExpiryDelta)
+	}
+
+	if p.MaxPayloadValueBytes > p.MaxPacketBytes {
+		return errorsmod.Wrapf(ErrInvalidParams, "max payload value bytes (%d) cannot exceed max packet bytes (%d)", p.MaxPayloadValueBytes, p.MaxPacketBytes)
+	}
+
+	seenClients := make(map[string]struct{}, len(p.ClientPortAllowlists))
+	for _, allowlist := range p.ClientPortAllowlists {
+		if err := allowlist.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seenClients[allowlist.ClientId]; found {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate port allowlist for client %s", allowlist.ClientId)
+		}
+		seenClients[allowlist.ClientId] = struct{}{}
+	}
+
+	seenOrderedClients := make(map[string]struct{}, len(p.OrderedStreams))
+	for _, stream := range p.OrderedStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seenOrderedClients[stream.ClientId]; found {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate ordered stream for client %s", stream.ClientId)
+		}
+		seenOrderedClients[stream.ClientId] = struct{}{}
+
+		if !p.IsPortAllowed(stream.ClientId, stream.PortId) {
+			return errorsmod.Wrapf(ErrInvalidParams, "ordered stream port %s is not allowed for client %s", stream.PortId, stream.ClientId)
+		}
+	}
+
+	seenStructuredErrorClients := make(map[string]struct{}, len(p.StructuredErrorAcknowledgementClients))
+	for _, clientID := range p.StructuredErrorAcknowledgementClients {
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid client ID in structured error acknowledgement clients: %v", err)
+		}
+
+		if _, found := seenStructuredErrorClients[clientID]; found {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate structured error acknowledgement client %s", clientID)
+		}
+		seenStructuredErrorClients[clientID] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsStructuredErrorAcknowledgementEnabled returns true if the counterparty of the given client has opted in to
+// structured error acknowledgements.
+func (p Params) IsStructuredErrorAcknowledgementEnabled(clientID string) bool {
+	return slices.Contains(p.StructuredErrorAcknowledgementClients, clientID)
+}
+
+// GetOrderedStream returns the ordered stream configured for the given client, if any.
+func (p Params) GetOrderedStream(clientID string) (OrderedStream, bool) {
+	for _, stream := range p.OrderedStreams {
+		if stream.ClientId == clientID {
+			return stream, true
+		}
+	}
+
+	return OrderedStream{}, false
+}
+
+// IsPortAllowed returns true if the given port may be used with the given client.
+// A client without a configured allowlist may be used with any port.
+func (p Params) IsPortAllowed(clientID, portID string) bool {
+	for _, allowlist := range p.ClientPortAllowlists {
+		if allowlist.ClientId != clientID {
+			continue
+		}
+
+		for _, allowedPortID := range allowlist.PortIds {
+			if allowedPortID == portID {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// Validate performs basic validation of the client port allowlist.
+func (a ClientPortAllowlist) Validate() error {
+	if err := host.ClientIdentifierValidator(a.ClientId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid client ID in port allowlist: %v", err)
+	}
+
+	if len(a.PortIds) == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "port allowlist for client %s cannot be empty", a.ClientId)
+	}
+
+	seenPorts := make(map[string]struct{}, len(a.PortIds))
+	for _, portID := range a.PortIds {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid port ID %s in allowlist for client %s: %v", portID, a.ClientId, err)
+		}
+
+		if _, found := seenPorts[portID]; found {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate port ID %s in allowlist for client %s", portID, a.ClientId)
+		}
+		seenPorts[portID] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewOrderedStream creates a new OrderedStream instance.
+func NewOrderedStream(clientID, portID string) OrderedStream {
+	return OrderedStream{
+		ClientId: clientID,
+		PortId:   portID,
+	}
+}
+
+// Validate performs basic validation of the ordered stream.
+func (s OrderedStream) Validate() error {
+	if err := host.ClientIdentifierValidator(s.ClientId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid client ID in ordered stream: %v", err)
+	}
+
+	if err := host.PortIdentifierValidator(s.PortId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid port ID in ordered stream for client %s: %v", s.ClientId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1204 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/channel/v2/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_gogo_protobuf_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of IBC channel v2 parameters.
+type Params struct {
+	// the maximum duration between the block time of the sending chain and the timeout timestamp of a packet.
+	MaxTimeoutDelta time.Duration `protobuf:"bytes,1,opt,name=max_timeout_delta,json=maxTimeoutDelta,proto3,stdduration" json:"max_timeout_delta"`
+	// the maximum size in bytes of the value of a single packet payload.
+	MaxPayloadValueBytes uint64 `protobuf:"varint,2,opt,name=max_payload_value_bytes,json=maxPayloadValueBytes,proto3" json:"max_payload_value_bytes,omitempty"`
+	// the maximum size in bytes of an encoded packet.
+	MaxPacketBytes uint64 `protobuf:"varint,3,opt,name=max_packet_bytes,json=maxPacketBytes,proto3" json:"max_packet_bytes,omitempty"`
+	// the ports which may be used with a client. Clients without an allowlist may be used with any port.
+	ClientPortAllowlists []ClientPortAllowlist `protobuf:"bytes,4,rep,name=client_port_allowlists,json=clientPortAllowlists,proto3" json:"client_port_allowlists"`
+	// the duration after which a packet awaiting an asynchronous acknowledgement expires and an error
+	// acknowledgement is written on behalf of the application. A zero duration disables the expiry.
+	// Applications, such as ICS-20 forwarding, may opt out of the expiry of their asynchronous acknowledgements.
+	AsyncAcknowledgementExpiryDelta time.Duration `protobuf:"bytes,5,opt,name=async_acknowledgement_expiry_delta,json=asyncAcknowledgementExpiryDelta,proto3,stdduration" json:"async_acknowledgement_expiry_delta"`
+	// the (client, port) pairs over which packets are delivered in order.
+	OrderedStreams []OrderedStream `protobuf:"bytes,6,rep,name=ordered_streams,json=orderedStreams,proto3" json:"ordered_streams"`
+	// whether the packets sent by this chain are archived until they are acknowledged or time out, such that
+	// relayers can query the packets from the state of any full node rather than from its transaction index.
+	PacketArchiveEnabled bool `protobuf:"varint,7,opt,name=packet_archive_enabled,json=packetArchiveEnabled,proto3" json:"packet_archive_enabled,omitempty"`
+	// the clients whose counterparty has opted in to structured error acknowledgements. The error acknowledgements of
+	// packets received over any other client only contain the sentinel error acknowledgement, while the codespace and
+	// code of the error are emitted in the write acknowledgement event.
+	StructuredErrorAcknowledgementClients []string `protobuf:"bytes,8,rep,name=structured_error_acknowledgement_clients,json=structuredErrorAcknowledgementClients,proto3" json:"structured_error_acknowledgement_clients,omitempty"`
+	// whether the height and time at which the packets sent by this chain were committed, acknowledged or timed out
+	// are recorded, such that the outcome of the packets is returned by the packet status query. The records are also
+	// used to report the latency between the sending and the acknowledgement of the packets.
+	PacketLifecycleTrackingEnabled bool `protobuf:"varint,9,opt,name=packet_lifecycle_tracking_enabled,json=packetLifecycleTrackingEnabled,proto3" json:"packet_lifecycle_tracking_enabled,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd743a06947191cd, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTimeoutDelta() time.Duration {
+	if m != nil {
+		return m.MaxTimeoutDelta
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPayloadValueBytes() uint64 {
+	if m != nil {
+		return m.MaxPayloadValueBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketBytes
+	}
+	return 0
+}
+
+func (m *Params) GetClientPortAllowlists() []ClientPortAllowlist {
+	if m != nil {
+		return m.ClientPortAllowlists
+	}
+	return nil
+}
+
+func (m *Params) GetAsyncAcknowledgementExpiryDelta() time.Duration {
+	if m != nil {
+		return m.AsyncAcknowledgementExpiryDelta
+	}
+	return 0
+}
+
+func (m *Params) GetOrderedStreams() []OrderedStream {
+	if m != nil {
+		return m.OrderedStreams
+	}
+	return nil
+}
+
+func (m *Params) GetPacketArchiveEnabled() bool {
+	if m != nil {
+		return m.PacketArchiveEnabled
+	}
+	return false
+}
+
+func (m *Params) GetStructuredErrorAcknowledgementClients() []string {
+	if m != nil {
+		return m.StructuredErrorAcknowledgementClients
+	}
+	return nil
+}
+
+func (m *Params) GetPacketLifecycleTrackingEnabled() bool {
+	if m != nil {
+		return m.PacketLifecycleTrackingEnabled
+	}
+	return false
+}
+
+// ClientPortAllowlist defines the ports which may send packets through, and receive packets from, a client.
+type ClientPortAllowlist struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the allowed port identifiers.
+	PortIds []string `protobuf:"bytes,2,rep,name=port_ids,json=portIds,proto3" json:"port_ids,omitempty"`
+}
+
+func (m *ClientPortAllowlist) Reset()         { *m = ClientPortAllowlist{} }
+func (m *ClientPortAllowlist) String() string { return proto.CompactTextString(m) }
+func (*ClientPortAllowlist) ProtoMessage()    {}
+func (*ClientPortAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd743a06947191cd, []int{1}
+}
+func (m *ClientPortAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientPortAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientPortAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientPortAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientPortAllowlist.Merge(m, src)
+}
+func (m *ClientPortAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientPortAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientPortAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientPortAllowlist proto.InternalMessageInfo
+
+func (m *ClientPortAllowlist) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientPortAllowlist) GetPortIds() []string {
+	if m != nil {
+		return m.PortIds
+	}
+	return nil
+}
+
+// OrderedStream defines a (client, port) pair over which packets are received and acknowledged in the
+// order in which they were sent. The stream must be configured on both the sending and the receiving chain.
+// A client with an ordered stream is reserved for the port of the stream, such that the sequences of the
+// packets sent over the client are contiguous. The stream must be configured before any packet is sent or
+// received over the client, after which params updates changing the ordered stream of the client are rejected.
+// As for ordered channels in IBC v1, it is closed once one of its packets times out: the sending side when the
+// timeout is relayed and the receiving side when the timed out packet is relayed.
+type OrderedStream struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// port unique identifier.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *OrderedStream) Reset()         { *m = OrderedStream{} }
+func (m *OrderedStream) String() string { return proto.CompactTextString(m) }
+func (*OrderedStream) ProtoMessage()    {}
+func (*OrderedStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd743a06947191cd, []int{2}
+}
+func (m *OrderedStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderedStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderedStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderedStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderedStream.Merge(m, src)
+}
+func (m *OrderedStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderedStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderedStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderedStream proto.InternalMessageInfo
+
+func (m *OrderedStream) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OrderedStream) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v2.Params")
+	proto.RegisterType((*ClientPortAllowlist)(nil), "ibc.core.channel.v2.ClientPortAllowlist")
+	proto.RegisterType((*OrderedStream)(nil), "ibc.core.channel.v2.OrderedStream")
+}
+
+func init() { proto.RegisterFile("ibc/core/channel/v2/params.proto", fileDescriptor_cd743a06947191cd) }
+
+var fileDescriptor_cd743a06947191cd = []byte{
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x02, 0xcb, 0xee, 0x10, 0x41, 0xcb, 0x06, 0x16, 0x4c, 0x4a, 0xdd, 0xc4, 0xa4,
+	0x17, 0x5a, 0xb3, 0xe2, 0xc1, 0xc4, 0x0b, 0x2b, 0x7b, 0x20, 0xd1, 0x80, 0x95, 0x60, 0xe2, 0xa5,
+	0x99, 0xce, 0x0c, 0x65, 0xc2, 0xb4, 0xd3, 0xcc, 0x4c, 0x97, 0xed, 0xb7, 0xf0, 0xe8, 0xb7, 0xf1,
+	0xca, 0x91, 0xa3, 0x27, 0x35, 0xf0, 0x45, 0xcc, 0xcc, 0x14, 0x05, 0xd9, 0x18, 0x6f, 0x3b, 0xf3,
+	0xff, 0xbd, 0xff, 0xbe, 0xf7, 0xef, 0x6b, 0x81, 0x4f, 0x53, 0x14, 0x21, 0x2e, 0x48, 0x84, 0x4e,
+	0x61, 0x51, 0x10, 0x16, 0x4d, 0x86, 0x51, 0x09, 0x05, 0xcc, 0x65, 0x58, 0x0a, 0xae, 0xb8, 0xbb,
+	0x4a, 0x53, 0x14, 0x6a, 0x22, 0x6c, 0x88, 0x70, 0x32, 0xdc, 0xec, 0x65, 0x3c, 0xe3, 0x46, 0x8f,
+	0xf4, 0x2f, 0x8b, 0x6e, 0x7a, 0x19, 0xe7, 0x19, 0x23, 0x91, 0x39, 0xa5, 0xd5, 0x49, 0x84, 0x2b,
+	0x01, 0x15, 0xe5, 0x85, 0xd5, 0x07, 0x5f, 0x17, 0x40, 0xfb, 0xd0, 0x78, 0xbb, 0x07, 0xe0, 0x71,
+	0x0e, 0xa7, 0x89, 0xa2, 0x39, 0xe1, 0x95, 0x4a, 0x30, 0x61, 0x0a, 0xf6, 0x1d, 0xdf, 0x09, 0x96,
+	0x86, 0x1b, 0xa1, 0xb5, 0x09, 0x6f, 0x6c, 0xc2, 0xbd, 0xc6, 0x66, 0xd4, 0xb9, 0xf8, 0xbe, 0xd5,
+	0xfa, 0xf2, 0x63, 0xcb, 0x89, 0x57, 0x72, 0x38, 0x3d, 0xb2, 0xc5, 0x7b, 0xba, 0xd6, 0x7d, 0x09,
+	0xd6, 0xb5, 0x61, 0x09, 0x6b, 0xc6, 0x21, 0x4e, 0x26, 0x90, 0x55, 0x24, 0x49, 0x6b, 0x45, 0x64,
+	0xff, 0x81, 0xef, 0x04, 0xf3, 0x71, 0x2f, 0x87, 0xd3, 0x43, 0xab, 0x1e, 0x6b, 0x71, 0xa4, 0x35,
+	0x37, 0x00, 0x8f, 0x6c, 0x19, 0x3a, 0x23, 0xaa, 0xe1, 0xe7, 0x0c, 0xbf, 0x6c, 0x78, 0x7d, 0x6d,
+	0x49, 0x0c, 0xd6, 0x10, 0xa3, 0xa4, 0x50, 0x49, 0xc9, 0x85, 0x4a, 0x20, 0x63, 0xfc, 0x9c, 0x51,
+	0xa9, 0x64, 0x7f, 0xde, 0x9f, 0x0b, 0x96, 0x86, 0x41, 0x38, 0x23, 0xa8, 0xf0, 0x8d, 0x29, 0x39,
+	0xe4, 0x42, 0xed, 0xde, 0x14, 0x8c, 0xe6, 0xf5, 0x14, 0x71, 0x0f, 0xdd, 0x97, 0xa4, 0x5b, 0x82,
+	0x01, 0x94, 0x75, 0x81, 0x12, 0x88, 0xce, 0x0a, 0x7e, 0xce, 0x08, 0xce, 0x48, 0xae, 0xff, 0x94,
+	0x4c, 0x4b, 0x2a, 0xea, 0x26, 0xa8, 0x85, 0xff, 0x0f, 0x6a, 0xcb, 0xd8, 0xed, 0xde, 0x75, 0x1b,
+	0x1b, 0x33, 0x1b, 0xdc, 0x7b, 0xb0, 0xc2, 0x05, 0x26, 0x82, 0xe0, 0x44, 0x2a, 0x41, 0x60, 0x2e,
+	0xfb, 0x6d, 0x33, 0xd0, 0x60, 0xe6, 0x40, 0x07, 0x96, 0xfd, 0x60, 0xd0, 0x66, 0x94, 0x65, 0x7e,
+	0xfb, 0x52, 0xba, 0x3b, 0x60, 0xad, 0x09, 0x14, 0x0a, 0x74, 0x4a, 0x27, 0x24, 0x21, 0x05, 0x4c,
+	0x19, 0xc1, 0xfd, 0x45, 0xdf, 0x09, 0x3a, 0x71, 0xcf, 0xaa, 0xbb, 0x56, 0x1c, 0x5b, 0xcd, 0xfd,
+	0x08, 0x02, 0xa9, 0x44, 0x85, 0x54, 0xa5, 0x7b, 0x21, 0x42, 0x70, 0x71, 0x2f, 0x05, 0x9b, 0x99,
+	0xec, 0x77, 0xfc, 0xb9, 0xa0, 0x1b, 0x3f, 0xfb, 0xc3, 0x8f, 0x35, 0xfe, 0xd7, 0x94, 0x36, 0x7b,
+	0xe9, 0xee, 0x83, 0xa7, 0x4d, 0x3b, 0x8c, 0x9e, 0x10, 0x54, 0x23, 0x46, 0x12, 0x25, 0x20, 0x3a,
+	0xa3, 0x45, 0xf6, 0xbb, 0xb3, 0xae, 0xe9, 0xcc, 0xb3, 0xe0, 0xdb, 0x1b, 0xee, 0xa8, 0xc1, 0x9a,
+	0x1e, 0x07, 0xef, 0xc0, 0xea, 0x8c, 0x27, 0xea, 0x3e, 0x01, 0xdd, 0x66, 0x37, 0x28, 0x36, 0x5b,
+	0xdc, 0x8d, 0x3b, 0xf6, 0x62, 0x1f, 0xbb, 0x1b, 0xa0, 0x63, 0x36, 0x86, 0x62, 0xbd, 0x8a, 0xba,
+	0xef, 0x45, 0x7d, 0xde, 0xc7, 0x72, 0x30, 0x06, 0x0f, 0xef, 0xe4, 0xf9, 0x6f, 0xa3, 0x75, 0xb0,
+	0xd8, 0x18, 0x99, 0x95, 0xee, 0xc6, 0x6d, 0xeb, 0x33, 0x3a, 0xbe, 0xb8, 0xf2, 0x9c, 0xcb, 0x2b,
+	0xcf, 0xf9, 0x79, 0xe5, 0x39, 0x9f, 0xaf, 0xbd, 0xd6, 0xe5, 0xb5, 0xd7, 0xfa, 0x76, 0xed, 0xb5,
+	0x3e, 0xbd, 0xce, 0xa8, 0x3a, 0xad, 0xd2, 0x10, 0xf1, 0x3c, 0x42, 0x5c, 0xe6, 0x5c, 0x46, 0x34,
+	0x45, 0xdb, 0x19, 0x8f, 0x26, 0xaf, 0xa2, 0x9c, 0xe3, 0x8a, 0x11, 0x69, 0x5f, 0xff, 0xe7, 0x3b,
+	0xdb, 0xb7, 0xbe, 0x00, 0xaa, 0x2e, 0x89, 0x4c, 0xdb, 0x66, 0xb1, 0x5e, 0xfc, 0x1a, 0x00, 0x5f,
+	0x9a, 0x9f, 0x1b, 0x25, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketLifecycleTrackingEnabled {
+		i--
+		if m.PacketLifecycleTrackingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StructuredErrorAcknowledgementClients) > 0 {
+		for iNdEx := len(m.StructuredErrorAcknowledgementClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StructuredErrorAcknowledgementClients[iNdEx])
+			copy(dAtA[i:], m.StructuredErrorAcknowledgementClients[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.StructuredErrorAcknowledgementClients[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PacketArchiveEnabled {
+		i--
+		if m.PacketArchiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OrderedStreams) > 0 {
+		for iNdEx := len(m.OrderedStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderedStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AsyncAcknowledgementExpiryDelta, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AsyncAcknowledgementExpiryDelta):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClientPortAllowlists) > 0 {
+		for iNdEx := len(m.ClientPortAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientPortAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxPacketBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPacketBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPayloadValueBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPayloadValueBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTimeoutDelta, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTimeoutDelta):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientPortAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientPortAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientPortAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortIds) > 0 {
+		for iNdEx := len(m.PortIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PortIds[iNdEx])
+			copy(dAtA[i:], m.PortIds[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PortIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderedStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderedStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderedStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTimeoutDelta)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPayloadValueBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxPayloadValueBytes))
+	}
+	if m.MaxPacketBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxPacketBytes))
+	}
+	if len(m.ClientPortAllowlists) > 0 {
+		for _, e := range m.ClientPortAllowlists {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AsyncAcknowledgementExpiryDelta)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.OrderedStreams) > 0 {
+		for _, e := range m.OrderedStreams {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PacketArchiveEnabled {
+		n += 2
+	}
+	if len(m.StructuredErrorAcknowledgementClients) > 0 {
+		for _, s := range m.StructuredErrorAcknowledgementClients {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PacketLifecycleTrackingEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClientPortAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.PortIds) > 0 {
+		for _, s := range m.PortIds {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderedStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeoutDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTimeoutDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadValueBytes", wireType)
+			}
+			m.MaxPayloadValueBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayloadValueBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketBytes", wireType)
+			}
+			m.MaxPacketBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPortAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPortAllowlists = append(m.ClientPortAllowlists, ClientPortAllowlist{})
+			if err := m.ClientPortAllowlists[len(m.ClientPortAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncAcknowledgementExpiryDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AsyncAcknowledgementExpiryDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderedStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderedStreams = append(m.OrderedStreams, OrderedStream{})
+			if err := m.OrderedStreams[len(m.OrderedStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketArchiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketArchiveEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredErrorAcknowledgementClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StructuredErrorAcknowledgementClients = append(m.StructuredErrorAcknowledgementClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketLifecycleTrackingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketLifecycleTrackingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientPortAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientPortAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientPortAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortIds = append(m.PortIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderedStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderedStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderedStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   types.Params
+		expError error
+	}{
+		{"default params", types.DefaultParams(), nil},
+		{"valid port allowlists", types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.MockPort, ibctesting.TransferPort), types.NewClientPortAllowlist(ibctesting.SecondClientID, ibctesting.TransferPort)), nil},
+		{"zero max timeout delta", types.NewParams(0, 1024, 2048), types.ErrInvalidParams},
+		{"negative max timeout delta", types.NewParams(-time.Hour, 1024, 2048), types.ErrInvalidParams},
+		{"negative async acknowledgement expiry delta", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, AsyncAcknowledgementExpiryDelta: -time.Hour}, types.ErrInvalidParams},
+		{"zero max payload value bytes", types.NewParams(time.Hour, 0, 2048), types.ErrInvalidParams},
+		{"zero max packet bytes", types.NewParams(time.Hour, 1024, 0), types.ErrInvalidParams},
+		{"max payload value bytes exceeds max packet bytes", types.NewParams(time.Hour, 2048, 1024), types.ErrInvalidParams},
+		{"invalid client ID in allowlist", types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist("", ibctesting.MockPort)), types.ErrInvalidParams},
+		{"empty port allowlist", types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist(ibctesting.FirstClientID)), types.ErrInvalidParams},
+		{"invalid port ID in allowlist", types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist(ibctesting.FirstClientID, "")), types.ErrInvalidParams},
+		{"duplicate port ID in allowlist", types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.MockPort, ibctesting.MockPort)), types.ErrInvalidParams},
+		{"duplicate client allowlist", types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.MockPort), types.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.TransferPort)), types.ErrInvalidParams},
+		{"valid ordered streams", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, OrderedStreams: []types.OrderedStream{types.NewOrderedStream(ibctesting.FirstClientID, ibctesting.MockPort), types.NewOrderedStream(ibctesting.SecondClientID, ibctesting.TransferPort)}}, nil},
+		{"invalid client ID in ordered stream", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, OrderedStreams: []types.OrderedStream{types.NewOrderedStream("", ibctesting.MockPort)}}, types.ErrInvalidParams},
+		{"invalid port ID in ordered stream", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, OrderedStreams: []types.OrderedStream{types.NewOrderedStream(ibctesting.FirstClientID, "")}}, types.ErrInvalidParams},
+		{"duplicate client ordered stream", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, OrderedStreams: []types.OrderedStream{types.NewOrderedStream(ibctesting.FirstClientID, ibctesting.MockPort), types.NewOrderedStream(ibctesting.FirstClientID, ibctesting.TransferPort)}}, types.ErrInvalidParams},
+		{"ordered stream port not in allowlist", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, ClientPortAllowlists: []types.ClientPortAllowlist{types.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.TransferPort)}, OrderedStreams: []types.OrderedStream{types.NewOrderedStream(ibctesting.FirstClientID, ibctesting.MockPort)}}, types.ErrInvalidParams},
+		{"valid structured error acknowledgement clients", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, StructuredErrorAcknowledgementClients: []string{ibctesting.FirstClientID, ibctesting.SecondClientID}}, nil},
+		{"invalid client ID in structured error acknowledgement clients", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, StructuredErrorAcknowledgementClients: []string{""}}, types.ErrInvalidParams},
+		{"duplicate structured error acknowledgement client", types.Params{MaxTimeoutDelta: time.Hour, MaxPayloadValueBytes: 1024, MaxPacketBytes: 2048, StructuredErrorAcknowledgementClients: []string{ibctesting.FirstClientID, ibctesting.FirstClientID}}, types.ErrInvalidParams},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
+
+func TestIsPortAllowed(t *testing.T) {
+	params := types.NewParams(time.Hour, 1024, 2048, types.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.MockPort))
+
+	require.True(t, params.IsPortAllowed(ibctesting.FirstClientID, ibctesting.MockPort))
+	require.False(t, params.IsPortAllowed(ibctesting.FirstClientID, ibctesting.TransferPort))
+	require.True(t, params.IsPortAllowed(ibctesting.SecondClientID, ibctesting.TransferPort), "clients without an allowlist may use any port")
+}
+
+func TestGetOrderedStream(t *testing.T) {
+	params := types.DefaultParams()
+	params.OrderedStreams = []types.OrderedStream{types.NewOrderedStream(ibctesting.FirstClientID, ibctesting.MockPort)}
+
+	stream, found := params.GetOrderedStream(ibctesting.FirstClientID)
+	require.True(t, found)
+	require.Equal(t, types.NewOrderedStream(ibctesting.FirstClientID, ibctesting.MockPort), stream)
+
+	_, found = params.GetOrderedStream(ibctesting.SecondClientID)
+	require.False(t, found)
+}
@@ -73,6 +73,39 @@ func NewQueryPacketReceiptResponse(exists bool, proof []byte, height clienttypes
 	}
 }
 
+// NewQueryPacketStatusRequest creates and returns a new packet status query request.
+func NewQueryPacketStatusRequest(clientID string, sequence uint64, destination bool) *QueryPacketStatusRequest {
+	return &QueryPacketStatusRequest{
+		ClientId:    clientID,
+		Sequence:    sequence,
+		Destination: destination,
+	}
+}
+
+// NewQueryPacketStatusResponse creates and returns a new packet status query response.
+func NewQueryPacketStatusResponse(lifecycle PacketLifecycle, height clienttypes.Height) *QueryPacketStatusResponse {
+	return &QueryPacketStatusResponse{
+		Lifecycle: lifecycle,
+		Height:    height,
+	}
+}
+
+// NewQueryPacketRequest creates and returns a new archived packet query request.
+func NewQueryPacketRequest(clientID string, sequence uint64) *QueryPacketRequest {
+	return &QueryPacketRequest{
+		ClientId: clientID,
+		Sequence: sequence,
+	}
+}
+
+// NewQueryPacketResponse creates and returns a new archived packet query response.
+func NewQueryPacketResponse(packet Packet, height clienttypes.Height) *QueryPacketResponse {
+	return &QueryPacketResponse{
+		Packet: packet,
+		Height: height,
+	}
+}
+
 // NewQueryPacketReceiptRequest creates and returns a new packet receipt query request.
 func NewQueryUnreceivedPacketsRequest(clientID string, sequences []uint64) *QueryUnreceivedPacketsRequest {
 	return &QueryUnreceivedPacketsRequest{
@@ -80,3 +113,24 @@ func NewQueryUnreceivedPacketsRequest(clientID string, sequences []uint64) *Quer
 		Sequences: sequences,
 	}
 }
+
+// NewQueryPruningSequenceStartRequest creates and returns a new pruning sequence start query request.
+func NewQueryPruningSequenceStartRequest(clientID string) *QueryPruningSequenceStartRequest {
+	return &QueryPruningSequenceStartRequest{
+		ClientId: clientID,
+	}
+}
+
+// NewQueryPruningSequenceStartResponse creates and returns a new pruning sequence start query response.
+func NewQueryPruningSequenceStartResponse(pruningSequenceStart uint64) *QueryPruningSequenceStartResponse {
+	return &QueryPruningSequenceStartResponse{
+		PruningSequenceStart: pruningSequenceStart,
+	}
+}
+
+// NewQueryChannelParamsResponse creates and returns a new channel v2 params query response.
+func NewQueryChannelParamsResponse(params Params) *QueryChannelParamsResponse {
+	return &QueryChannelParamsResponse{
+		Params: params,
+	}
+}
@@ -383,6 +383,497 @@ func (m *MsgAcknowledgementResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcknowledgementResponse proto.InternalMessageInfo
 
+// MsgPruneAcknowledgements prunes the packet acknowledgements and receipts stored for a client, starting
+// from the client's pruning sequence start. The combined proof must prove the absence of the counterparty
+// packet commitments of the limit sequences starting at the pruning sequence start. Sequences without a
+// packet receipt are skipped, but the last sequence to be pruned must have been received.
+type MsgPruneAcknowledgements struct {
+	ClientId               string       `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Limit                  uint64       `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	ProofCommitmentAbsence []byte       `protobuf:"bytes,3,opt,name=proof_commitment_absence,json=proofCommitmentAbsence,proto3" json:"proof_commitment_absence,omitempty"`
+	ProofHeight            types.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer                 string       `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneAcknowledgements) Reset()         { *m = MsgPruneAcknowledgements{} }
+func (m *MsgPruneAcknowledgements) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAcknowledgements) ProtoMessage()    {}
+func (*MsgPruneAcknowledgements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{8}
+}
+func (m *MsgPruneAcknowledgements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAcknowledgements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAcknowledgements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAcknowledgements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAcknowledgements.Merge(m, src)
+}
+func (m *MsgPruneAcknowledgements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAcknowledgements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAcknowledgements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAcknowledgements proto.InternalMessageInfo
+
+// MsgPruneAcknowledgementsResponse defines the Msg/PruneAcknowledgements response type.
+type MsgPruneAcknowledgementsResponse struct {
+	// Number of sequences pruned (includes both packet acknowledgements and packet receipts where appropriate).
+	TotalPrunedSequences uint64 `protobuf:"varint,1,opt,name=total_pruned_sequences,json=totalPrunedSequences,proto3" json:"total_pruned_sequences,omitempty"`
+	// The next sequence to be pruned for the client.
+	PruningSequenceStart uint64 `protobuf:"varint,2,opt,name=pruning_sequence_start,json=pruningSequenceStart,proto3" json:"pruning_sequence_start,omitempty"`
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Reset()         { *m = MsgPruneAcknowledgementsResponse{} }
+func (m *MsgPruneAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAcknowledgementsResponse) ProtoMessage()    {}
+func (*MsgPruneAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{9}
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAcknowledgementsResponse.Merge(m, src)
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAcknowledgementsResponse proto.InternalMessageInfo
+
+// MsgRecvPackets receives a batch of incoming IBC packets verified at a single proof height.
+// Either a single proof is provided, combining the proofs of all packet commitments (ICS-23 batch proof),
+// or one proof is provided for each packet.
+type MsgRecvPackets struct {
+	Packets          []Packet     `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	ProofsCommitment [][]byte     `protobuf:"bytes,2,rep,name=proofs_commitment,json=proofsCommitment,proto3" json:"proofs_commitment,omitempty"`
+	ProofHeight      types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string       `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRecvPackets) Reset()         { *m = MsgRecvPackets{} }
+func (m *MsgRecvPackets) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPackets) ProtoMessage()    {}
+func (*MsgRecvPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{10}
+}
+func (m *MsgRecvPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPackets.Merge(m, src)
+}
+func (m *MsgRecvPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPackets proto.InternalMessageInfo
+
+// MsgRecvPacketsResponse defines the Msg/RecvPackets response type.
+type MsgRecvPacketsResponse struct {
+	// the result of each packet, in the order in which the packets were provided.
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v2.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgRecvPacketsResponse) Reset()         { *m = MsgRecvPacketsResponse{} }
+func (m *MsgRecvPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketsResponse) ProtoMessage()    {}
+func (*MsgRecvPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{11}
+}
+func (m *MsgRecvPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketsResponse.Merge(m, src)
+}
+func (m *MsgRecvPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketsResponse proto.InternalMessageInfo
+
+// MsgTimeouts receives a batch of timed-out packets verified at a single proof height.
+// Either a single proof is provided, combining the proofs of all packet receipt absences (ICS-23 batch proof),
+// or one proof is provided for each packet.
+type MsgTimeouts struct {
+	Packets          []Packet     `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	ProofsUnreceived [][]byte     `protobuf:"bytes,2,rep,name=proofs_unreceived,json=proofsUnreceived,proto3" json:"proofs_unreceived,omitempty"`
+	ProofHeight      types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string       `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgTimeouts) Reset()         { *m = MsgTimeouts{} }
+func (m *MsgTimeouts) String() string { return proto.CompactTextString(m) }
+func (*MsgTimeouts) ProtoMessage()    {}
+func (*MsgTimeouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{12}
+}
+func (m *MsgTimeouts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTimeouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTimeouts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTimeouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTimeouts.Merge(m, src)
+}
+func (m *MsgTimeouts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTimeouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTimeouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTimeouts proto.InternalMessageInfo
+
+// MsgTimeoutsResponse defines the Msg/Timeouts response type.
+type MsgTimeoutsResponse struct {
+	// the result of each packet, in the order in which the packets were provided.
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v2.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgTimeoutsResponse) Reset()         { *m = MsgTimeoutsResponse{} }
+func (m *MsgTimeoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTimeoutsResponse) ProtoMessage()    {}
+func (*MsgTimeoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{13}
+}
+func (m *MsgTimeoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTimeoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTimeoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTimeoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTimeoutsResponse.Merge(m, src)
+}
+func (m *MsgTimeoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTimeoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTimeoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTimeoutsResponse proto.InternalMessageInfo
+
+// MsgAcknowledgements receives a batch of incoming IBC acknowledgements verified at a single proof height.
+// The acknowledgement at index i belongs to the packet at index i. Either a single proof is provided, combining
+// the proofs of all acknowledgements (ICS-23 batch proof), or one proof is provided for each packet.
+type MsgAcknowledgements struct {
+	Packets          []Packet          `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Acknowledgements []Acknowledgement `protobuf:"bytes,2,rep,name=acknowledgements,proto3" json:"acknowledgements"`
+	ProofsAcked      [][]byte          `protobuf:"bytes,3,rep,name=proofs_acked,json=proofsAcked,proto3" json:"proofs_acked,omitempty"`
+	ProofHeight      types.Height      `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string            `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgAcknowledgements) Reset()         { *m = MsgAcknowledgements{} }
+func (m *MsgAcknowledgements) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgements) ProtoMessage()    {}
+func (*MsgAcknowledgements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{14}
+}
+func (m *MsgAcknowledgements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgements.Merge(m, src)
+}
+func (m *MsgAcknowledgements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgements proto.InternalMessageInfo
+
+// MsgAcknowledgementsResponse defines the Msg/Acknowledgements response type.
+type MsgAcknowledgementsResponse struct {
+	// the result of each packet, in the order in which the packets were provided.
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v2.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgAcknowledgementsResponse) Reset()         { *m = MsgAcknowledgementsResponse{} }
+func (m *MsgAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgementsResponse) ProtoMessage()    {}
+func (*MsgAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{15}
+}
+func (m *MsgAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgementsResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgementsResponse proto.InternalMessageInfo
+
+// MsgExpireAsyncPacket writes an error acknowledgement for a packet awaiting an asynchronous acknowledgement
+// whose deadline has elapsed.
+type MsgExpireAsyncPacket struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Signer   string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgExpireAsyncPacket) Reset()         { *m = MsgExpireAsyncPacket{} }
+func (m *MsgExpireAsyncPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgExpireAsyncPacket) ProtoMessage()    {}
+func (*MsgExpireAsyncPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{16}
+}
+func (m *MsgExpireAsyncPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExpireAsyncPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExpireAsyncPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExpireAsyncPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpireAsyncPacket.Merge(m, src)
+}
+func (m *MsgExpireAsyncPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExpireAsyncPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpireAsyncPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExpireAsyncPacket proto.InternalMessageInfo
+
+// MsgExpireAsyncPacketResponse defines the Msg/ExpireAsyncPacket response type.
+type MsgExpireAsyncPacketResponse struct {
+}
+
+func (m *MsgExpireAsyncPacketResponse) Reset()         { *m = MsgExpireAsyncPacketResponse{} }
+func (m *MsgExpireAsyncPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExpireAsyncPacketResponse) ProtoMessage()    {}
+func (*MsgExpireAsyncPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{17}
+}
+func (m *MsgExpireAsyncPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExpireAsyncPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExpireAsyncPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExpireAsyncPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpireAsyncPacketResponse.Merge(m, src)
+}
+func (m *MsgExpireAsyncPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExpireAsyncPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpireAsyncPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExpireAsyncPacketResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the MsgUpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the channel v2 parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{18}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the MsgUpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{19}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v2.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgSendPacket)(nil), "ibc.core.channel.v2.MsgSendPacket")
@@ -393,63 +884,104 @@ func init() {
 	proto.RegisterType((*MsgTimeoutResponse)(nil), "ibc.core.channel.v2.MsgTimeoutResponse")
 	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v2.MsgAcknowledgement")
 	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v2.MsgAcknowledgementResponse")
+	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v2.MsgPruneAcknowledgements")
+	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v2.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgRecvPackets)(nil), "ibc.core.channel.v2.MsgRecvPackets")
+	proto.RegisterType((*MsgRecvPacketsResponse)(nil), "ibc.core.channel.v2.MsgRecvPacketsResponse")
+	proto.RegisterType((*MsgTimeouts)(nil), "ibc.core.channel.v2.MsgTimeouts")
+	proto.RegisterType((*MsgTimeoutsResponse)(nil), "ibc.core.channel.v2.MsgTimeoutsResponse")
+	proto.RegisterType((*MsgAcknowledgements)(nil), "ibc.core.channel.v2.MsgAcknowledgements")
+	proto.RegisterType((*MsgAcknowledgementsResponse)(nil), "ibc.core.channel.v2.MsgAcknowledgementsResponse")
+	proto.RegisterType((*MsgExpireAsyncPacket)(nil), "ibc.core.channel.v2.MsgExpireAsyncPacket")
+	proto.RegisterType((*MsgExpireAsyncPacketResponse)(nil), "ibc.core.channel.v2.MsgExpireAsyncPacketResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.channel.v2.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v2.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/tx.proto", fileDescriptor_d421c7119e969b99) }
 
 var fileDescriptor_d421c7119e969b99 = []byte{
-	// 803 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xda, 0x48,
-	0x18, 0xc7, 0x71, 0x70, 0x48, 0x76, 0x20, 0x0b, 0xeb, 0x7d, 0x63, 0xbd, 0x11, 0x58, 0xec, 0x4a,
-	0xc9, 0xb2, 0x8a, 0xbd, 0x61, 0x77, 0x0f, 0x89, 0x56, 0x5b, 0x25, 0xd4, 0x51, 0x23, 0xe5, 0x05,
-	0xd9, 0x50, 0xa9, 0x6d, 0x54, 0x04, 0x66, 0x6a, 0xac, 0x60, 0x8f, 0xcb, 0x18, 0xda, 0xdc, 0xaa,
-	0x9e, 0x22, 0x4e, 0xfd, 0x02, 0x91, 0x2a, 0xf5, 0x0b, 0xe4, 0xd0, 0x0f, 0x11, 0xf5, 0x94, 0x63,
-	0x4e, 0x55, 0x14, 0x0e, 0xf9, 0x1a, 0x95, 0x67, 0x86, 0xd7, 0x98, 0x26, 0x52, 0xe9, 0x09, 0xcf,
-	0x7f, 0x7e, 0xcf, 0xf3, 0xcc, 0xf3, 0x1f, 0x33, 0x63, 0xb0, 0x68, 0x55, 0x0d, 0xc5, 0x40, 0x4d,
-	0xa8, 0x18, 0xf5, 0x8a, 0xe3, 0xc0, 0x86, 0xd2, 0xce, 0x29, 0xde, 0x4b, 0xd9, 0x6d, 0x22, 0x0f,
-	0x09, 0xdf, 0x5b, 0x55, 0x43, 0xf6, 0x67, 0x65, 0x36, 0x2b, 0xb7, 0x73, 0xe2, 0x0f, 0x26, 0x32,
-	0x11, 0x99, 0x57, 0xfc, 0x27, 0x8a, 0x8a, 0x3f, 0x1b, 0x08, 0xdb, 0x08, 0x2b, 0x36, 0x36, 0x95,
-	0xf6, 0xaa, 0xff, 0xc3, 0x26, 0xa4, 0xa0, 0x0a, 0x6e, 0xc5, 0x38, 0x84, 0x1e, 0x23, 0xd2, 0x03,
-	0xa2, 0x61, 0x41, 0xc7, 0xf3, 0xe3, 0xe9, 0x13, 0x05, 0x32, 0x1f, 0x38, 0xb0, 0xb0, 0x8b, 0x4d,
-	0x1d, 0x3a, 0xb5, 0x02, 0x09, 0x14, 0x7e, 0x03, 0x0b, 0x18, 0xb5, 0x9a, 0x06, 0x2c, 0x53, 0x30,
-	0xc9, 0x49, 0xdc, 0xf2, 0x37, 0x5a, 0x8c, 0x8a, 0x79, 0xa2, 0x09, 0x7f, 0x82, 0xef, 0x3c, 0xcb,
-	0x86, 0xa8, 0xe5, 0x95, 0xfd, 0x5f, 0xec, 0x55, 0x6c, 0x37, 0x39, 0x23, 0x71, 0xcb, 0xbc, 0x96,
-	0x60, 0x13, 0xc5, 0x9e, 0x2e, 0xfc, 0x0f, 0xe6, 0xdd, 0xca, 0x51, 0x03, 0x55, 0x6a, 0x38, 0x19,
-	0x96, 0xc2, 0xcb, 0xd1, 0xdc, 0xa2, 0x1c, 0xd0, 0xbd, 0x5c, 0xa0, 0xd0, 0x26, 0x7f, 0xf6, 0x31,
-	0x1d, 0xd2, 0xfa, 0x31, 0xc2, 0x4f, 0x20, 0x82, 0x2d, 0xd3, 0x81, 0xcd, 0x24, 0x4f, 0x96, 0xc2,
-	0x46, 0xeb, 0xf1, 0xe3, 0xb7, 0xe9, 0xd0, 0xeb, 0xeb, 0xd3, 0x2c, 0x13, 0x32, 0x6b, 0xe0, 0xc7,
-	0x91, 0x5e, 0x34, 0x88, 0x5d, 0xe4, 0x60, 0x28, 0x88, 0x60, 0x1e, 0xc3, 0xe7, 0x2d, 0xe8, 0x18,
-	0x90, 0xb4, 0xc3, 0x6b, 0xfd, 0xf1, 0x3a, 0xef, 0x67, 0xc9, 0x74, 0xa9, 0x0f, 0x1a, 0x34, 0xda,
-	0xcc, 0x87, 0x35, 0x10, 0xa1, 0x56, 0x92, 0x88, 0x68, 0xee, 0xd7, 0x09, 0x6b, 0xf6, 0x11, 0xb6,
-	0x64, 0x16, 0x20, 0xfc, 0x01, 0x12, 0x6e, 0x13, 0xa1, 0x67, 0x65, 0x03, 0xd9, 0xb6, 0xe5, 0xd9,
-	0xbe, 0x8b, 0xbe, 0x39, 0x31, 0x2d, 0x4e, 0xf4, 0x7c, 0x5f, 0x16, 0xf2, 0x20, 0x46, 0xd1, 0x3a,
-	0xb4, 0xcc, 0xba, 0x97, 0x0c, 0x93, 0x5a, 0xe2, 0x50, 0x2d, 0xba, 0x5b, 0xed, 0x55, 0xf9, 0x01,
-	0x21, 0x58, 0xa9, 0x28, 0x89, 0xa2, 0xd2, 0xdd, 0x0d, 0x7a, 0x4a, 0x0c, 0x1a, 0x34, 0xd9, 0x37,
-	0xe8, 0x1e, 0x88, 0x34, 0x21, 0x6e, 0x35, 0x68, 0xb3, 0xdf, 0xe6, 0x96, 0x02, 0x9b, 0xed, 0xe1,
-	0x1a, 0x41, 0x8b, 0x47, 0x2e, 0xd4, 0x58, 0x18, 0x73, 0xf1, 0x92, 0x03, 0x60, 0x17, 0x9b, 0x45,
-	0xfa, 0x06, 0x4c, 0xc5, 0xc2, 0x96, 0xd3, 0x84, 0x06, 0xb4, 0xda, 0xb0, 0x36, 0x62, 0x61, 0xa9,
-	0x2f, 0x4f, 0xdb, 0xc2, 0xd9, 0xcf, 0x5b, 0xf8, 0x04, 0x08, 0x83, 0x0e, 0xa7, 0xed, 0xdf, 0xfb,
-	0x19, 0x92, 0x7d, 0xc3, 0x38, 0x74, 0xd0, 0x8b, 0x06, 0xac, 0x99, 0x90, 0xbc, 0x24, 0x5f, 0xe0,
-	0x63, 0x11, 0xc4, 0x2b, 0xa3, 0xd9, 0x88, 0x8d, 0xd1, 0xdc, 0xef, 0x81, 0x39, 0xc6, 0x2a, 0xb3,
-	0x64, 0xe3, 0x29, 0x84, 0x34, 0xa0, 0xe6, 0x95, 0xfd, 0x22, 0x35, 0xe2, 0x78, 0x4c, 0x03, 0x44,
-	0xda, 0xf0, 0x95, 0x1b, 0x7b, 0xc2, 0x7f, 0xd5, 0x3d, 0x31, 0x80, 0x78, 0xd3, 0xb5, 0x29, 0xef,
-	0x4d, 0xf6, 0x82, 0x03, 0xc2, 0x4d, 0x48, 0xf8, 0x17, 0x48, 0x9a, 0xaa, 0x17, 0xf6, 0xf7, 0x74,
-	0xb5, 0xac, 0xa9, 0x7a, 0x69, 0xa7, 0x58, 0x2e, 0x3e, 0x2a, 0xa8, 0xe5, 0xd2, 0x9e, 0x5e, 0x50,
-	0xf3, 0xdb, 0x5b, 0xdb, 0xea, 0xfd, 0x44, 0x48, 0x8c, 0x77, 0x4e, 0xa4, 0xe8, 0x90, 0x24, 0x2c,
-	0x81, 0x5f, 0x02, 0xc3, 0xf6, 0xf6, 0xf7, 0x0b, 0x09, 0x4e, 0x9c, 0xef, 0x9c, 0x48, 0xbc, 0xff,
-	0x2c, 0xac, 0x80, 0xc5, 0x40, 0x50, 0x2f, 0xe5, 0xf3, 0xaa, 0xae, 0x27, 0x66, 0xc4, 0x68, 0xe7,
-	0x44, 0x9a, 0x63, 0xc3, 0x89, 0xf8, 0xd6, 0xc6, 0xf6, 0x4e, 0x49, 0x53, 0x13, 0x61, 0x8a, 0xb3,
-	0xa1, 0xc8, 0x1f, 0xbf, 0x4b, 0x85, 0x72, 0x9d, 0x30, 0x08, 0xef, 0x62, 0x53, 0x38, 0x00, 0x60,
-	0xe8, 0x22, 0xc8, 0x04, 0xfa, 0x34, 0x72, 0xc0, 0x8a, 0xd9, 0xdb, 0x99, 0xfe, 0x3e, 0x1c, 0x00,
-	0x30, 0x74, 0xbc, 0x4e, 0xcc, 0x3e, 0x60, 0x26, 0x67, 0x0f, 0x38, 0xc1, 0x74, 0x30, 0xd7, 0x3b,
-	0x76, 0xd2, 0x93, 0xc2, 0x18, 0x20, 0x2e, 0xdd, 0x02, 0xf4, 0x93, 0x1e, 0x82, 0xf8, 0xf8, 0x7f,
-	0x71, 0x62, 0xec, 0x18, 0x28, 0x2a, 0x77, 0x04, 0x7b, 0xc5, 0xc4, 0xd9, 0x57, 0xd7, 0xa7, 0x59,
-	0x6e, 0xf3, 0xe1, 0xd9, 0x55, 0x8a, 0x3b, 0xbf, 0x4a, 0x71, 0x97, 0x57, 0x29, 0xee, 0x4d, 0x37,
-	0x15, 0x3a, 0xef, 0xa6, 0x42, 0x17, 0xdd, 0x54, 0xe8, 0xf1, 0x7f, 0xa6, 0xe5, 0xd5, 0x5b, 0x55,
-	0xd9, 0x40, 0xb6, 0xc2, 0x3e, 0x09, 0xac, 0xaa, 0xb1, 0x62, 0x22, 0xa5, 0xbd, 0xa6, 0xd8, 0xa8,
-	0xd6, 0x6a, 0x40, 0x4c, 0x2f, 0xfb, 0xbf, 0xfe, 0x59, 0x19, 0xfe, 0xe6, 0x38, 0x72, 0x21, 0xae,
-	0x46, 0xc8, 0x85, 0xff, 0xf7, 0xa7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0xb6, 0x94, 0x19, 0x97,
-	0x08, 0x00, 0x00,
+	// 1267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1a, 0x47,
+	0x14, 0x67, 0x0d, 0xfe, 0x7a, 0x38, 0x81, 0x6c, 0x6c, 0x87, 0xac, 0x2d, 0x4c, 0x49, 0x24, 0x13,
+	0xa7, 0x86, 0x98, 0x26, 0x52, 0xed, 0x56, 0xad, 0x08, 0x25, 0xaa, 0xa5, 0x60, 0xa3, 0x05, 0x2c,
+	0xb5, 0x8d, 0xba, 0x5a, 0x96, 0xc9, 0x7a, 0x65, 0xd8, 0x25, 0x3b, 0x0b, 0xb5, 0x0f, 0xfd, 0x50,
+	0x4f, 0x91, 0x4f, 0x3d, 0xf4, 0x6a, 0xa9, 0x52, 0xd5, 0x7b, 0x0e, 0xbd, 0xf5, 0x1f, 0x88, 0x7a,
+	0xca, 0x31, 0xa7, 0x2a, 0xb2, 0x55, 0xa5, 0xff, 0x40, 0xef, 0xd5, 0xce, 0xcc, 0x2e, 0x5f, 0x8b,
+	0xc1, 0x0a, 0x69, 0x4e, 0x30, 0xef, 0xfd, 0xde, 0x7b, 0xf3, 0x7e, 0x33, 0xfc, 0xf6, 0xb1, 0xb0,
+	0xac, 0x55, 0x94, 0x94, 0x62, 0x98, 0x28, 0xa5, 0xec, 0xcb, 0xba, 0x8e, 0x6a, 0xa9, 0x56, 0x3a,
+	0x65, 0x1d, 0x26, 0x1b, 0xa6, 0x61, 0x19, 0xfc, 0x55, 0xad, 0xa2, 0x24, 0x6d, 0x6f, 0x92, 0x79,
+	0x93, 0xad, 0xb4, 0x30, 0xaf, 0x1a, 0xaa, 0x41, 0xfc, 0x29, 0xfb, 0x1b, 0x85, 0x0a, 0xd7, 0x14,
+	0x03, 0xd7, 0x0d, 0x9c, 0xaa, 0x63, 0x35, 0xd5, 0xda, 0xb0, 0x3f, 0x98, 0x23, 0xe6, 0x55, 0xa1,
+	0x21, 0x2b, 0x07, 0xc8, 0x3a, 0x1f, 0x61, 0xca, 0x75, 0xcc, 0x10, 0x2b, 0x6d, 0x44, 0x4d, 0x43,
+	0xba, 0x65, 0x57, 0xa0, 0xdf, 0x28, 0x20, 0xfe, 0x27, 0x07, 0x97, 0xf2, 0x58, 0x2d, 0x22, 0xbd,
+	0x5a, 0x20, 0xa9, 0xf9, 0x1b, 0x70, 0x09, 0x1b, 0x4d, 0x53, 0x41, 0x12, 0x05, 0x46, 0xb8, 0x18,
+	0x97, 0x98, 0x15, 0xe7, 0xa8, 0x31, 0x4b, 0x6c, 0xfc, 0x6d, 0xb8, 0x62, 0x69, 0x75, 0x64, 0x34,
+	0x2d, 0xc9, 0xfe, 0xc4, 0x96, 0x5c, 0x6f, 0x44, 0x26, 0x62, 0x5c, 0x22, 0x20, 0x86, 0x99, 0xa3,
+	0xe4, 0xd8, 0xf9, 0x4f, 0x60, 0xa6, 0x21, 0x1f, 0xd5, 0x0c, 0xb9, 0x8a, 0x23, 0xfe, 0x98, 0x3f,
+	0x11, 0x4c, 0x2f, 0x27, 0x3d, 0xf8, 0x49, 0x16, 0x28, 0xe8, 0x7e, 0xe0, 0xf9, 0x5f, 0x2b, 0x3e,
+	0xd1, 0x8d, 0xe1, 0x17, 0x61, 0x0a, 0x6b, 0xaa, 0x8e, 0xcc, 0x48, 0x80, 0x6c, 0x85, 0xad, 0xb6,
+	0x42, 0x4f, 0x7f, 0x59, 0xf1, 0xfd, 0xf8, 0xfa, 0xd9, 0x1a, 0x33, 0xc4, 0x37, 0x61, 0xa1, 0xab,
+	0x17, 0x11, 0xe1, 0x86, 0xa1, 0x63, 0xc4, 0x0b, 0x30, 0x83, 0xd1, 0x93, 0x26, 0xd2, 0x15, 0x44,
+	0xda, 0x09, 0x88, 0xee, 0x7a, 0x2b, 0x60, 0x67, 0x89, 0x9f, 0x51, 0x1e, 0x44, 0xa4, 0xb4, 0x18,
+	0x0f, 0x9b, 0x30, 0x45, 0xc9, 0x26, 0x11, 0xc1, 0xf4, 0xd2, 0x80, 0x3d, 0xdb, 0x10, 0xb6, 0x65,
+	0x16, 0xc0, 0xdf, 0x82, 0x70, 0xc3, 0x34, 0x8c, 0xc7, 0x92, 0x62, 0xd4, 0xeb, 0x9a, 0x55, 0xb7,
+	0x59, 0xb4, 0xc9, 0x99, 0x13, 0x43, 0xc4, 0x9e, 0x75, 0xcd, 0x7c, 0x16, 0xe6, 0x28, 0x74, 0x1f,
+	0x69, 0xea, 0xbe, 0x15, 0xf1, 0x93, 0x5a, 0x42, 0x47, 0x2d, 0x7a, 0x5a, 0xad, 0x8d, 0xe4, 0xe7,
+	0x04, 0xc1, 0x4a, 0x05, 0x49, 0x14, 0x35, 0x8d, 0x4e, 0xd0, 0xd7, 0xb0, 0xd0, 0xd5, 0xa4, 0x4b,
+	0xd0, 0xa7, 0x30, 0x65, 0x22, 0xdc, 0xac, 0xd1, 0x66, 0x2f, 0xa7, 0x57, 0x3d, 0x9b, 0x75, 0xe0,
+	0x22, 0x81, 0x96, 0x8e, 0x1a, 0x48, 0x64, 0x61, 0x8c, 0xc5, 0x57, 0x1c, 0x40, 0x1e, 0xab, 0x25,
+	0x7a, 0x03, 0xc6, 0x42, 0x61, 0x53, 0x37, 0x91, 0x82, 0xb4, 0x16, 0xaa, 0x76, 0x51, 0x58, 0x76,
+	0xcd, 0xe3, 0xa6, 0x70, 0xf2, 0x7c, 0x0a, 0xbf, 0x02, 0xbe, 0xdd, 0xe1, 0xb8, 0xf9, 0xfb, 0x7d,
+	0x82, 0x64, 0xcf, 0x28, 0x07, 0xba, 0xf1, 0x4d, 0x0d, 0x55, 0x55, 0x44, 0x2e, 0xc9, 0x1b, 0xf0,
+	0x58, 0x82, 0x90, 0xdc, 0x9d, 0x8d, 0xd0, 0x18, 0x4c, 0xdf, 0xf4, 0xcc, 0xd1, 0x53, 0x99, 0x25,
+	0xeb, 0x4d, 0xc1, 0xaf, 0x00, 0x25, 0x4f, 0xb2, 0x8b, 0x54, 0x09, 0xe3, 0x73, 0x22, 0x10, 0x53,
+	0x46, 0x39, 0xf0, 0x38, 0x93, 0xc0, 0x5b, 0x3d, 0x13, 0x05, 0x84, 0x7e, 0xd6, 0xc6, 0x7d, 0x36,
+	0xff, 0x72, 0x10, 0xc9, 0x63, 0xb5, 0x60, 0x36, 0x75, 0xd4, 0x53, 0x0a, 0xf3, 0x4b, 0x30, 0x4b,
+	0x3b, 0x92, 0xb4, 0x2a, 0x13, 0xcc, 0x19, 0x6a, 0xd8, 0xae, 0xf2, 0xf3, 0x30, 0x59, 0xd3, 0xea,
+	0x9a, 0xc5, 0x04, 0x92, 0x2e, 0xf8, 0x0f, 0x21, 0xd2, 0x2b, 0x12, 0x92, 0x5c, 0xc1, 0x44, 0xa3,
+	0x28, 0xa1, 0x8b, 0x3d, 0x62, 0x91, 0xa1, 0xde, 0xff, 0x99, 0xdc, 0x9f, 0x39, 0x88, 0x0d, 0xea,
+	0xdb, 0xe5, 0xf8, 0x2e, 0x2c, 0x5a, 0x86, 0x25, 0xd7, 0xa4, 0x86, 0x0d, 0xab, 0x4a, 0x8e, 0xba,
+	0x62, 0x26, 0xb7, 0xf3, 0xc4, 0x4b, 0x72, 0x54, 0x8b, 0x8e, 0xcf, 0x8e, 0xb2, 0xf1, 0x9a, 0xae,
+	0xba, 0x01, 0x12, 0xb6, 0x64, 0xd3, 0x61, 0x6a, 0x9e, 0x79, 0x9d, 0x88, 0xa2, 0xed, 0x63, 0xc7,
+	0xf1, 0x0f, 0x07, 0x97, 0xbb, 0xb4, 0x0c, 0xf3, 0x1f, 0xc1, 0x34, 0xbd, 0xf5, 0x76, 0x55, 0xff,
+	0x68, 0xbf, 0x13, 0x27, 0xc2, 0x7e, 0xa2, 0x11, 0x7a, 0x70, 0xb7, 0x68, 0xfb, 0x13, 0x73, 0x22,
+	0x55, 0x22, 0xfc, 0xce, 0x54, 0x5b, 0x86, 0xc5, 0xee, 0x4e, 0x5d, 0xda, 0x33, 0x30, 0x4d, 0xef,
+	0x28, 0xed, 0xf8, 0x02, 0x77, 0xdb, 0x89, 0x63, 0x6c, 0xfe, 0xcd, 0x41, 0xb0, 0x2d, 0x6b, 0x63,
+	0xa3, 0xb2, 0x4b, 0xbc, 0x3b, 0xa8, 0x7c, 0x7b, 0xea, 0x3d, 0xf4, 0x01, 0x78, 0xb5, 0xa3, 0xcd,
+	0xf1, 0xf3, 0xf8, 0xc7, 0x04, 0x29, 0xd0, 0xa7, 0x0f, 0x6f, 0xc4, 0xe7, 0x1e, 0x84, 0x7b, 0x04,
+	0x18, 0x13, 0x3a, 0x2f, 0x26, 0xe2, 0x7d, 0x39, 0xf8, 0xf7, 0x18, 0xf5, 0xd8, 0x95, 0x71, 0xfb,
+	0x88, 0x28, 0xb1, 0xf8, 0x5d, 0xe8, 0xf8, 0x63, 0x58, 0xf2, 0x20, 0x6f, 0xfc, 0xa7, 0x74, 0x08,
+	0xf3, 0x79, 0xac, 0xe6, 0x0e, 0x1b, 0x9a, 0x89, 0x32, 0xf8, 0x48, 0x57, 0xd8, 0xc8, 0x77, 0xae,
+	0x8a, 0x77, 0xce, 0x90, 0x13, 0xdd, 0x33, 0x64, 0x47, 0x87, 0xfe, 0xf3, 0x3b, 0x8c, 0xc2, 0xb2,
+	0x57, 0x65, 0x67, 0xcb, 0xf1, 0xef, 0x20, 0x94, 0xc7, 0x6a, 0xb9, 0x51, 0x95, 0x2d, 0x54, 0x20,
+	0x83, 0x3c, 0xbf, 0x0c, 0xb3, 0x72, 0xd3, 0xda, 0x37, 0x4c, 0xcd, 0x3a, 0x62, 0x9b, 0x6a, 0x1b,
+	0xe8, 0x68, 0x60, 0xe3, 0xd8, 0x63, 0x7d, 0xd0, 0xbd, 0xb2, 0x21, 0xed, 0xd1, 0xc0, 0x5e, 0x6d,
+	0xf1, 0xce, 0xe6, 0xda, 0xe9, 0xe2, 0xd7, 0xe1, 0x5a, 0x4f, 0x7d, 0x67, 0x6b, 0x6b, 0x2f, 0x39,
+	0xe0, 0xfb, 0xa9, 0xe5, 0xef, 0x41, 0x4c, 0xcc, 0x15, 0x0b, 0xbb, 0x3b, 0xc5, 0x9c, 0x24, 0xe6,
+	0x8a, 0xe5, 0x87, 0x25, 0xa9, 0xf4, 0x45, 0x21, 0x27, 0x95, 0x77, 0x8a, 0x85, 0x5c, 0x76, 0xfb,
+	0xc1, 0x76, 0xee, 0xb3, 0xb0, 0x4f, 0x08, 0x1d, 0x9f, 0xc4, 0x82, 0x1d, 0x26, 0x7e, 0x15, 0xae,
+	0x7b, 0x86, 0xed, 0xec, 0xee, 0x16, 0xc2, 0x9c, 0x30, 0x73, 0x7c, 0x12, 0x0b, 0xd8, 0xdf, 0xf9,
+	0x75, 0x58, 0xf6, 0x04, 0x16, 0xcb, 0xd9, 0x6c, 0xae, 0x58, 0x0c, 0x4f, 0x08, 0xc1, 0xe3, 0x93,
+	0xd8, 0x34, 0x5b, 0x0e, 0x84, 0x3f, 0xc8, 0x6c, 0x3f, 0x2c, 0x8b, 0xb9, 0xb0, 0x9f, 0xc2, 0xd9,
+	0x52, 0x08, 0x3c, 0xfd, 0x35, 0xea, 0x4b, 0xff, 0x36, 0x0d, 0xfe, 0x3c, 0x56, 0xf9, 0x47, 0x00,
+	0x1d, 0x7f, 0x84, 0xe2, 0x9e, 0x54, 0x76, 0xfd, 0xc1, 0x10, 0xd6, 0x86, 0x63, 0xdc, 0xeb, 0xfb,
+	0x08, 0xa0, 0xe3, 0xef, 0xc5, 0xc0, 0xec, 0x6d, 0x8c, 0xb0, 0x36, 0x1c, 0xe3, 0x66, 0x2f, 0xc2,
+	0xb4, 0x33, 0x76, 0xaf, 0x0c, 0x0a, 0x63, 0x00, 0x61, 0x75, 0x08, 0xc0, 0x4d, 0x7a, 0x00, 0xa1,
+	0xde, 0x59, 0x74, 0x60, 0x6c, 0x0f, 0x50, 0x48, 0x8d, 0x08, 0x74, 0x8b, 0x7d, 0x0b, 0x0b, 0xde,
+	0xc3, 0xd5, 0xfa, 0xa0, 0x4c, 0x9e, 0x70, 0xe1, 0xde, 0x85, 0xe0, 0x6e, 0x79, 0x09, 0x82, 0x9d,
+	0xc3, 0xc4, 0x8d, 0xe1, 0xdc, 0x63, 0xe1, 0xf6, 0x08, 0x20, 0xb7, 0xc0, 0x1e, 0xcc, 0xb8, 0xcf,
+	0xd7, 0xd8, 0x90, 0x13, 0xc0, 0x42, 0x62, 0x18, 0xc2, 0xcd, 0xab, 0x43, 0xb8, 0x8f, 0xb2, 0xc4,
+	0x88, 0xe4, 0x63, 0xe1, 0xce, 0xa8, 0x48, 0xb7, 0xde, 0x13, 0xb8, 0xd2, 0x2f, 0x9d, 0xb7, 0x06,
+	0xa5, 0xe9, 0x83, 0x0a, 0x1b, 0x23, 0x43, 0x9d, 0x92, 0xc2, 0xe4, 0x0f, 0xaf, 0x9f, 0xad, 0x71,
+	0xe9, 0xef, 0x61, 0x96, 0x8a, 0x92, 0xfd, 0x63, 0x3d, 0x80, 0x05, 0xaa, 0x53, 0x59, 0x9a, 0x84,
+	0x7a, 0xf6, 0xd2, 0xfc, 0xcd, 0x41, 0xf9, 0x3b, 0x65, 0x4d, 0x78, 0x7f, 0x14, 0x54, 0xcf, 0x06,
+	0xee, 0xef, 0x3d, 0x3f, 0x8d, 0x72, 0x2f, 0x4e, 0xa3, 0xdc, 0xab, 0xd3, 0x28, 0xf7, 0xd3, 0x59,
+	0xd4, 0xf7, 0xe2, 0x2c, 0xea, 0x7b, 0x79, 0x16, 0xf5, 0x7d, 0xf9, 0xb1, 0xaa, 0x59, 0xfb, 0xcd,
+	0x4a, 0x52, 0x31, 0xea, 0x29, 0xf6, 0x42, 0x47, 0xab, 0x28, 0xeb, 0xaa, 0x91, 0x6a, 0x6d, 0xa6,
+	0xea, 0x46, 0xb5, 0x59, 0x43, 0x98, 0xbe, 0x88, 0xb9, 0x73, 0x77, 0xbd, 0xf3, 0x8d, 0xd1, 0x51,
+	0x03, 0xe1, 0xca, 0x14, 0x79, 0x19, 0xf3, 0xc1, 0x7f, 0x03, 0x00, 0x5b, 0xc3, 0xdb, 0x5b, 0x55,
+	0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -472,6 +1004,16 @@ type MsgClient interface {
 	Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.CallOption) (*MsgTimeoutResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(ctx context.Context, in *MsgAcknowledgement, opts ...grpc.CallOption) (*MsgAcknowledgementResponse, error)
+	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// RecvPackets defines a rpc handler method for MsgRecvPackets.
+	RecvPackets(ctx context.Context, in *MsgRecvPackets, opts ...grpc.CallOption) (*MsgRecvPacketsResponse, error)
+	// Timeouts defines a rpc handler method for MsgTimeouts.
+	Timeouts(ctx context.Context, in *MsgTimeouts, opts ...grpc.CallOption) (*MsgTimeoutsResponse, error)
+	// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+	Acknowledgements(ctx context.Context, in *MsgAcknowledgements, opts ...grpc.CallOption) (*MsgAcknowledgementsResponse, error)
+	// ExpireAsyncPacket defines a rpc handler method for MsgExpireAsyncPacket.
+	ExpireAsyncPacket(ctx context.Context, in *MsgExpireAsyncPacket, opts ...grpc.CallOption) (*MsgExpireAsyncPacketResponse, error)
 }
 
 type msgClient struct {
@@ -518,16 +1060,71 @@ func (c *msgClient) Acknowledgement(ctx context.Context, in *MsgAcknowledgement,
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SendPacket defines a rpc handler method for MsgSendPacket.
-	SendPacket(context.Context, *MsgSendPacket) (*MsgSendPacketResponse, error)
+func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error) {
+	out := new(MsgPruneAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/PruneAcknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecvPackets(ctx context.Context, in *MsgRecvPackets, opts ...grpc.CallOption) (*MsgRecvPacketsResponse, error) {
+	out := new(MsgRecvPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/RecvPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Timeouts(ctx context.Context, in *MsgTimeouts, opts ...grpc.CallOption) (*MsgTimeoutsResponse, error) {
+	out := new(MsgTimeoutsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/Timeouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Acknowledgements(ctx context.Context, in *MsgAcknowledgements, opts ...grpc.CallOption) (*MsgAcknowledgementsResponse, error) {
+	out := new(MsgAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/Acknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExpireAsyncPacket(ctx context.Context, in *MsgExpireAsyncPacket, opts ...grpc.CallOption) (*MsgExpireAsyncPacketResponse, error) {
+	out := new(MsgExpireAsyncPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/ExpireAsyncPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SendPacket defines a rpc handler method for MsgSendPacket.
+	SendPacket(context.Context, *MsgSendPacket) (*MsgSendPacketResponse, error)
 	// RecvPacket defines a rpc handler method for MsgRecvPacket.
 	RecvPacket(context.Context, *MsgRecvPacket) (*MsgRecvPacketResponse, error)
 	// Timeout defines a rpc handler method for MsgTimeout.
 	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// RecvPackets defines a rpc handler method for MsgRecvPackets.
+	RecvPackets(context.Context, *MsgRecvPackets) (*MsgRecvPacketsResponse, error)
+	// Timeouts defines a rpc handler method for MsgTimeouts.
+	Timeouts(context.Context, *MsgTimeouts) (*MsgTimeoutsResponse, error)
+	// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+	Acknowledgements(context.Context, *MsgAcknowledgements) (*MsgAcknowledgementsResponse, error)
+	// ExpireAsyncPacket defines a rpc handler method for MsgExpireAsyncPacket.
+	ExpireAsyncPacket(context.Context, *MsgExpireAsyncPacket) (*MsgExpireAsyncPacketResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -546,6 +1143,21 @@ func (*UnimplementedMsgServer) Timeout(ctx context.Context, req *MsgTimeout) (*M
 func (*UnimplementedMsgServer) Acknowledgement(ctx context.Context, req *MsgAcknowledgement) (*MsgAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgement not implemented")
 }
+func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
+}
+func (*UnimplementedMsgServer) RecvPackets(ctx context.Context, req *MsgRecvPackets) (*MsgRecvPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecvPackets not implemented")
+}
+func (*UnimplementedMsgServer) Timeouts(ctx context.Context, req *MsgTimeouts) (*MsgTimeoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timeouts not implemented")
+}
+func (*UnimplementedMsgServer) Acknowledgements(ctx context.Context, req *MsgAcknowledgements) (*MsgAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgements not implemented")
+}
+func (*UnimplementedMsgServer) ExpireAsyncPacket(ctx context.Context, req *MsgExpireAsyncPacket) (*MsgExpireAsyncPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireAsyncPacket not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -623,6 +1235,96 @@ func _Msg_Acknowledgement_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneAcknowledgements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneAcknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/PruneAcknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneAcknowledgements(ctx, req.(*MsgPruneAcknowledgements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecvPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecvPackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecvPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/RecvPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecvPackets(ctx, req.(*MsgRecvPackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Timeouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTimeouts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Timeouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/Timeouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Timeouts(ctx, req.(*MsgTimeouts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Acknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Acknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/Acknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Acknowledgements(ctx, req.(*MsgAcknowledgements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExpireAsyncPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpireAsyncPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExpireAsyncPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/ExpireAsyncPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpireAsyncPacket(ctx, req.(*MsgExpireAsyncPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -643,6 +1345,100 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Acknowledgement",
 			Handler:    _Msg_Acknowledgement_Handler,
 		},
+		{
+			MethodName: "PruneAcknowledgements",
+			Handler:    _Msg_PruneAcknowledgements_Handler,
+		},
+		{
+			MethodName: "RecvPackets",
+			Handler:    _Msg_RecvPackets_Handler,
+		},
+		{
+			MethodName: "Timeouts",
+			Handler:    _Msg_Timeouts_Handler,
+		},
+		{
+			MethodName: "Acknowledgements",
+			Handler:    _Msg_Acknowledgements_Handler,
+		},
+		{
+			MethodName: "ExpireAsyncPacket",
+			Handler:    _Msg_ExpireAsyncPacket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/core/channel/v2/tx.proto",
+}
+
+// ParamsMsgClient is the client API for ParamsMsg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ParamsMsgClient interface {
+	// UpdateChannelParamsV2 defines a rpc handler method for MsgUpdateParams.
+	UpdateChannelParamsV2(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type paramsMsgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewParamsMsgClient(cc grpc1.ClientConn) ParamsMsgClient {
+	return &paramsMsgClient{cc}
+}
+
+func (c *paramsMsgClient) UpdateChannelParamsV2(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.ParamsMsg/UpdateChannelParamsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParamsMsgServer is the server API for ParamsMsg service.
+type ParamsMsgServer interface {
+	// UpdateChannelParamsV2 defines a rpc handler method for MsgUpdateParams.
+	UpdateChannelParamsV2(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedParamsMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedParamsMsgServer struct {
+}
+
+func (*UnimplementedParamsMsgServer) UpdateChannelParamsV2(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelParamsV2 not implemented")
+}
+
+func RegisterParamsMsgServer(s grpc1.Server, srv ParamsMsgServer) {
+	s.RegisterService(&_ParamsMsg_serviceDesc, srv)
+}
+
+func _ParamsMsg_UpdateChannelParamsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParamsMsgServer).UpdateChannelParamsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.ParamsMsg/UpdateChannelParamsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParamsMsgServer).UpdateChannelParamsV2(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ParamsMsg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.core.channel.v2.ParamsMsg",
+	HandlerType: (*ParamsMsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateChannelParamsV2",
+			Handler:    _ParamsMsg_UpdateChannelParamsV2_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v2/tx.proto",
@@ -997,163 +1793,2548 @@ func (m *MsgAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPruneAcknowledgements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSendPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPruneAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourceClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Payloads) > 0 {
-		for _, e := range m.Payloads {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofCommitmentAbsence) > 0 {
+		i -= len(m.ProofCommitmentAbsence)
+		copy(dAtA[i:], m.ProofCommitmentAbsence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofCommitmentAbsence)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+	if m.PruningSequenceStart != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PruningSequenceStart))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.TotalPrunedSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPrunedSequences))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRecvPackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecvPackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecvPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofCommitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofsCommitment) > 0 {
+		for iNdEx := len(m.ProofsCommitment) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsCommitment[iNdEx])
+			copy(dAtA[i:], m.ProofsCommitment[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsCommitment[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRecvPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecvPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecvPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	if len(m.Results) > 0 {
+		dAtA11 := make([]byte, len(m.Results)*10)
+		var j10 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTimeout) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTimeouts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTimeouts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTimeouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofsUnreceived) > 0 {
+		for iNdEx := len(m.ProofsUnreceived) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsUnreceived[iNdEx])
+			copy(dAtA[i:], m.ProofsUnreceived[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsUnreceived[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTimeoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTimeoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTimeoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		dAtA14 := make([]byte, len(m.Results)*10)
+		var j13 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofsAcked) > 0 {
+		for iNdEx := len(m.ProofsAcked) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsAcked[iNdEx])
+			copy(dAtA[i:], m.ProofsAcked[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsAcked[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		dAtA17 := make([]byte, len(m.Results)*10)
+		var j16 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTx(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpireAsyncPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpireAsyncPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpireAsyncPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpireAsyncPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpireAsyncPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpireAsyncPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.Payloads) > 0 {
+		for _, e := range m.Payloads {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgRecvPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Acknowledgement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofAcked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgPruneAcknowledgements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.ProofCommitmentAbsence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPrunedSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalPrunedSequences))
+	}
+	if m.PruningSequenceStart != 0 {
+		n += 1 + sovTx(uint64(m.PruningSequenceStart))
+	}
+	return n
+}
+
+func (m *MsgRecvPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProofsCommitment) > 0 {
+		for _, b := range m.ProofsCommitment {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgTimeouts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProofsUnreceived) > 0 {
+		for _, b := range m.ProofsUnreceived {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTimeoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgAcknowledgements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for _, e := range m.Acknowledgements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProofsAcked) > 0 {
+		for _, b := range m.ProofsAcked {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgExpireAsyncPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExpireAsyncPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payloads = append(m.Payloads, Payload{})
+			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Acknowledgement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAcked == nil {
+				m.ProofAcked = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitmentAbsence", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitmentAbsence = append(m.ProofCommitmentAbsence[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitmentAbsence == nil {
+				m.ProofCommitmentAbsence = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
+			}
+			m.TotalPrunedSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStart", wireType)
+			}
+			m.PruningSequenceStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningSequenceStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsCommitment = append(m.ProofsCommitment, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsCommitment[len(m.ProofsCommitment)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgTimeoutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgAcknowledgement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Acknowledgement.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofAcked)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRecvPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAcknowledgementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeouts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,17 +4357,17 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeouts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeouts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1196,29 +4377,31 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceClient = string(dAtA[iNdEx:postIndex])
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsUnreceived", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1228,14 +4411,27 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsUnreceived = append(m.ProofsUnreceived, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsUnreceived[len(m.ProofsUnreceived)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1262,8 +4458,7 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payloads = append(m.Payloads, Payload{})
-			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1320,7 +4515,7 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1343,30 +4538,80 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1389,7 +4634,7 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,15 +4657,15 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1447,13 +4692,48 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, Acknowledgement{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsAcked", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1480,12 +4760,10 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofCommitment == nil {
-				m.ProofCommitment = []byte{}
-			}
+			m.ProofsAcked = append(m.ProofsAcked, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsAcked[len(m.ProofsAcked)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -1518,7 +4796,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1571,7 +4849,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1594,30 +4872,80 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1640,7 +4968,7 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgExpireAsyncPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1663,17 +4991,17 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExpireAsyncPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExpireAsyncPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1683,30 +5011,29 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1716,60 +5043,12 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1822,7 +5101,7 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExpireAsyncPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1845,31 +5124,12 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExpireAsyncPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExpireAsyncPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1891,7 +5151,7 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1914,17 +5174,17 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1934,95 +5194,27 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Acknowledgement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAcked == nil {
-				m.ProofAcked = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2049,42 +5241,10 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2106,7 +5266,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,31 +5289,12 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12,6 +12,7 @@ const (
 	KeyPacketReceiptPrefix    = "receipts"
 	KeyPruningSequenceStart   = "pruningSequenceStart"
 	KeyRecvStartSequence      = "recvStartSequence"
+	KeySendPacketLifecycle    = "sendPacketLifecycles"
 )
 
 // ICS04
@@ -73,6 +74,11 @@ func RecvStartSequenceKey(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyRecvStartSequence, channelPath(portID, channelID)))
 }
 
+// SendPacketLifecycleKey returns the store key under which the lifecycle record of a sent packet is stored
+func SendPacketLifecycleKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", KeySendPacketLifecycle, channelPath(portID, channelID), sequencePath(sequence)))
+}
+
 func sequencePath(sequence uint64) string {
 	return fmt.Sprintf("%s/%d", KeySequencePrefix, sequence)
 }
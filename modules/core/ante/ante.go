@@ -120,6 +120,30 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 					redundancies++
 				}
 				packetMsgs++
+			case *channeltypesv2.MsgRecvPackets:
+				response, err := rrd.k.ChannelKeeperV2.RecvPackets(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				redundancies += countNoOps(response.Results)
+				packetMsgs += len(response.Results)
+			case *channeltypesv2.MsgAcknowledgements:
+				response, err := rrd.k.ChannelKeeperV2.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				redundancies += countNoOps(response.Results)
+				packetMsgs += len(response.Results)
+			case *channeltypesv2.MsgTimeouts:
+				response, err := rrd.k.ChannelKeeperV2.Timeouts(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				redundancies += countNoOps(response.Results)
+				packetMsgs += len(response.Results)
 			default:
 				// if the multiMsg tx has a msg that is not a packet msg or update msg, then we will not return error
 				// regardless of if all packet messages are redundant. This ensures that non-packet messages get processed
@@ -206,3 +230,14 @@ func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *cli
 
 	return nil
 }
+
+// countNoOps returns the number of no-op results of a batch of IBC v2 packet messages.
+func countNoOps(results []channeltypesv2.ResponseResultType) int {
+	noOps := 0
+	for _, result := range results {
+		if result == channeltypesv2.NOOP {
+			noOps++
+		}
+	}
+	return noOps
+}
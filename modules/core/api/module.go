@@ -54,6 +54,9 @@ type IBCModule interface {
 	) error
 }
 
+// WriteAcknowledgementWrapper defines an interface which is used by IBC applications to write asynchronous
+// acknowledgements. Middleware implement this interface to intercept acknowledgements written by the
+// underlying application before passing them on to the next middleware or core IBC.
 type WriteAcknowledgementWrapper interface {
 	// WriteAcknowledgement writes the acknowledgement for an async acknowledgement
 	WriteAcknowledgement(
@@ -71,3 +74,35 @@ type PacketDataUnmarshaler interface {
 	// the payload is provided and the packet data interface is returned
 	UnmarshalPacketData(payload channeltypesv2.Payload) (interface{}, error)
 }
+
+// AsyncAcknowledgementExpiryExempt defines an optional interface which allows an IBC application to opt out of the
+// expiry of packets awaiting its asynchronous acknowledgement. Applications whose asynchronous acknowledgement depends
+// on the outcome of a subsequent packet, such as the forwarding of ICS-20 transfers, should opt out, as an error
+// acknowledgement written on their behalf cannot revert the side effects of the subsequent packet.
+// Middleware should forward the call to the underlying application.
+type AsyncAcknowledgementExpiryExempt interface {
+	// ExemptFromAsyncAcknowledgementExpiry returns true if the packets awaiting an asynchronous acknowledgement
+	// from the application must not expire.
+	ExemptFromAsyncAcknowledgementExpiry() bool
+}
+
+// WriteAcknowledgementWrapperSetter defines an optional interface which allows the IBCStackBuilder to set the
+// WriteAcknowledgementWrapper of an IBC application, i.e. the middleware directly above the application or core IBC.
+// Applications which write asynchronous acknowledgements should implement this interface.
+type WriteAcknowledgementWrapperSetter interface {
+	// WithWriteAckWrapper sets the WriteAcknowledgementWrapper used to write asynchronous acknowledgements.
+	WithWriteAckWrapper(writeAckWrapper WriteAcknowledgementWrapper)
+}
+
+// Middleware defines an interface which IBC v2 middleware must implement. Middleware wrap an underlying IBCModule
+// to intercept the callbacks from core IBC, and implement the WriteAcknowledgementWrapper to intercept asynchronous
+// acknowledgements written by the underlying application on their way to core IBC.
+type Middleware interface {
+	IBCModule
+	WriteAcknowledgementWrapper
+	WriteAcknowledgementWrapperSetter
+
+	// SetUnderlyingApplication sets the underlying IBC module. This function may be used after the middleware's
+	// initialization to set the IBC module which is below this middleware in the IBC application stack.
+	SetUnderlyingApplication(app IBCModule)
+}
@@ -3,20 +3,26 @@ package api
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 // Router contains all the module-defined callbacks required by IBC Protocol V2.
 type Router struct {
 	// routes is a map from portID to IBCModule
 	routes map[string]IBCModule
+	// prefixRoutes is a map from port prefix to IBCModule
+	prefixRoutes map[string]IBCModule
 }
 
 // NewRouter creates a new Router instance.
 func NewRouter() *Router {
 	return &Router{
-		routes: make(map[string]IBCModule),
+		routes:       make(map[string]IBCModule),
+		prefixRoutes: make(map[string]IBCModule),
 	}
 }
 
@@ -26,7 +32,7 @@ func (rtr *Router) AddRoute(portID string, cbs IBCModule) *Router {
 		panic(errors.New("route expressions can only contain alphanumeric characters"))
 	}
 
-	if rtr.HasRoute(portID) {
+	if _, ok := rtr.routes[portID]; ok {
 		panic(fmt.Errorf("route %s has already been registered", portID))
 	}
 
@@ -35,17 +41,57 @@ func (rtr *Router) AddRoute(portID string, cbs IBCModule) *Router {
 	return rtr
 }
 
-// Route returns the IBCModule for a given portID.
+// AddPrefixRoute registers a route for all portIDs starting with the given port prefix to a given IBCModule.
+// This allows applications which dynamically create ports, such as a port per smart contract, to handle
+// packets for these ports without registering each port individually. If multiple port prefixes match a portID,
+// the route of the longest port prefix is used. Routes registered with AddRoute take precedence over port prefixes.
+func (rtr *Router) AddPrefixRoute(portPrefix string, cbs IBCModule) *Router {
+	if err := host.PortIdentifierValidator(portPrefix); err != nil {
+		panic(fmt.Errorf("invalid port prefix %s: %w", portPrefix, err))
+	}
+
+	if _, ok := rtr.prefixRoutes[portPrefix]; ok {
+		panic(fmt.Errorf("prefix route %s has already been registered", portPrefix))
+	}
+
+	rtr.prefixRoutes[portPrefix] = cbs
+
+	return rtr
+}
+
+// Route returns the IBCModule for a given portID. It panics if no route is registered for the portID.
 func (rtr *Router) Route(portID string) IBCModule {
-	route, ok := rtr.routes[portID]
+	route, ok := rtr.GetRoute(portID)
 	if !ok {
 		panic(fmt.Sprintf("no route for %s", portID))
 	}
 	return route
 }
 
-// HasRoute returns true if the Router has a module registered for the portID or false otherwise.
+// GetRoute returns the IBCModule for a given portID and a boolean indicating whether a route was found.
+// A route registered for the exact portID is returned if present, otherwise the route of the longest
+// port prefix matching the portID is returned.
+func (rtr *Router) GetRoute(portID string) (IBCModule, bool) {
+	if route, ok := rtr.routes[portID]; ok {
+		return route, true
+	}
+
+	var (
+		route      IBCModule
+		longestLen int
+	)
+	for prefix, cbs := range rtr.prefixRoutes {
+		if len(prefix) > longestLen && strings.HasPrefix(portID, prefix) {
+			route, longestLen = cbs, len(prefix)
+		}
+	}
+
+	return route, route != nil
+}
+
+// HasRoute returns true if the Router has a module registered for the portID, either for the exact portID or
+// a matching port prefix, or false otherwise.
 func (rtr *Router) HasRoute(portID string) bool {
-	_, ok := rtr.routes[portID]
+	_, ok := rtr.GetRoute(portID)
 	return ok
 }
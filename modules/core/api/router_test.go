@@ -68,3 +68,100 @@ func (suite *APITestSuite) TestRouter() {
 		})
 	}
 }
+
+func (suite *APITestSuite) TestRouterPrefixRoutes() {
+	var (
+		router       *api.Router
+		exactModule  = &mockv2.IBCModule{IBCApp: &mockv2.IBCApp{}}
+		prefixModule = &mockv2.IBCModule{IBCApp: &mockv2.IBCApp{}}
+		longerModule = &mockv2.IBCModule{IBCApp: &mockv2.IBCApp{}}
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		assertionFn func()
+	}{
+		{
+			name: "success: prefix route",
+			malleate: func() {
+				router.AddPrefixRoute("wasm.", prefixModule)
+			},
+			assertionFn: func() {
+				route, ok := router.GetRoute("wasm.contract1")
+				suite.Require().True(ok)
+				suite.Require().Same(prefixModule, route)
+				suite.Require().True(router.HasRoute("wasm.contract2"))
+				suite.Require().False(router.HasRoute("wasm"))
+			},
+		},
+		{
+			name: "success: longest prefix route is used",
+			malleate: func() {
+				router.AddPrefixRoute("wasm.", prefixModule)
+				router.AddPrefixRoute("wasm.special.", longerModule)
+			},
+			assertionFn: func() {
+				route, ok := router.GetRoute("wasm.special.contract1")
+				suite.Require().True(ok)
+				suite.Require().Same(longerModule, route)
+
+				route, ok = router.GetRoute("wasm.contract1")
+				suite.Require().True(ok)
+				suite.Require().Same(prefixModule, route)
+			},
+		},
+		{
+			name: "success: exact route takes precedence over prefix route",
+			malleate: func() {
+				router.AddRoute("port01", exactModule)
+				router.AddPrefixRoute("port", prefixModule)
+			},
+			assertionFn: func() {
+				suite.Require().Same(exactModule, router.Route("port01"))
+				suite.Require().Same(prefixModule, router.Route("port02"))
+			},
+		},
+		{
+			name:     "success: lookup of unknown port does not panic",
+			malleate: func() {},
+			assertionFn: func() {
+				route, ok := router.GetRoute("unknown")
+				suite.Require().False(ok)
+				suite.Require().Nil(route)
+				suite.Require().Panics(func() {
+					router.Route("unknown")
+				})
+			},
+		},
+		{
+			name: "failure: panics on duplicate prefix",
+			malleate: func() {
+				router.AddPrefixRoute("wasm.", prefixModule)
+			},
+			assertionFn: func() {
+				suite.Require().PanicsWithError("prefix route wasm. has already been registered", func() {
+					router.AddPrefixRoute("wasm.", longerModule)
+				})
+			},
+		},
+		{
+			name:     "failure: panics on invalid prefix",
+			malleate: func() {},
+			assertionFn: func() {
+				suite.Require().Panics(func() {
+					router.AddPrefixRoute("wasm/", prefixModule)
+				})
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			router = api.NewRouter()
+
+			tc.malleate()
+
+			tc.assertionFn()
+		})
+	}
+}
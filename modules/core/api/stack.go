@@ -0,0 +1,80 @@
+package api
+
+import "errors"
+
+// IBCStackBuilder composes an IBC v2 application with a list of middleware into an IBC application stack.
+//
+// Middleware are added from the bottom of the stack to the top, thus the first middleware added wraps the
+// base application and the last middleware added is the module registered on the Router. Callbacks from
+// core IBC, such as OnSendPacket and OnRecvPacket, are executed from the top of the stack to the base application,
+// while asynchronous acknowledgements written by the base application are passed through the middleware from the
+// bottom of the stack to the top before being written by core IBC.
+type IBCStackBuilder struct {
+	writeAckWrapper WriteAcknowledgementWrapper
+	baseModule      IBCModule
+	middlewares     []Middleware
+}
+
+// NewIBCStackBuilder creates a new IBCStackBuilder. The provided WriteAcknowledgementWrapper is used by the top
+// of the stack to write asynchronous acknowledgements and is expected to be the IBC v2 channel keeper.
+func NewIBCStackBuilder(writeAckWrapper WriteAcknowledgementWrapper) *IBCStackBuilder {
+	return &IBCStackBuilder{
+		writeAckWrapper: writeAckWrapper,
+	}
+}
+
+// Base sets the base IBC application of the stack.
+func (b *IBCStackBuilder) Base(baseModule IBCModule) *IBCStackBuilder {
+	if baseModule == nil {
+		panic(errors.New("base module cannot be nil"))
+	}
+
+	if b.baseModule != nil {
+		panic(errors.New("base module already set"))
+	}
+
+	b.baseModule = baseModule
+	return b
+}
+
+// Next adds a middleware to the top of the stack.
+func (b *IBCStackBuilder) Next(middleware Middleware) *IBCStackBuilder {
+	if middleware == nil {
+		panic(errors.New("middleware cannot be nil"))
+	}
+
+	b.middlewares = append(b.middlewares, middleware)
+	return b
+}
+
+// Build wires the base application and the middleware together and returns the top of the stack, which
+// should be registered on the Router.
+func (b *IBCStackBuilder) Build() IBCModule {
+	if b.baseModule == nil {
+		panic(errors.New("base module cannot be nil"))
+	}
+
+	if b.writeAckWrapper == nil {
+		panic(errors.New("write acknowledgement wrapper cannot be nil"))
+	}
+
+	var underlyingModule IBCModule = b.baseModule
+	for _, middleware := range b.middlewares {
+		middleware.SetUnderlyingApplication(underlyingModule)
+		setWriteAckWrapper(underlyingModule, middleware)
+
+		underlyingModule = middleware
+	}
+
+	// the top of the stack writes asynchronous acknowledgements through core IBC
+	setWriteAckWrapper(underlyingModule, b.writeAckWrapper)
+
+	return underlyingModule
+}
+
+// setWriteAckWrapper sets the WriteAcknowledgementWrapper of the provided module if it writes asynchronous acknowledgements.
+func setWriteAckWrapper(module IBCModule, writeAckWrapper WriteAcknowledgementWrapper) {
+	if setter, ok := module.(WriteAcknowledgementWrapperSetter); ok {
+		setter.WithWriteAckWrapper(writeAckWrapper)
+	}
+}
@@ -0,0 +1,126 @@
+package api_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/api"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
+)
+
+var _ api.Middleware = (*recordingMiddleware)(nil)
+
+// recordingMiddleware is a middleware which records the order in which it is called.
+type recordingMiddleware struct {
+	mockv2.IBCModule
+
+	name            string
+	calls           *[]string
+	app             api.IBCModule
+	writeAckWrapper api.WriteAcknowledgementWrapper
+}
+
+func (m *recordingMiddleware) OnSendPacket(ctx context.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	*m.calls = append(*m.calls, m.name)
+	return m.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+func (m *recordingMiddleware) WriteAcknowledgement(ctx context.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	*m.calls = append(*m.calls, m.name)
+	return m.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+func (m *recordingMiddleware) SetUnderlyingApplication(app api.IBCModule) {
+	m.app = app
+}
+
+func (m *recordingMiddleware) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	m.writeAckWrapper = writeAckWrapper
+}
+
+// recordingApp is a base application which writes asynchronous acknowledgements.
+type recordingApp struct {
+	mockv2.IBCModule
+
+	calls           *[]string
+	writeAckWrapper api.WriteAcknowledgementWrapper
+}
+
+func (a *recordingApp) OnSendPacket(_ context.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	*a.calls = append(*a.calls, "app")
+	return nil
+}
+
+func (a *recordingApp) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	a.writeAckWrapper = writeAckWrapper
+}
+
+// recordingWriteAckWrapper records acknowledgements written by the top of the stack in place of core IBC.
+type recordingWriteAckWrapper struct {
+	calls *[]string
+}
+
+func (w recordingWriteAckWrapper) WriteAcknowledgement(_ context.Context, _ string, _ uint64, _ channeltypesv2.Acknowledgement) error {
+	*w.calls = append(*w.calls, "core")
+	return nil
+}
+
+func (suite *APITestSuite) TestIBCStackBuilder() {
+	var calls []string
+
+	app := &recordingApp{IBCModule: mockv2.NewIBCModule(), calls: &calls}
+	middlewareA := &recordingMiddleware{IBCModule: mockv2.NewIBCModule(), name: "middlewareA", calls: &calls}
+	middlewareB := &recordingMiddleware{IBCModule: mockv2.NewIBCModule(), name: "middlewareB", calls: &calls}
+
+	stack := api.NewIBCStackBuilder(recordingWriteAckWrapper{calls: &calls}).
+		Base(app).
+		Next(middlewareA).
+		Next(middlewareB).
+		Build()
+
+	suite.Require().Equal(middlewareB, stack)
+	suite.Require().Equal(app, middlewareA.app)
+	suite.Require().Equal(middlewareA, middlewareB.app)
+
+	// callbacks from core IBC are executed from the top of the stack to the base application
+	err := stack.OnSendPacket(context.Background(), "client-0", "client-1", 1, channeltypesv2.Payload{}, sdk.AccAddress{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"middlewareB", "middlewareA", "app"}, calls)
+
+	// acknowledgements written by the base application are passed from the bottom of the stack to core IBC
+	calls = nil
+	err = app.writeAckWrapper.WriteAcknowledgement(context.Background(), "client-1", 1, channeltypesv2.Acknowledgement{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"middlewareA", "middlewareB", "core"}, calls)
+}
+
+func (suite *APITestSuite) TestIBCStackBuilderPanics() {
+	writeAckWrapper := recordingWriteAckWrapper{calls: &[]string{}}
+
+	suite.Require().PanicsWithError("base module cannot be nil", func() {
+		api.NewIBCStackBuilder(writeAckWrapper).Build()
+	})
+
+	suite.Require().PanicsWithError("base module cannot be nil", func() {
+		api.NewIBCStackBuilder(writeAckWrapper).Base(nil)
+	})
+
+	suite.Require().PanicsWithError("base module already set", func() {
+		api.NewIBCStackBuilder(writeAckWrapper).Base(&mockv2.IBCModule{}).Base(&mockv2.IBCModule{})
+	})
+
+	suite.Require().PanicsWithError("middleware cannot be nil", func() {
+		api.NewIBCStackBuilder(writeAckWrapper).Next(nil)
+	})
+
+	suite.Require().PanicsWithError("write acknowledgement wrapper cannot be nil", func() {
+		api.NewIBCStackBuilder(nil).Base(&mockv2.IBCModule{}).Build()
+	})
+
+	suite.Require().NotPanics(func() {
+		stack := api.NewIBCStackBuilder(writeAckWrapper).Base(&mockv2.IBCModule{}).Build()
+		suite.Require().Equal(&mockv2.IBCModule{}, stack)
+	})
+}
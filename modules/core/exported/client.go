@@ -122,6 +122,44 @@ type LightClientModule interface {
 	) error
 }
 
+// BatchVerifier is an optional interface which light client modules may implement to verify several
+// CommitmentPaths against a single proof at one height. This allows relayers to prove multiple packets
+// with one combined proof rather than a separate proof for each path.
+type BatchVerifier interface {
+	// VerifyMembershipBatch verifies a proof of the existence of each value at its respective CommitmentPath at the specified height.
+	// The paths and values are matched by index and the caller is expected to provide an equal number of each.
+	VerifyMembershipBatch(
+		ctx context.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+		values [][]byte,
+	) error
+
+	// VerifyNonMembershipBatch verifies a proof of the absence of each of the given CommitmentPaths at the specified height.
+	VerifyNonMembershipBatch(
+		ctx context.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+	) error
+}
+
+// ConsensusStatePruner is an optional interface which light client modules may implement to prune expired
+// consensus states independently of client updates. Core IBC calls into it at the beginning of each block,
+// such that clients which are no longer updated eventually release their storage.
+type ConsensusStatePruner interface {
+	// PruneExpiredConsensusStates deletes at most limit expired consensus states of the client, together with their
+	// metadata, and returns the number of consensus states deleted.
+	PruneExpiredConsensusStates(ctx context.Context, clientID string, limit uint64) (uint64, error)
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/types"
 	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	mockv2 "github.com/cosmos/ibc-go/v9/testing/mock/v2"
 	"github.com/cosmos/ibc-go/v9/testing/simapp"
 )
 
@@ -102,6 +103,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 							},
 						),
 					},
+					nil,
 					clienttypes.NewParams(exported.Tendermint),
 					false,
 					2,
@@ -144,6 +146,9 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					[]channeltypes.SendPacketLifecycle{
+						channeltypes.NewSendPacketLifecycle(port1, channel1, 1, channeltypes.NewPacketLifecycle(channeltypes.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)),
+					},
 					channeltypes.Params{UpgradeTimeout: channeltypes.DefaultTimeout},
 				),
 				ChannelV2Genesis: channelv2types.NewGenesisState(
@@ -159,6 +164,25 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]channelv2types.PacketSequence{
 						channelv2types.NewPacketSequence(channel1, 1),
 					},
+					[]channelv2types.PacketSequence{
+						channelv2types.NewPacketSequence(channel2, 1),
+					},
+					[]channelv2types.AsyncPacket{
+						channelv2types.NewAsyncPacket(channel2, 1, channelv2types.NewPacket(1, channel1, channel2, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))),
+					},
+					[]channelv2types.OrderedStreamState{
+						channelv2types.NewOrderedStreamState(channel2, mockv2.ModuleNameB, 2, 1, false),
+					},
+					[]channelv2types.Packet{
+						channelv2types.NewPacket(1, channel1, channel2, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+					},
+					[]channelv2types.ClientProofHeight{
+						channelv2types.NewClientProofHeight(channel2, clienttypes.NewHeight(0, 10)),
+					},
+					[]channelv2types.SendPacketLifecycle{
+						channelv2types.NewSendPacketLifecycle(channel1, 1, channelv2types.NewPacketLifecycle(channelv2types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)),
+					},
+					channelv2types.DefaultParams(),
 				),
 			},
 			expError: nil,
@@ -182,6 +206,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 							},
 						),
 					},
+					nil,
 					clienttypes.NewParams(exported.Tendermint),
 					false,
 					2,
@@ -293,6 +318,7 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 							},
 						),
 					},
+					nil,
 					clienttypes.NewParams(exported.Tendermint),
 					false,
 					0,
@@ -335,6 +361,9 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					[]channeltypes.SendPacketLifecycle{
+						channeltypes.NewSendPacketLifecycle(port1, channel1, 1, channeltypes.NewPacketLifecycle(channeltypes.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)),
+					},
 					channeltypes.Params{UpgradeTimeout: channeltypes.DefaultTimeout},
 				),
 				ChannelV2Genesis: channelv2types.NewGenesisState(
@@ -350,6 +379,25 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 					[]channelv2types.PacketSequence{
 						channelv2types.NewPacketSequence(channel1, 1),
 					},
+					[]channelv2types.PacketSequence{
+						channelv2types.NewPacketSequence(channel2, 1),
+					},
+					[]channelv2types.AsyncPacket{
+						channelv2types.NewAsyncPacket(channel2, 1, channelv2types.NewPacket(1, channel1, channel2, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))),
+					},
+					[]channelv2types.OrderedStreamState{
+						channelv2types.NewOrderedStreamState(channel2, mockv2.ModuleNameB, 2, 1, false),
+					},
+					[]channelv2types.Packet{
+						channelv2types.NewPacket(1, channel1, channel2, 100, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+					},
+					[]channelv2types.ClientProofHeight{
+						channelv2types.NewClientProofHeight(channel2, clienttypes.NewHeight(0, 10)),
+					},
+					[]channelv2types.SendPacketLifecycle{
+						channelv2types.NewSendPacketLifecycle(channel1, 1, channelv2types.NewPacketLifecycle(channelv2types.PacketLifecycleStatus_Committed, clienttypes.NewHeight(0, 10), 100)),
+					},
+					channelv2types.DefaultParams(),
 				),
 			},
 		},
@@ -1,6 +1,8 @@
 package telemetry
 
 import (
+	"time"
+
 	metrics "github.com/hashicorp/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -9,6 +11,15 @@ import (
 	ibcmetrics "github.com/cosmos/ibc-go/v9/modules/core/metrics"
 )
 
+// ReportSendPacket reports the number of payloads sent and their size in bytes.
+func ReportSendPacket(packet types.Packet) {
+	for _, payload := range packet.Payloads {
+		labels := addPayloadLabels(packet, payload)
+		telemetry.IncrCounterWithLabels([]string{"ibc", "send", "packet"}, 1, labels)
+		telemetry.IncrCounterWithLabels([]string{"ibc", "send", "packet", "bytes"}, float32(len(payload.Value)), labels)
+	}
+}
+
 func ReportRecvPacket(packet types.Packet) {
 	for _, payload := range packet.Payloads {
 		telemetry.IncrCounterWithLabels(
@@ -54,3 +65,39 @@ func ReportAcknowledgePacket(packet types.Packet) {
 		)
 	}
 }
+
+// ReportAcknowledgePacketLatency reports the time elapsed between the packet being sent and its acknowledgement
+// being processed on the sending chain. The latency is only known for packets sent while packet lifecycle tracking
+// is enabled in the channel v2 params.
+func ReportAcknowledgePacketLatency(packet types.Packet, latency time.Duration) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	for _, payload := range packet.Payloads {
+		metrics.AddSampleWithLabels(
+			[]string{"ibc", "acknowledge", "packet", "latency", "seconds"},
+			float32(latency.Seconds()),
+			addPayloadLabels(packet, payload),
+		)
+	}
+}
+
+// ReportPendingCommitments reports the number of packets sent over the given client which await
+// their acknowledgement or timeout.
+func ReportPendingCommitments(clientID string, pending uint64) {
+	telemetry.SetGaugeWithLabels(
+		[]string{"ibc", "packet", "commitments", "pending"},
+		float32(pending),
+		[]metrics.Label{telemetry.NewLabel(ibcmetrics.LabelClientID, clientID)},
+	)
+}
+
+func addPayloadLabels(packet types.Packet, payload types.Payload) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel(ibcmetrics.LabelSourcePort, payload.SourcePort),
+		telemetry.NewLabel(ibcmetrics.LabelSourceClient, packet.SourceClient),
+		telemetry.NewLabel(ibcmetrics.LabelDestinationPort, payload.DestinationPort),
+		telemetry.NewLabel(ibcmetrics.LabelDestinationClient, packet.DestinationClient),
+	}
+}
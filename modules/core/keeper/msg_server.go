@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 
@@ -12,7 +13,9 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	internalerrors "github.com/cosmos/ibc-go/v9/modules/core/internal/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/internal/telemetry"
@@ -23,6 +26,7 @@ var (
 	_ clienttypes.CounterpartyMsgServer = (*Keeper)(nil)
 	_ connectiontypes.MsgServer         = (*Keeper)(nil)
 	_ channeltypes.MsgServer            = (*Keeper)(nil)
+	_ channeltypesv2.ParamsMsgServer    = (*Keeper)(nil)
 )
 
 // CreateClient defines a rpc handler method for MsgCreateClient.
@@ -52,25 +56,131 @@ func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateC
 
 // RegisterCounterparty will register the eureka counterparty info for the given client id
 // it must be called by the same relayer that called CreateClient
-func (k *Keeper) RegisterCounterparty(ctx context.Context, msg *clienttypes.MsgRegisterCounterparty) (*clienttypes.MsgRegisterCounterpartyResponse, error) {
+// If a counterparty proof is provided, the registration succeeds only if the counterparty chain
+// has registered the given client as the counterparty of the counterparty client.
+// If no counterparty proof is provided and confirmation is required, the registration remains pending
+// and packet flow is only enabled once the registration is confirmed with MsgConfirmCounterparty.
+func (k *Keeper) RegisterCounterparty(goCtx context.Context, msg *clienttypes.MsgRegisterCounterparty) (*clienttypes.MsgRegisterCounterpartyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
 	creator := k.ClientKeeper.GetClientCreator(ctx, msg.ClientId)
 	if !creator.Equals(sdk.AccAddress(msg.Signer)) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected same signer as createClient submittor %s, got %s", creator, msg.Signer)
 	}
 
-	counterpartyInfo := clienttypes.CounterpartyInfo{
-		MerklePrefix: msg.CounterpartyMerklePrefix,
-		ClientId:     msg.CounterpartyClientId,
+	counterpartyInfo := clienttypes.NewCounterpartyInfo(msg.CounterpartyMerklePrefix, msg.CounterpartyClientId)
+	if len(msg.ProofCounterparty) != 0 {
+		if err := k.verifyCounterpartyRegistration(ctx, msg.ClientId, counterpartyInfo, msg.MerklePrefix, msg.ProofCounterparty, msg.ProofHeight); err != nil {
+			return nil, err
+		}
 	}
-	k.ClientKeeper.SetClientCounterparty(ctx, msg.ClientId, counterpartyInfo)
 
-	// initialize next sequence send to enable packet flow
-	k.ChannelKeeperV2.SetNextSequenceSend(ctx, msg.ClientId, 1)
+	pending := msg.RequireConfirmation && len(msg.ProofCounterparty) == 0
+	k.ClientKeeper.RegisterCounterparty(ctx, msg.ClientId, counterpartyInfo, pending)
+
+	if !pending {
+		// initialize next sequence send to enable packet flow
+		k.ChannelKeeperV2.SetNextSequenceSend(ctx, msg.ClientId, 1)
+	}
 
 	k.ClientKeeper.DeleteClientCreator(ctx, msg.ClientId)
 	return &clienttypes.MsgRegisterCounterpartyResponse{}, nil
 }
 
+// ConfirmCounterparty defines a rpc handler method for MsgConfirmCounterparty. It confirms the pending
+// counterparty registration of the given client id if the counterparty chain has registered the client
+// as the counterparty of the counterparty client, and enables packet flow over the client.
+func (k *Keeper) ConfirmCounterparty(goCtx context.Context, msg *clienttypes.MsgConfirmCounterparty) (*clienttypes.MsgConfirmCounterpartyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	counterpartyInfo, found := k.ClientKeeper.GetClientCounterparty(ctx, msg.ClientId)
+	if !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrCounterpartyNotFound, "counterparty not registered for client %s", msg.ClientId)
+	}
+
+	if !k.ClientKeeper.IsCounterpartyPending(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "counterparty registration of client %s is not pending confirmation", msg.ClientId)
+	}
+
+	if err := k.verifyCounterpartyRegistration(ctx, msg.ClientId, counterpartyInfo, msg.MerklePrefix, msg.ProofCounterparty, msg.ProofHeight); err != nil {
+		return nil, err
+	}
+
+	if err := k.ClientKeeper.ConfirmCounterparty(ctx, msg.ClientId); err != nil {
+		return nil, err
+	}
+
+	// initialize next sequence send to enable packet flow
+	k.ChannelKeeperV2.SetNextSequenceSend(ctx, msg.ClientId, 1)
+
+	return &clienttypes.MsgConfirmCounterpartyResponse{}, nil
+}
+
+// verifyCounterpartyRegistration verifies that the counterparty chain has registered the given client as the
+// counterparty of the counterparty client, under the provided merkle prefix of this chain.
+func (k *Keeper) verifyCounterpartyRegistration(
+	ctx sdk.Context,
+	clientID string,
+	counterpartyInfo clienttypes.CounterpartyInfo,
+	merklePrefix [][]byte,
+	proof []byte,
+	proofHeight clienttypes.Height,
+) error {
+	merklePrefix, err := k.counterpartyMerklePrefix(merklePrefix)
+	if err != nil {
+		return err
+	}
+
+	expectedCounterparty := clienttypes.NewCounterpartyInfo(merklePrefix, clientID)
+	bz, err := k.cdc.Marshal(&expectedCounterparty)
+	if err != nil {
+		return err
+	}
+
+	path := host.FullClientKey(counterpartyInfo.ClientId, clienttypes.CounterpartyKey())
+	merklePath := channeltypesv2.BuildMerklePath(counterpartyInfo.MerklePrefix, path)
+
+	if err := k.ClientKeeper.VerifyMembership(ctx, clientID, proofHeight, 0, 0, proof, merklePath, bz); err != nil {
+		return errorsmod.Wrapf(err, "failed counterparty registration verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// counterpartyMerklePrefix returns the merkle prefix under which the counterparty chain is expected to have
+// registered this chain. IBC v2 paths are appended to the last element of the merkle prefix, thus by default
+// an empty element follows the commitment prefix of this chain, so that the paths are stored directly under it.
+// A provided merkle prefix must be rooted at the commitment prefix of this chain.
+func (k *Keeper) counterpartyMerklePrefix(merklePrefix [][]byte) ([][]byte, error) {
+	commitmentPrefix := k.ConnectionKeeper.GetCommitmentPrefix().Bytes()
+	if len(merklePrefix) == 0 {
+		return [][]byte{commitmentPrefix, []byte("")}, nil
+	}
+
+	if !bytes.Equal(merklePrefix[0], commitmentPrefix) {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidCounterparty, "merkle prefix must be rooted at the commitment prefix (%s), got %s", commitmentPrefix, merklePrefix[0])
+	}
+
+	return merklePrefix, nil
+}
+
+// UpdateCounterparty defines a rpc handler method for MsgUpdateCounterparty. It allows the authority
+// to correct the counterparty info registered for the given client id.
+func (k *Keeper) UpdateCounterparty(goCtx context.Context, msg *clienttypes.MsgUpdateCounterparty) (*clienttypes.MsgUpdateCounterpartyResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	counterpartyInfo := clienttypes.NewCounterpartyInfo(msg.CounterpartyMerklePrefix, msg.CounterpartyClientId)
+	if err := k.ClientKeeper.UpdateCounterparty(ctx, msg.ClientId, counterpartyInfo); err != nil {
+		return nil, err
+	}
+
+	return &clienttypes.MsgUpdateCounterpartyResponse{}, nil
+}
+
 // UpdateClient defines a rpc handler method for MsgUpdateClient.
 func (k *Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateClient) (*clienttypes.MsgUpdateClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -1000,3 +1110,19 @@ func (k *Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.Ms
 
 	return &channeltypes.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateChannelParamsV2 defines a rpc handler method for the channel v2 MsgUpdateParams.
+func (k *Keeper) UpdateChannelParamsV2(goCtx context.Context, msg *channeltypesv2.MsgUpdateParams) (*channeltypesv2.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ChannelKeeperV2.ValidateParamsUpdate(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	k.ChannelKeeperV2.SetParams(ctx, msg.Params)
+
+	return &channeltypesv2.MsgUpdateParamsResponse{}, nil
+}
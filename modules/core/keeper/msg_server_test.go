@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -15,6 +17,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
@@ -34,11 +37,19 @@ var (
 // TestRegisterCounterparty tests that counterpartyInfo is correctly stored
 // and only if the submittor is the same submittor as prior createClient msg
 func (suite *KeeperTestSuite) TestRegisterCounterparty() {
-	var path *ibctesting.Path
+	var (
+		path                *ibctesting.Path
+		merklePrefix        [][]byte
+		proof               []byte
+		proofHeight         clienttypes.Height
+		requireConfirmation bool
+		ownMerklePrefix     [][]byte
+	)
 	testCases := []struct {
-		name     string
-		malleate func()
-		expError error
+		name       string
+		malleate   func()
+		expError   error
+		expPending bool
 	}{
 		{
 			"success",
@@ -46,11 +57,63 @@ func (suite *KeeperTestSuite) TestRegisterCounterparty() {
 				path.SetupClients()
 			},
 			nil,
+			false,
+		},
+		{
+			"success: counterparty registration pending confirmation",
+			func() {
+				path.SetupClients()
+				requireConfirmation = true
+			},
+			nil,
+			true,
+		},
+		{
+			"success: counterparty registration is proven with confirmation required",
+			func() {
+				path.SetupClients()
+				suite.Require().NoError(path.EndpointB.RegisterCounterparty())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				requireConfirmation = true
+				merklePrefix = path.EndpointB.MerklePathPrefix.KeyPath
+				proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			nil,
+			false,
+		},
+		{
+			"success: counterparty registration is proven",
+			func() {
+				path.SetupClients()
+				suite.Require().NoError(path.EndpointB.RegisterCounterparty())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				merklePrefix = path.EndpointB.MerklePathPrefix.KeyPath
+				proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			nil,
+			false,
+		},
+		{
+			"success: counterparty registration is proven with the merkle prefix of this chain",
+			func() {
+				path.SetupClients()
+				suite.Require().NoError(path.EndpointB.RegisterCounterparty())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				ownMerklePrefix = path.EndpointA.MerklePathPrefix.KeyPath
+				merklePrefix = path.EndpointB.MerklePathPrefix.KeyPath
+				proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			nil,
+			false,
 		},
 		{
 			"client not created first",
 			func() {},
 			ibcerrors.ErrUnauthorized,
+			false,
 		},
 		{
 			"creator is different than expected",
@@ -59,6 +122,48 @@ func (suite *KeeperTestSuite) TestRegisterCounterparty() {
 				path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.SetClientCreator(suite.chainA.GetContext(), path.EndpointA.ClientID, sdk.AccAddress(ibctesting.TestAccAddress))
 			},
 			ibcerrors.ErrUnauthorized,
+			false,
+		},
+		{
+			"failure: counterparty has not registered the client",
+			func() {
+				path.SetupClients()
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				merklePrefix = path.EndpointB.MerklePathPrefix.KeyPath
+				proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			commitmenttypes.ErrInvalidProof,
+			false,
+		},
+		{
+			"failure: merkle prefix of this chain is not rooted at its commitment prefix",
+			func() {
+				path.SetupClients()
+				suite.Require().NoError(path.EndpointB.RegisterCounterparty())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				ownMerklePrefix = [][]byte{[]byte("invalid"), []byte("")}
+				merklePrefix = path.EndpointB.MerklePathPrefix.KeyPath
+				proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			clienttypes.ErrInvalidCounterparty,
+			false,
+		},
+		{
+			"failure: counterparty registered a different client",
+			func() {
+				path.SetupClients()
+				counterpartyInfo := clienttypes.NewCounterpartyInfo(path.EndpointA.MerklePathPrefix.KeyPath, ibctesting.InvalidID)
+				suite.chainB.App.GetIBCKeeper().ClientKeeper.SetClientCounterparty(suite.chainB.GetContext(), path.EndpointB.ClientID, counterpartyInfo)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				merklePrefix = path.EndpointB.MerklePathPrefix.KeyPath
+				proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			commitmenttypes.ErrInvalidProof,
+			false,
 		},
 	}
 	for _, tc := range testCases {
@@ -67,10 +172,20 @@ func (suite *KeeperTestSuite) TestRegisterCounterparty() {
 			suite.SetupTest()
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 
+			merklePrefix = [][]byte{[]byte("ibc"), []byte("channel-7")}
+			proof, proofHeight = nil, clienttypes.ZeroHeight()
+			requireConfirmation = false
+			ownMerklePrefix = nil
+
 			tc.malleate()
-			merklePrefix := [][]byte{[]byte("ibc"), []byte("channel-7")}
 			msg := clienttypes.NewMsgRegisterCounterparty(path.EndpointA.ClientID, merklePrefix, path.EndpointB.ClientID, suite.chainA.SenderAccount.GetAddress().String())
-			_, err := suite.chainA.App.GetIBCKeeper().RegisterCounterparty(suite.chainA.GetContext(), msg)
+			msg.ProofCounterparty = proof
+			msg.ProofHeight = proofHeight
+			msg.RequireConfirmation = requireConfirmation
+			msg.MerklePrefix = ownMerklePrefix
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.App.GetIBCKeeper().RegisterCounterparty(ctx, msg)
 			if tc.expError != nil {
 				suite.Require().Error(err)
 				suite.Require().True(errors.Is(err, tc.expError))
@@ -79,11 +194,192 @@ func (suite *KeeperTestSuite) TestRegisterCounterparty() {
 				counterpartyInfo, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCounterparty(suite.chainA.GetContext(), path.EndpointA.ClientID)
 				suite.Require().True(ok)
 				suite.Require().Equal(counterpartyInfo, clienttypes.NewCounterpartyInfo(merklePrefix, path.EndpointB.ClientID))
+				suite.Require().Equal(tc.expPending, suite.chainA.App.GetIBCKeeper().ClientKeeper.IsCounterpartyPending(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+				// packet flow is only enabled once the counterparty registration has been confirmed
 				nextSeqSend, ok := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ClientID)
-				suite.Require().True(ok)
-				suite.Require().Equal(nextSeqSend, uint64(1))
+				suite.Require().Equal(!tc.expPending, ok)
+				if !tc.expPending {
+					suite.Require().Equal(nextSeqSend, uint64(1))
+				}
 				creator := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(suite.chainA.GetContext(), path.EndpointA.ClientID)
 				suite.Require().Empty(creator)
+
+				expEvent := sdk.NewEvent(
+					clienttypes.EventTypeRegisterCounterparty,
+					sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+					sdk.NewAttribute(clienttypes.AttributeKeyCounterpartyClientID, path.EndpointB.ClientID),
+					sdk.NewAttribute(clienttypes.AttributeKeyCounterpartyMerklePrefix, fmt.Sprintf("%x,%x", merklePrefix[0], merklePrefix[1])),
+					sdk.NewAttribute(clienttypes.AttributeKeyCounterpartyPending, strconv.FormatBool(tc.expPending)),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			}
+		})
+	}
+}
+
+// TestConfirmCounterparty tests the ConfirmCounterparty rpc handler
+func (suite *KeeperTestSuite) TestConfirmCounterparty() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgConfirmCounterparty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: counterparty not registered",
+			func() {
+				msg.ClientId = ibctesting.SecondClientID
+			},
+			clienttypes.ErrCounterpartyNotFound,
+		},
+		{
+			"failure: counterparty registration is not pending",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.DeleteCounterpartyPending(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			},
+			clienttypes.ErrInvalidCounterparty,
+		},
+		{
+			"failure: counterparty registered a different client",
+			func() {
+				counterpartyInfo := clienttypes.NewCounterpartyInfo(path.EndpointA.MerklePathPrefix.KeyPath, ibctesting.InvalidID)
+				suite.chainB.App.GetIBCKeeper().ClientKeeper.SetClientCounterparty(suite.chainB.GetContext(), path.EndpointB.ClientID, counterpartyInfo)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				msg.ProofCounterparty, msg.ProofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			// register the counterparty on chainA pending confirmation, the counterparty registration on chainB is proven
+			registerMsg := clienttypes.NewMsgRegisterCounterparty(path.EndpointA.ClientID, path.EndpointB.MerklePathPrefix.KeyPath, path.EndpointB.ClientID, suite.chainA.SenderAccount.GetAddress().String())
+			registerMsg.RequireConfirmation = true
+			_, err := suite.chainA.SendMsgs(registerMsg)
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proof, proofHeight := suite.chainA.QueryProof(host.FullClientKey(path.EndpointA.ClientID, clienttypes.CounterpartyKey()))
+			registerMsg = clienttypes.NewMsgRegisterCounterparty(path.EndpointB.ClientID, path.EndpointA.MerklePathPrefix.KeyPath, path.EndpointA.ClientID, suite.chainB.SenderAccount.GetAddress().String())
+			registerMsg.ProofCounterparty, registerMsg.ProofHeight = proof, proofHeight
+			_, err = suite.chainB.SendMsgs(registerMsg)
+			suite.Require().NoError(err)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight = suite.chainB.QueryProof(host.FullClientKey(path.EndpointB.ClientID, clienttypes.CounterpartyKey()))
+			msg = clienttypes.NewMsgConfirmCounterparty(path.EndpointA.ClientID, proof, proofHeight, ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err = suite.chainA.App.GetIBCKeeper().ConfirmCounterparty(ctx, msg)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ClientKeeper.IsCounterpartyPending(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+				nextSeqSend, ok := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().True(ok)
+				suite.Require().Equal(uint64(1), nextSeqSend)
+
+				expEvent := sdk.NewEvent(
+					clienttypes.EventTypeConfirmCounterparty,
+					sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+					sdk.NewAttribute(clienttypes.AttributeKeyCounterpartyClientID, path.EndpointB.ClientID),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestUpdateCounterparty tests the UpdateCounterparty rpc handler
+func (suite *KeeperTestSuite) TestUpdateCounterparty() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgUpdateCounterparty
+	)
+
+	merklePrefix := [][]byte{[]byte("ibc"), []byte("")}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: counterparty not registered",
+			func() {
+				msg.ClientId = ibctesting.SecondClientID
+			},
+			clienttypes.ErrCounterpartyNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			msg = clienttypes.NewMsgUpdateCounterparty(path.EndpointA.ClientID, merklePrefix, ibctesting.SecondClientID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.App.GetIBCKeeper().UpdateCounterparty(ctx, msg)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				counterpartyInfo, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCounterparty(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().True(ok)
+				suite.Require().Equal(clienttypes.NewCounterpartyInfo(merklePrefix, ibctesting.SecondClientID), counterpartyInfo)
+
+				expEvent := sdk.NewEvent(
+					clienttypes.EventTypeUpdateCounterparty,
+					sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+					sdk.NewAttribute(clienttypes.AttributeKeyCounterpartyClientID, ibctesting.SecondClientID),
+					sdk.NewAttribute(clienttypes.AttributeKeyCounterpartyMerklePrefix, fmt.Sprintf("%x,", merklePrefix[0])),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+
+				counterpartyInfo, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCounterparty(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().True(ok)
+				suite.Require().Equal(clienttypes.NewCounterpartyInfo(path.EndpointB.MerklePathPrefix.KeyPath, path.EndpointB.ClientID), counterpartyInfo)
 			}
 		})
 	}
@@ -2746,6 +3042,55 @@ func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 	}
 }
 
+// TestUpdateChannelParamsV2 tests the UpdateChannelParamsV2 rpc handler
+func (suite *KeeperTestSuite) TestUpdateChannelParamsV2() {
+	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name     string
+		msg      *channeltypesv2.MsgUpdateParams
+		expError error
+	}{
+		{
+			"success: valid authority and default params",
+			channeltypesv2.NewMsgUpdateParams(authority, channeltypesv2.DefaultParams()),
+			nil,
+		},
+		{
+			"success: valid authority and port allowlist",
+			channeltypesv2.NewMsgUpdateParams(authority, channeltypesv2.NewParams(
+				time.Hour, 1024, 2048, channeltypesv2.NewClientPortAllowlist(ibctesting.FirstClientID, ibctesting.MockPort),
+			)),
+			nil,
+		},
+		{
+			"failure: empty authority address",
+			channeltypesv2.NewMsgUpdateParams("", channeltypesv2.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: unauthorized authority address",
+			channeltypesv2.NewMsgUpdateParams(ibctesting.TestAccAddress, channeltypesv2.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			resp, err := suite.chainA.App.GetIBCKeeper().UpdateChannelParamsV2(suite.chainA.GetContext(), tc.msg)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				p := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetParams(suite.chainA.GetContext())
+				suite.Require().Equal(tc.msg.Params, p)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 	var msg *channeltypes.MsgPruneAcknowledgements
 
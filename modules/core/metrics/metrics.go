@@ -15,6 +15,8 @@ const (
 	LabelSourceChannel      = "source_channel"
 	LabelDestinationPort    = "destination_port"
 	LabelDestinationChannel = "destination_channel"
+	LabelSourceClient       = "source_client"
+	LabelDestinationClient  = "destination_client"
 	LabelTimeoutType        = "timeout_type"
 	LabelDenom              = "denom"
 	LabelSource             = "source"
@@ -24,6 +24,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	channelkeeper "github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channelv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2"
 	channelkeeperv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/keeper"
 	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/client/cli"
@@ -44,6 +45,7 @@ var (
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the ibc module.
@@ -139,6 +141,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	connectiontypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	channeltypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	channeltypesv2.RegisterMsgServer(cfg.MsgServer(), am.keeper.ChannelKeeperV2)
+	channeltypesv2.RegisterParamsMsgServer(cfg.MsgServer(), am.keeper)
 
 	clienttypes.RegisterQueryServer(cfg.QueryServer(), clientkeeper.NewQueryServer(am.keeper.ClientKeeper))
 	connectiontypes.RegisterQueryServer(cfg.QueryServer(), connectionkeeper.NewQueryServer(am.keeper.ConnectionKeeper))
@@ -173,6 +176,17 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(exported.ModuleName, 6, clientMigrator.MigrateToStatelessLocalhost); err != nil {
 		panic(err)
 	}
+
+	channelMigratorV2 := channelkeeperv2.NewMigrator(am.keeper.ChannelKeeperV2)
+	if err := cfg.RegisterMigration(exported.ModuleName, 7, func(ctx sdk.Context) error {
+		if err := channelMigratorV2.MigrateParams(ctx); err != nil {
+			return err
+		}
+
+		return channelMigratorV2.MigratePendingCommitmentCounts(ctx)
+	}); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -193,11 +207,19 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 7 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	ibcclient.BeginBlocker(sdk.UnwrapSDKContext(ctx), am.keeper.ClientKeeper)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ibcclient.BeginBlocker(sdkCtx, am.keeper.ClientKeeper)
+	channelv2.BeginBlocker(sdkCtx, am.keeper.ChannelKeeperV2)
+	return nil
+}
+
+// EndBlock returns the end blocker for the ibc module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	channelv2.EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper.ChannelKeeperV2)
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	clientsim "github.com/cosmos/ibc-go/v9/modules/core/02-client/simulation"
 	connectionsim "github.com/cosmos/ibc-go/v9/modules/core/03-connection/simulation"
 	channelsim "github.com/cosmos/ibc-go/v9/modules/core/04-channel/simulation"
+	channelv2sim "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/simulation"
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 	"github.com/cosmos/ibc-go/v9/modules/core/keeper"
 )
@@ -28,6 +29,10 @@ func NewDecodeStore(k keeper.Keeper) func(kvA, kvB kv.Pair) string {
 			return res
 		}
 
+		if res, found := channelv2sim.NewDecodeStore(k.Codec(), kvA, kvB); found {
+			return res
+		}
+
 		panic(fmt.Errorf("invalid %s key prefix: %s", ibcexported.ModuleName, string(kvA.Key)))
 	}
 }
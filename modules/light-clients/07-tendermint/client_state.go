@@ -287,6 +287,200 @@ func (cs ClientState) verifyNonMembership(
 	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath)
 }
 
+// verifyMembershipBatch is a generic proof verification method which verifies a single proof of the existence of each value at its
+// respective CommitmentPath at the specified height. The proofs of the lowest subtree are expected to be combined into an ICS-23
+// batch proof, while the proofs of the higher subtrees are shared by all paths.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) verifyMembershipBatch(
+	ctx context.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of paths (%d) must equal number of values (%d)", len(paths), len(values))
+	}
+
+	merkleProof, consensusState, err := cs.prepareBatchVerification(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof)
+	if err != nil {
+		return err
+	}
+
+	entryProofs, err := batchEntryProofs(merkleProof)
+	if err != nil {
+		return err
+	}
+
+	for i, path := range paths {
+		merklePath, ok := path.(commitmenttypesv2.MerklePath)
+		if !ok {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T at index %d", commitmenttypesv2.MerklePath{}, path, i)
+		}
+
+		pathProof, err := batchPathProof(merkleProof, entryProofs, merklePath)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to retrieve proof of path at index %d", i)
+		}
+
+		if err := pathProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, values[i]); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify membership of path at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// verifyNonMembershipBatch is a generic proof verification method which verifies a single proof of the absence of each of the given
+// CommitmentPaths at the specified height. The proofs of the lowest subtree are expected to be combined into an ICS-23 batch proof,
+// while the proofs of the higher subtrees are shared by all paths.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) verifyNonMembershipBatch(
+	ctx context.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	merkleProof, consensusState, err := cs.prepareBatchVerification(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof)
+	if err != nil {
+		return err
+	}
+
+	entryProofs, err := batchEntryProofs(merkleProof)
+	if err != nil {
+		return err
+	}
+
+	for i, path := range paths {
+		merklePath, ok := path.(commitmenttypesv2.MerklePath)
+		if !ok {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T at index %d", commitmenttypesv2.MerklePath{}, path, i)
+		}
+
+		pathProof, err := batchPathProof(merkleProof, entryProofs, merklePath)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to retrieve proof of path at index %d", i)
+		}
+
+		if err := pathProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify non-membership of path at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// prepareBatchVerification performs the checks shared by all paths of a batch verification and returns the unmarshalled
+// merkle proof and the consensus state at the given height. Compressed batch proofs are decompressed once so that they
+// do not need to be decompressed again for each verified path.
+func (cs ClientState) prepareBatchVerification(
+	ctx context.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+) (commitmenttypes.MerkleProof, *ConsensusState, error) {
+	if cs.LatestHeight.LT(height) {
+		return commitmenttypes.MerkleProof{}, nil, errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	for i, p := range merkleProof.Proofs {
+		merkleProof.Proofs[i] = ics23.Decompress(p)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return commitmenttypes.MerkleProof{}, nil, errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof, consensusState, nil
+}
+
+// batchEntryProofs indexes the proofs of the lowest subtree contained in the batch proof by their key, such that the
+// proof of each path can be retrieved without searching the batch proof for every path.
+func batchEntryProofs(merkleProof commitmenttypes.MerkleProof) (map[string]*ics23.CommitmentProof, error) {
+	if len(merkleProof.Proofs) == 0 {
+		return nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "merkle proof cannot be empty")
+	}
+
+	var entries []*ics23.BatchEntry
+	switch proof := merkleProof.Proofs[0].Proof.(type) {
+	case *ics23.CommitmentProof_Batch:
+		entries = proof.Batch.GetEntries()
+	case *ics23.CommitmentProof_Exist:
+		entries = []*ics23.BatchEntry{{Proof: &ics23.BatchEntry_Exist{Exist: proof.Exist}}}
+	case *ics23.CommitmentProof_Nonexist:
+		entries = []*ics23.BatchEntry{{Proof: &ics23.BatchEntry_Nonexist{Nonexist: proof.Nonexist}}}
+	default:
+		return nil, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "unexpected commitment proof type %T", proof)
+	}
+
+	entryProofs := make(map[string]*ics23.CommitmentProof, len(entries))
+	for _, entry := range entries {
+		if ep := entry.GetExist(); ep != nil {
+			entryProofs[string(ep.Key)] = &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: ep}}
+		} else if np := entry.GetNonexist(); np != nil {
+			entryProofs[string(np.Key)] = &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{Nonexist: np}}
+		}
+	}
+
+	return entryProofs, nil
+}
+
+// batchPathProof assembles the merkle proof of the path from the proof of its key in the lowest subtree
+// and the proofs of the higher subtrees, which are shared by all paths of the batch.
+func batchPathProof(merkleProof commitmenttypes.MerkleProof, entryProofs map[string]*ics23.CommitmentProof, merklePath commitmenttypesv2.MerklePath) (commitmenttypes.MerkleProof, error) {
+	if len(merklePath.KeyPath) == 0 {
+		return commitmenttypes.MerkleProof{}, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "merkle path cannot be empty")
+	}
+
+	key, err := merklePath.GetKey(uint64(len(merklePath.KeyPath) - 1))
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "could not retrieve key bytes for key %s: %v", merklePath.KeyPath[len(merklePath.KeyPath)-1], err)
+	}
+
+	entryProof, ok := entryProofs[string(key)]
+	if !ok {
+		return commitmenttypes.MerkleProof{}, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "batch proof does not contain a proof for key %s", merklePath.KeyPath[len(merklePath.KeyPath)-1])
+	}
+
+	proofs := make([]*ics23.CommitmentProof, len(merkleProof.Proofs))
+	proofs[0] = entryProof
+	copy(proofs[1:], merkleProof.Proofs[1:])
+
+	return commitmenttypes.MerkleProof{Proofs: proofs}, nil
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx context.Context, store storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
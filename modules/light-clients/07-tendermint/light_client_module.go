@@ -13,7 +13,11 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule    = (*LightClientModule)(nil)
+	_ exported.BatchVerifier        = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruner = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -138,6 +142,57 @@ func (l LightClientModule) VerifyNonMembership(
 	return clientState.verifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
 }
 
+// VerifyMembershipBatch obtains the client state associated with the client identifier and calls into the clientState.verifyMembershipBatch method.
+func (l LightClientModule) VerifyMembershipBatch(
+	ctx context.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyMembershipBatch(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
+// VerifyNonMembershipBatch obtains the client state associated with the client identifier and calls into the clientState.verifyNonMembershipBatch method.
+func (l LightClientModule) VerifyNonMembershipBatch(
+	ctx context.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyNonMembershipBatch(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths)
+}
+
+// PruneExpiredConsensusStates obtains the client state associated with the client identifier and deletes at most limit
+// expired consensus states, starting from the lowest height. The consensus state at the latest height is never deleted.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx context.Context, clientID string, limit uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return pruneExpiredConsensusStates(ctx, clientStore, l.cdc, clientState, limit), nil
+}
+
 // Status obtains the client state associated with the client identifier and calls into the clientState.status method.
 func (l LightClientModule) Status(ctx context.Context, clientID string) exported.Status {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
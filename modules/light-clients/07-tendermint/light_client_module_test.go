@@ -747,6 +747,221 @@ func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestVerifyMembershipBatch() {
+	var (
+		testingpath *ibctesting.Path
+		proofHeight exported.Height
+		proof       []byte
+		paths       []exported.Path
+		values      [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: ClientState and Connection verification",
+			func() {},
+			nil,
+		},
+		{
+			"success: single path",
+			func() {
+				paths = paths[:1]
+				values = values[:1]
+			},
+			nil,
+		},
+		{
+			"failure: empty paths",
+			func() {
+				paths = nil
+				values = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: number of paths does not match number of values",
+			func() {
+				values = values[:1]
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid path type",
+			func() {
+				paths[1] = ibcmock.KeyPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: batch proof does not contain path",
+			func() {
+				proof, proofHeight = testingpath.EndpointB.QueryBatchProof(host.FullClientStateKey(testingpath.EndpointB.ClientID))
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: invalid value",
+			func() {
+				values[1] = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: latest client height < height",
+			func() {
+				proofHeight = testingpath.EndpointA.GetClientLatestHeight().Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), testingpath.EndpointA.ClientID)
+				store.Delete(host.ClientStateKey())
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			testingpath.Setup()
+
+			clientStateKey := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+			connectionKey := host.ConnectionKey(testingpath.EndpointB.ConnectionID)
+
+			paths = nil
+			for _, key := range [][]byte{clientStateKey, connectionKey} {
+				path, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				suite.Require().NoError(err)
+
+				paths = append(paths, path)
+			}
+
+			proof, proofHeight = testingpath.EndpointB.QueryBatchProof(clientStateKey, connectionKey)
+
+			clientState, ok := testingpath.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientStateBz, err := suite.chainB.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+
+			connection := testingpath.EndpointB.GetConnection()
+			connectionBz, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			values = [][]byte{clientStateBz, connectionBz}
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), testingpath.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			suite.Require().True(ok)
+
+			err = batchVerifier.VerifyMembershipBatch(suite.chainA.GetContext(), testingpath.EndpointA.ClientID, proofHeight, 0, 0, proof, paths, values)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyNonMembershipBatch() {
+	var (
+		testingpath *ibctesting.Path
+		proofHeight exported.Height
+		proof       []byte
+		paths       []exported.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: ClientState and Connection verification of non membership",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty paths",
+			func() {
+				paths = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: batch proof does not contain path",
+			func() {
+				proof, proofHeight = testingpath.EndpointB.QueryBatchProof(host.FullClientStateKey("07-tendermint-100"))
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: path exists",
+			func() {
+				key := host.ConnectionKey(testingpath.EndpointB.ConnectionID)
+				path, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				suite.Require().NoError(err)
+
+				paths = append(paths, path)
+				proof, proofHeight = testingpath.EndpointB.QueryBatchProof(host.FullClientStateKey("07-tendermint-100"), host.ConnectionKey("connection-100"), key)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			testingpath.Setup()
+
+			clientStateKey := host.FullClientStateKey("07-tendermint-100")
+			connectionKey := host.ConnectionKey("connection-100")
+
+			paths = nil
+			for _, key := range [][]byte{clientStateKey, connectionKey} {
+				path, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				suite.Require().NoError(err)
+
+				paths = append(paths, path)
+			}
+
+			proof, proofHeight = testingpath.EndpointB.QueryBatchProof(clientStateKey, connectionKey)
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), testingpath.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			suite.Require().True(ok)
+
+			err = batchVerifier.VerifyNonMembershipBatch(suite.chainA.GetContext(), testingpath.EndpointA.ClientID, proofHeight, 0, 0, proof, paths)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestStatus() {
 	var (
 		path        *ibctesting.Path
@@ -822,6 +1037,58 @@ func (suite *TendermintTestSuite) TestStatus() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestPruneExpiredConsensusStates() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	heights := []exported.Height{path.EndpointA.GetClientLatestHeight()}
+	for i := 0; i < 2; i++ {
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		heights = append(heights, path.EndpointA.GetClientLatestHeight())
+	}
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	pruner, ok := lightClientModule.(exported.ConsensusStatePruner)
+	suite.Require().True(ok)
+
+	// no consensus state is expired
+	pruned, err := pruner.PruneExpiredConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, 10)
+	suite.Require().NoError(err)
+	suite.Require().Zero(pruned)
+
+	suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+	// expired consensus states are pruned up to the limit, starting from the lowest height
+	pruned, err = pruner.PruneExpiredConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+
+	_, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, heights[0])
+	suite.Require().False(found)
+	_, found = suite.chainA.GetConsensusState(path.EndpointA.ClientID, heights[1])
+	suite.Require().True(found)
+
+	// the consensus state at the latest height is never pruned
+	pruned, err = pruner.PruneExpiredConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	_, found = ibctm.GetProcessedTime(clientStore, heights[1])
+	suite.Require().False(found)
+	_, found = suite.chainA.GetConsensusState(path.EndpointA.ClientID, heights[2])
+	suite.Require().True(found)
+
+	// the client must exist
+	_, err = pruner.PruneExpiredConsensusStates(suite.chainA.GetContext(), tmClientID, 10)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+}
+
 func (suite *TendermintTestSuite) TestLatestHeight() {
 	var (
 		path   *ibctesting.Path
@@ -293,6 +293,45 @@ func PruneAllExpiredConsensusStates(
 	return len(heights)
 }
 
+// pruneExpiredConsensusStates iterates over the consensus states for a given client store in ascending order
+// of height and deletes at most limit expired consensus states, together with their metadata. Iteration stops at
+// the first consensus state which is not expired. The consensus state at the latest height of the client is never
+// deleted, such that the status of the client can still be determined. The number of consensus states pruned is returned.
+func pruneExpiredConsensusStates(
+	ctx context.Context, clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState, limit uint64,
+) uint64 {
+	var heights []exported.Height
+
+	pruneCb := func(height exported.Height) bool {
+		if uint64(len(heights)) >= limit || height.GTE(clientState.LatestHeight) {
+			return true
+		}
+
+		consState, found := GetConsensusState(clientStore, cdc, height)
+		if !found { // consensus state should always be found
+			return true
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		if !clientState.IsExpired(consState.Timestamp, sdkCtx.BlockTime()) {
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	IterateConsensusStateAscending(clientStore, pruneCb)
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return uint64(len(heights))
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
@@ -20,7 +20,10 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule = (*LightClientModule)(nil)
+	_ exported.BatchVerifier     = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -311,6 +314,154 @@ func (l LightClientModule) VerifyNonMembership(
 	return err
 }
 
+// VerifyMembershipBatch obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
+// VerifyMembershipBatch verifies a single proof of the existence of each value at its respective CommitmentPath at the specified height.
+// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// Contracts which do not support batch verification are called to verify each path in turn.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (l LightClientModule) VerifyMembershipBatch(
+	ctx context.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of paths (%d) must equal number of values (%d)", len(paths), len(values))
+	}
+
+	if len(paths) == 1 {
+		return l.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths[0], values[0])
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := types.GetClientState(clientStore, cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	proofHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	if clientState.LatestHeight.LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", clientState.LatestHeight, height,
+		)
+	}
+
+	merklePaths, err := toMerklePaths(paths)
+	if err != nil {
+		return err
+	}
+
+	payload := types.SudoMsg{
+		VerifyMembershipBatch: &types.VerifyMembershipBatchMsg{
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			Paths:            merklePaths,
+			Values:           values,
+		},
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, err := l.keeper.WasmSudo(sdkCtx, clientID, clientStore, clientState, payload); err == nil {
+		return nil
+	}
+
+	for i, path := range paths {
+		if err := l.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, values[i]); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify membership of path at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyNonMembershipBatch obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
+// VerifyNonMembershipBatch verifies a single proof of the absence of each of the given CommitmentPaths at the specified height.
+// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// Contracts which do not support batch verification are called to verify each path in turn.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (l LightClientModule) VerifyNonMembershipBatch(
+	ctx context.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	if len(paths) == 1 {
+		return l.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths[0])
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := types.GetClientState(clientStore, cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	proofHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	if clientState.LatestHeight.LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", clientState.LatestHeight, height,
+		)
+	}
+
+	merklePaths, err := toMerklePaths(paths)
+	if err != nil {
+		return err
+	}
+
+	payload := types.SudoMsg{
+		VerifyNonMembershipBatch: &types.VerifyNonMembershipBatchMsg{
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			Paths:            merklePaths,
+		},
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, err := l.keeper.WasmSudo(sdkCtx, clientID, clientStore, clientState, payload); err == nil {
+		return nil
+	}
+
+	for i, path := range paths {
+		if err := l.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify non-membership of path at index %d", i)
+		}
+	}
+
+	return nil
+}
+
 // Status obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
 // It returns the status of the wasm client.
 // The client may be:
@@ -493,3 +644,18 @@ func (l LightClientModule) VerifyUpgradeAndUpdateState(
 	_, err := l.keeper.WasmSudo(sdkCtx, clientID, clientStore, clientState, payload)
 	return err
 }
+
+// toMerklePaths converts the provided paths into merkle paths, returning an error if any path is not a merkle path.
+func toMerklePaths(paths []exported.Path) ([]commitmenttypesv2.MerklePath, error) {
+	merklePaths := make([]commitmenttypesv2.MerklePath, 0, len(paths))
+	for i, path := range paths {
+		merklePath, ok := path.(commitmenttypesv2.MerklePath)
+		if !ok {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T at index %d", commitmenttypesv2.MerklePath{}, path, i)
+		}
+
+		merklePaths = append(merklePaths, merklePath)
+	}
+
+	return merklePaths, nil
+}
@@ -670,6 +670,345 @@ func (suite *WasmTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *WasmTestSuite) TestVerifyMembershipBatch() {
+	var (
+		paths       []exported.Path
+		values      [][]byte
+		proof       []byte
+		proofHeight exported.Height
+		clientID    string
+		calls       int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					var payload types.SudoMsg
+					err := json.Unmarshal(sudoMsg, &payload)
+					suite.Require().NoError(err)
+
+					suite.Require().NotNil(payload.VerifyMembershipBatch)
+					suite.Require().Nil(payload.VerifyMembership)
+					suite.Require().Nil(payload.VerifyNonMembershipBatch)
+					suite.Require().Equal(proofHeight, payload.VerifyMembershipBatch.Height)
+					suite.Require().Equal(proof, payload.VerifyMembershipBatch.Proof)
+					suite.Require().Len(payload.VerifyMembershipBatch.Paths, len(paths))
+					for i, path := range paths {
+						suite.Require().Equal(path, payload.VerifyMembershipBatch.Paths[i])
+					}
+					suite.Require().Equal(values, payload.VerifyMembershipBatch.Values)
+
+					bz, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"success: single path is verified without batch verification",
+			func() {
+				paths = paths[:1]
+				values = values[:1]
+
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					calls++
+
+					bz, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"success: paths are verified individually when the contract does not support batch verification",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: "unknown variant"}, wasmtesting.DefaultGasUsed, nil
+				})
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					calls++
+
+					bz, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedWasmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: contract returns invalid proof error",
+			func() {
+				proof = wasmtesting.MockInvalidProofBz
+
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: commitmenttypes.ErrInvalidProof.Error()}, wasmtesting.DefaultGasUsed, nil
+				})
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: commitmenttypes.ErrInvalidProof.Error()}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: number of paths does not match number of values",
+			func() {
+				values = values[:1]
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: empty paths",
+			func() {
+				paths = nil
+				values = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid path argument",
+			func() {
+				paths[1] = ibcmock.KeyPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: proof height greater than client state latest height",
+			func() {
+				proofHeight = clienttypes.NewHeight(1, 100)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+			calls = 0
+
+			paths = []exported.Path{commitmenttypes.NewMerklePath([]byte("/ibc/key/path/1")), commitmenttypes.NewMerklePath([]byte("/ibc/key/path/2"))}
+			values = [][]byte{[]byte("value1"), []byte("value2")}
+			proof = wasmtesting.MockValidProofBz
+			proofHeight = clienttypes.NewHeight(0, 1)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			err = batchVerifier.VerifyMembershipBatch(suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, paths, values)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				// paths verified individually each result in a contract call
+				if calls > 0 {
+					suite.Require().Equal(len(paths), calls)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestVerifyNonMembershipBatch() {
+	var (
+		paths       []exported.Path
+		proof       []byte
+		proofHeight exported.Height
+		clientID    string
+		calls       int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.VerifyNonMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					var payload types.SudoMsg
+					err := json.Unmarshal(sudoMsg, &payload)
+					suite.Require().NoError(err)
+
+					suite.Require().NotNil(payload.VerifyNonMembershipBatch)
+					suite.Require().Nil(payload.VerifyNonMembership)
+					suite.Require().Nil(payload.VerifyMembershipBatch)
+					suite.Require().Equal(proofHeight, payload.VerifyNonMembershipBatch.Height)
+					suite.Require().Equal(proof, payload.VerifyNonMembershipBatch.Proof)
+					suite.Require().Len(payload.VerifyNonMembershipBatch.Paths, len(paths))
+					for i, path := range paths {
+						suite.Require().Equal(path, payload.VerifyNonMembershipBatch.Paths[i])
+					}
+
+					bz, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"success: single path is verified without batch verification",
+			func() {
+				paths = paths[:1]
+
+				suite.mockVM.RegisterSudoCallback(types.VerifyNonMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					calls++
+
+					bz, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"success: paths are verified individually when the contract does not support batch verification",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.VerifyNonMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: "unknown variant"}, wasmtesting.DefaultGasUsed, nil
+				})
+				suite.mockVM.RegisterSudoCallback(types.VerifyNonMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					calls++
+
+					bz, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedWasmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: contract returns invalid proof error",
+			func() {
+				proof = wasmtesting.MockInvalidProofBz
+
+				suite.mockVM.RegisterSudoCallback(types.VerifyNonMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: commitmenttypes.ErrInvalidProof.Error()}, wasmtesting.DefaultGasUsed, nil
+				})
+				suite.mockVM.RegisterSudoCallback(types.VerifyNonMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: commitmenttypes.ErrInvalidProof.Error()}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: empty paths",
+			func() {
+				paths = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid path argument",
+			func() {
+				paths[0] = ibcmock.KeyPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+			calls = 0
+
+			paths = []exported.Path{commitmenttypes.NewMerklePath([]byte("/ibc/key/path/1")), commitmenttypes.NewMerklePath([]byte("/ibc/key/path/2"))}
+			proof = wasmtesting.MockValidProofBz
+			proofHeight = clienttypes.NewHeight(0, 1)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			err = batchVerifier.VerifyNonMembershipBatch(suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, paths)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				// paths verified individually each result in a contract call
+				if calls > 0 {
+					suite.Require().Equal(len(paths), calls)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *WasmTestSuite) TestVerifyClientMessage() {
 	var (
 		clientMsg exported.ClientMessage
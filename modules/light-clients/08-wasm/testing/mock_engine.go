@@ -22,7 +22,7 @@ var (
 	queryTypes = [...]any{types.StatusMsg{}, types.TimestampAtHeightMsg{}, types.VerifyClientMessageMsg{}, types.CheckForMisbehaviourMsg{}}
 
 	// sudoTypes contains all the possible sudo message types.
-	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.MigrateClientStoreMsg{}}
+	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.VerifyMembershipBatchMsg{}, types.VerifyNonMembershipBatchMsg{}, types.MigrateClientStoreMsg{}}
 )
 
 type (
@@ -272,6 +272,14 @@ func getSudoMsgPayloadTypeName(sudoMsgBz []byte) string {
 		payloadField = *payload.VerifyNonMembership
 	}
 
+	if payload.VerifyMembershipBatch != nil {
+		payloadField = *payload.VerifyMembershipBatch
+	}
+
+	if payload.VerifyNonMembershipBatch != nil {
+		payloadField = *payload.VerifyNonMembershipBatch
+	}
+
 	if payload.MigrateClientStore != nil {
 		payloadField = *payload.MigrateClientStore
 	}
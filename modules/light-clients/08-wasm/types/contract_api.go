@@ -51,6 +51,8 @@ type SudoMsg struct {
 	VerifyUpgradeAndUpdateState *VerifyUpgradeAndUpdateStateMsg `json:"verify_upgrade_and_update_state,omitempty"`
 	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
 	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
+	VerifyMembershipBatch       *VerifyMembershipBatchMsg       `json:"verify_membership_batch,omitempty"`
+	VerifyNonMembershipBatch    *VerifyNonMembershipBatchMsg    `json:"verify_non_membership_batch,omitempty"`
 	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
 }
 
@@ -83,6 +85,26 @@ type VerifyNonMembershipMsg struct {
 	Path             commitmenttypesv2.MerklePath `json:"merkle_path"`
 }
 
+// VerifyMembershipBatchMsg is a sudoMsg sent to the contract to verify a single membership proof for multiple paths.
+// The paths and values are matched by index.
+type VerifyMembershipBatchMsg struct {
+	Height           clienttypes.Height             `json:"height"`
+	DelayTimePeriod  uint64                         `json:"delay_time_period"`
+	DelayBlockPeriod uint64                         `json:"delay_block_period"`
+	Proof            []byte                         `json:"proof"`
+	Paths            []commitmenttypesv2.MerklePath `json:"merkle_paths"`
+	Values           [][]byte                       `json:"values"`
+}
+
+// VerifyNonMembershipBatchMsg is a sudoMsg sent to the contract to verify a single non-membership proof for multiple paths.
+type VerifyNonMembershipBatchMsg struct {
+	Height           clienttypes.Height             `json:"height"`
+	DelayTimePeriod  uint64                         `json:"delay_time_period"`
+	DelayBlockPeriod uint64                         `json:"delay_block_period"`
+	Proof            []byte                         `json:"proof"`
+	Paths            []commitmenttypesv2.MerklePath `json:"merkle_paths"`
+}
+
 // VerifyUpgradeAndUpdateStateMsg is a sudoMsg sent to the contract to verify an upgrade and update its state.
 type VerifyUpgradeAndUpdateStateMsg struct {
 	UpgradeClientState         []byte `json:"upgrade_client_state"`
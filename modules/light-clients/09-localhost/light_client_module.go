@@ -29,7 +29,10 @@ const (
 // Localhost client state verification will fail if the sentintel proof value is not provided.
 var SentinelProof = []byte{0x01}
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule = (*LightClientModule)(nil)
+	_ exported.BatchVerifier     = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -155,6 +158,59 @@ func (l LightClientModule) VerifyNonMembership(
 	return nil
 }
 
+// VerifyMembershipBatch verifies the existence of each of the given keys and values within the IBC store.
+// The 09-localhost client reads directly from the IBC store, thus each path is verified individually using the sentinel proof.
+func (l LightClientModule) VerifyMembershipBatch(
+	ctx context.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of paths (%d) must equal number of values (%d)", len(paths), len(values))
+	}
+
+	for i, path := range paths {
+		if err := l.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, values[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyNonMembershipBatch verifies the absence of each of the given keys within the IBC store.
+// The 09-localhost client reads directly from the IBC store, thus each path is verified individually using the sentinel proof.
+func (l LightClientModule) VerifyNonMembershipBatch(
+	ctx context.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "paths cannot be empty")
+	}
+
+	for _, path := range paths {
+		if err := l.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Status always returns Active. The 09-localhost status cannot be changed.
 func (LightClientModule) Status(_ context.Context, _ string) exported.Status {
 	return exported.Active
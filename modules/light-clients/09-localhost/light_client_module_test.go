@@ -339,6 +339,163 @@ func (suite *LocalhostTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *LocalhostTestSuite) TestVerifyMembershipBatch() {
+	var (
+		paths  []exported.Path
+		values [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: packet commitments verification",
+			func() {},
+			nil,
+		},
+		{
+			"empty paths",
+			func() {
+				paths, values = nil, nil
+			},
+			errors.New("paths cannot be empty"),
+		},
+		{
+			"number of paths does not match number of values",
+			func() {
+				values = values[:1]
+			},
+			errors.New("number of paths (2) must equal number of values (1)"),
+		},
+		{
+			"invalid value for second path",
+			func() {
+				values[1] = []byte("invalid value")
+			},
+			errors.New("membership verification failed"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			paths, values = nil, nil
+			for seq := uint64(1); seq <= 2; seq++ {
+				commitmentBz := []byte("commitment")
+				suite.chain.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chain.GetContext(), mock.PortID, ibctesting.FirstChannelID, seq, commitmentBz)
+
+				merklePath := commitmenttypes.NewMerklePath(host.PacketCommitmentKey(mock.PortID, ibctesting.FirstChannelID, seq))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				paths = append(paths, merklePath)
+				values = append(values, commitmentBz)
+			}
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chain.App.GetIBCKeeper().ClientKeeper.Route(suite.chain.GetContext(), exported.LocalhostClientID)
+			suite.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			suite.Require().True(ok)
+
+			err = batchVerifier.VerifyMembershipBatch(
+				suite.chain.GetContext(),
+				exported.LocalhostClientID,
+				clienttypes.ZeroHeight(),
+				0, 0, // use zero values for delay periods
+				localhost.SentinelProof,
+				paths,
+				values,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyNonMembershipBatch() {
+	var paths []exported.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: packet receipts absence verification",
+			func() {},
+			nil,
+		},
+		{
+			"empty paths",
+			func() {
+				paths = nil
+			},
+			errors.New("paths cannot be empty"),
+		},
+		{
+			"packet receipt absence verification fails for second path",
+			func() {
+				suite.chain.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chain.GetContext(), mock.PortID, ibctesting.FirstChannelID, 2)
+			},
+			errors.New("non-membership verification failed"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			paths = nil
+			for seq := uint64(1); seq <= 2; seq++ {
+				merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptKey(mock.PortID, ibctesting.FirstChannelID, seq))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				paths = append(paths, merklePath)
+			}
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chain.App.GetIBCKeeper().ClientKeeper.Route(suite.chain.GetContext(), exported.LocalhostClientID)
+			suite.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			suite.Require().True(ok)
+
+			err = batchVerifier.VerifyNonMembershipBatch(
+				suite.chain.GetContext(),
+				exported.LocalhostClientID,
+				clienttypes.ZeroHeight(),
+				0, 0, // use zero values for delay periods
+				localhost.SentinelProof,
+				paths,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
+
 func (suite *LocalhostTestSuite) TestStatus() {
 	lightClientModule, err := suite.chain.App.GetIBCKeeper().ClientKeeper.Route(suite.chain.GetContext(), exported.LocalhostClientID)
 	suite.Require().NoError(err)
@@ -2,11 +2,14 @@ package ibctesting
 
 import (
 	"github.com/cosmos/gogoproto/proto"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
 	hostv2 "github.com/cosmos/ibc-go/v9/modules/core/24-host/v2"
 )
 
@@ -21,18 +24,18 @@ func (endpoint *Endpoint) RegisterCounterparty() (err error) {
 }
 
 // MsgSendPacket sends a packet on the associated endpoint using a predefined sender. The constructed packet is returned.
-func (endpoint *Endpoint) MsgSendPacket(timeoutTimestamp uint64, payload channeltypesv2.Payload) (channeltypesv2.Packet, error) {
+func (endpoint *Endpoint) MsgSendPacket(timeoutTimestamp uint64, payloads ...channeltypesv2.Payload) (channeltypesv2.Packet, error) {
 	senderAccount := SenderAccount{
 		SenderPrivKey: endpoint.Chain.SenderPrivKey,
 		SenderAccount: endpoint.Chain.SenderAccount,
 	}
 
-	return endpoint.MsgSendPacketWithSender(timeoutTimestamp, payload, senderAccount)
+	return endpoint.MsgSendPacketWithSender(timeoutTimestamp, senderAccount, payloads...)
 }
 
 // MsgSendPacketWithSender sends a packet on the associated endpoint using the provided sender. The constructed packet is returned.
-func (endpoint *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, payload channeltypesv2.Payload, sender SenderAccount) (channeltypesv2.Packet, error) {
-	msgSendPacket := channeltypesv2.NewMsgSendPacket(endpoint.ClientID, timeoutTimestamp, sender.SenderAccount.GetAddress().String(), payload)
+func (endpoint *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, sender SenderAccount, payloads ...channeltypesv2.Payload) (channeltypesv2.Packet, error) {
+	msgSendPacket := channeltypesv2.NewMsgSendPacket(endpoint.ClientID, timeoutTimestamp, sender.SenderAccount.GetAddress().String(), payloads...)
 
 	res, err := endpoint.Chain.SendMsgsWithSender(sender, msgSendPacket)
 	if err != nil {
@@ -56,7 +59,7 @@ func (endpoint *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, paylo
 	if err != nil {
 		return channeltypesv2.Packet{}, err
 	}
-	packet := channeltypesv2.NewPacket(sendResponse.Sequence, endpoint.ClientID, endpoint.Counterparty.ClientID, timeoutTimestamp, payload)
+	packet := channeltypesv2.NewPacket(sendResponse.Sequence, endpoint.ClientID, endpoint.Counterparty.ClientID, timeoutTimestamp, payloads...)
 
 	return packet, nil
 }
@@ -103,3 +106,37 @@ func (endpoint *Endpoint) MsgTimeoutPacket(packet channeltypesv2.Packet) error {
 
 	return endpoint.Counterparty.UpdateClient()
 }
+
+// QueryBatchProof queries the proofs of the provided keys associated with this endpoint and combines them into a
+// single proof, where the proofs of the lowest subtree are combined into an ICS-23 batch proof. The proofs are
+// queried using the latest height of the counterparty client.
+func (endpoint *Endpoint) QueryBatchProof(keys ...[]byte) ([]byte, clienttypes.Height) {
+	require.NotEmpty(endpoint.Chain.TB, keys)
+
+	var (
+		batch       ics23.BatchProof
+		merkleProof commitmenttypes.MerkleProof
+		proofHeight clienttypes.Height
+	)
+	for _, key := range keys {
+		var proofBz []byte
+		proofBz, proofHeight = endpoint.QueryProof(key)
+
+		err := endpoint.Chain.Codec.Unmarshal(proofBz, &merkleProof)
+		require.NoError(endpoint.Chain.TB, err)
+
+		entry := &ics23.BatchEntry{Proof: &ics23.BatchEntry_Nonexist{Nonexist: merkleProof.Proofs[0].GetNonexist()}}
+		if exist := merkleProof.Proofs[0].GetExist(); exist != nil {
+			entry = &ics23.BatchEntry{Proof: &ics23.BatchEntry_Exist{Exist: exist}}
+		}
+		batch.Entries = append(batch.Entries, entry)
+	}
+
+	// the proofs of the higher subtrees are identical for all keys of the same store
+	merkleProof.Proofs[0] = &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Batch{Batch: &batch}}
+
+	proof, err := endpoint.Chain.Codec.Marshal(&merkleProof)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return proof, proofHeight
+}
@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strconv"
 
+	"github.com/cosmos/gogoproto/proto"
 	testifysuite "github.com/stretchr/testify/suite"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -14,6 +15,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v9/modules/core/04-channel/v2/types"
 )
 
 // ParseClientIDFromEvents parses events emitted from a MsgCreateClient and returns the
@@ -147,6 +149,36 @@ func ParsePacketsFromEvents(eventType string, events []abci.Event) ([]channeltyp
 	return packets, nil
 }
 
+// ParsePacketV2FromEvents parses events emitted from an IBC v2 send packet and returns
+// the first EventTypeSendPacket packet found.
+// Returns an error if no packet is found.
+func ParsePacketV2FromEvents(events []abci.Event) (channeltypesv2.Packet, error) {
+	for _, ev := range events {
+		if ev.Type != channeltypesv2.EventTypeSendPacket {
+			continue
+		}
+
+		for _, attr := range ev.Attributes {
+			if attr.Key != channeltypesv2.AttributeKeyEncodedPacketHex {
+				continue
+			}
+
+			bz, err := hex.DecodeString(attr.Value)
+			if err != nil {
+				return channeltypesv2.Packet{}, err
+			}
+
+			var packet channeltypesv2.Packet
+			if err := proto.Unmarshal(bz, &packet); err != nil {
+				return channeltypesv2.Packet{}, err
+			}
+
+			return packet, nil
+		}
+	}
+	return channeltypesv2.Packet{}, errors.New("packet event attribute not found")
+}
+
 // ParseAckFromEvents parses events emitted from a MsgRecvPacket and returns the
 // acknowledgement.
 func ParseAckFromEvents(events []abci.Event) ([]byte, error) {
@@ -11,7 +11,10 @@ import (
 	mockv1 "github.com/cosmos/ibc-go/v9/testing/mock"
 )
 
-var _ api.IBCModule = (*IBCModule)(nil)
+var (
+	_ api.IBCModule                        = (*IBCModule)(nil)
+	_ api.AsyncAcknowledgementExpiryExempt = (*IBCModule)(nil)
+)
 
 const (
 	// ModuleNameA is a name that can be used for the first mock application.
@@ -68,6 +71,13 @@ func (im IBCModule) OnTimeoutPacket(ctx context.Context, sourceChannel string, d
 	return nil
 }
 
+func (im IBCModule) ExemptFromAsyncAcknowledgementExpiry() bool {
+	if im.IBCApp.ExemptFromAsyncAcknowledgementExpiry != nil {
+		return im.IBCApp.ExemptFromAsyncAcknowledgementExpiry()
+	}
+	return false
+}
+
 func (IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (interface{}, error) {
 	if bytes.Equal(payload.Value, mockv1.MockPacketData) {
 		return mockv1.MockPacketData, nil